@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"runtime"
+	"strings"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
@@ -34,6 +35,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -102,10 +104,20 @@ func main() {
 	}
 
 	// Create a new Cmd to provide shared dependencies and start components
-	mgr, err := manager.New(cfg, manager.Options{
+	options := manager.Options{
 		Namespace:          namespace,
 		MetricsBindAddress: fmt.Sprintf("%s:%d", metricsHost, metricsPort),
-	})
+	}
+
+	// A comma separated WATCH_NAMESPACE watches several namespaces,
+	// required by cross-namespace provider account and backend references
+	if strings.Contains(namespace, ",") {
+		options.Namespace = ""
+		options.NewCache = cache.MultiNamespacedCacheBuilder(strings.Split(namespace, ","))
+		log.Info("Watching multiple namespaces", "namespaces", namespace)
+	}
+
+	mgr, err := manager.New(cfg, options)
 	if err != nil {
 		log.Error(err, "")
 		os.Exit(1)
@@ -163,7 +175,7 @@ func main() {
 	register3scaleVersionInfoMetric()
 
 	// Add the Metrics Service
-	addMetrics(ctx, cfg, namespace)
+	addMetrics(ctx, cfg, metricsNamespace(namespace))
 
 	log.Info("Starting the Cmd.")
 
@@ -189,6 +201,20 @@ func register3scaleVersionInfoMetric() {
 	crmetrics.Registry.MustRegister(threeScaleVersionInfo)
 }
 
+// metricsNamespace returns the namespace where the metrics ServiceMonitor is created.
+// When several namespaces are watched, the operator namespace is used
+func metricsNamespace(watchNamespace string) string {
+	if !strings.Contains(watchNamespace, ",") {
+		return watchNamespace
+	}
+
+	operatorNs, err := k8sutil.GetOperatorNamespace()
+	if err != nil {
+		return strings.Split(watchNamespace, ",")[0]
+	}
+	return operatorNs
+}
+
 // addMetrics will create the Services and Service Monitors to allow the operator export the metrics by using
 // the Prometheus operator
 func addMetrics(ctx context.Context, cfg *rest.Config, namespace string) {
@@ -0,0 +1,137 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/export"
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	"github.com/spf13/cobra"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	exportAdminURLEnvVar    = "THREESCALE_ADMIN_URL"
+	exportAccessTokenEnvVar = "THREESCALE_ACCESS_TOKEN"
+)
+
+var (
+	exportAdminURL           string
+	exportAccessToken        string
+	exportProducts           []string
+	exportProviderAccountRef string
+	exportOutputDir          string
+)
+
+// exportCmd represents the export command
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Generate Product and Backend custom resources from existing 3scale products and backends",
+	Long: `Generate Product and Backend custom resources from the products and backends of a 3scale tenant.
+
+Metrics, methods, mapping rules, backend usages, proxy settings, features, policies
+and application plans with limits and pricing rules are exported.
+The generated resources do not change 3scale on the first reconciliation.
+
+The tenant admin portal URL and access token are read from the THREESCALE_ADMIN_URL
+and THREESCALE_ACCESS_TOKEN environment variables, unless provided as flags.
+
+Resources are printed to the standard output, unless an output directory is provided.
+
+Examples:
+  generator export --admin-url https://example-admin.3scale.net --access-token TOKEN
+  generator export --product petstore --provider-account-ref tenant-secret --output-dir ./resources`,
+	Args: cobra.NoArgs,
+	RunE: runExportCommand,
+}
+
+func runExportCommand(cmd *cobra.Command, args []string) error {
+	adminURL := exportAdminURL
+	if adminURL == "" {
+		adminURL = helper.GetEnvVar(exportAdminURLEnvVar, "")
+	}
+
+	accessToken := exportAccessToken
+	if accessToken == "" {
+		accessToken = helper.GetEnvVar(exportAccessTokenEnvVar, "")
+	}
+
+	if adminURL == "" || accessToken == "" {
+		return fmt.Errorf("3scale admin URL and access token are required")
+	}
+
+	threescaleAPIClient, err := controllerhelper.PortaClientFromURLString(adminURL, accessToken)
+	if err != nil {
+		return err
+	}
+
+	options := export.ExporterOptions{
+		ProductSystemNames: exportProducts,
+		ProviderAccountRef: exportProviderAccountRef,
+	}
+
+	exporter, err := export.NewExporter(threescaleAPIClient, options, logf.Log.WithName("export"))
+	if err != nil {
+		return err
+	}
+
+	products, backends, err := exporter.Export()
+	if err != nil {
+		return err
+	}
+
+	if exportOutputDir != "" {
+		if err := os.MkdirAll(exportOutputDir, 0755); err != nil {
+			return err
+		}
+	}
+
+	for _, backend := range backends {
+		if err := writeResource(exportResourcePath("backend", backend.Name), backend); err != nil {
+			return err
+		}
+	}
+
+	for _, product := range products {
+		if err := writeResource(exportResourcePath("product", product.Name), product); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// exportResourcePath returns the file of the exported resource.
+// Empty path, meaning standard output, when the output directory is not provided.
+func exportResourcePath(kind, name string) string {
+	if exportOutputDir == "" {
+		return ""
+	}
+
+	return filepath.Join(exportOutputDir, fmt.Sprintf("%s-%s.yaml", kind, name))
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+
+	exportCmd.Flags().StringVar(&exportAdminURL, "admin-url", "", "3scale tenant admin portal URL (default: THREESCALE_ADMIN_URL environment variable)")
+	exportCmd.Flags().StringVar(&exportAccessToken, "access-token", "", "3scale tenant access token (default: THREESCALE_ACCESS_TOKEN environment variable)")
+	exportCmd.Flags().StringSliceVar(&exportProducts, "product", nil, "System name of the product to export. Can be repeated (default: all products)")
+	exportCmd.Flags().StringVar(&exportProviderAccountRef, "provider-account-ref", "", "Name of the ProviderAccount resource or provider account secret referenced by the exported resources")
+	exportCmd.Flags().StringVar(&exportOutputDir, "output-dir", "", "Directory of the exported resource files (default: standard output)")
+}
@@ -0,0 +1,173 @@
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	"github.com/3scale/3scale-operator/pkg/openapi"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	openAPISystemName        string
+	openAPIBackendSystemName string
+	openAPIPrivateBaseURL    string
+	openAPIProductFile       string
+	openAPIBackendFile       string
+)
+
+// openAPICmd represents the openapi command
+var openAPICmd = &cobra.Command{
+	Use:   "openapi <openapi-file>",
+	Short: "Generate Product and Backend custom resources from an OpenAPI document",
+	Long: `Generate Product and Backend custom resources from an OpenAPI 2.0 or 3.0 document in JSON or YAML format.
+
+Every operation is imported as a product method and a product mapping rule.
+The backend private base URL is read from the document servers (3.0) or host and basePath (2.0).
+
+Resources are printed to the standard output, unless a resource file is provided.
+Existing resource files are updated in place.
+
+Examples:
+  generator openapi petstore.yaml
+  generator openapi petstore.yaml --product-file product.yaml --backend-file backend.yaml`,
+	Args: cobra.ExactArgs(1),
+	RunE: runOpenAPICommand,
+}
+
+func runOpenAPICommand(cmd *cobra.Command, args []string) error {
+	data, err := ioutil.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	doc, err := openapi.ParseDocument(data)
+	if err != nil {
+		return err
+	}
+
+	backend := &capabilitiesv1beta1.Backend{}
+	backendExists, err := readResourceFile(openAPIBackendFile, backend)
+	if err != nil {
+		return err
+	}
+
+	options := openapi.ImporterOptions{
+		SystemName:        openAPISystemName,
+		BackendSystemName: openAPIBackendSystemName,
+		PrivateBaseURL:    openAPIPrivateBaseURL,
+	}
+
+	// Existing backend is referenced by the product backend usage
+	if backendExists && options.BackendSystemName == "" {
+		options.BackendSystemName = backend.Spec.SystemName
+	}
+
+	importer := openapi.NewImporter(doc, options)
+
+	if backendExists {
+		err = importer.UpdateBackend(backend)
+	} else {
+		backend, err = importer.Backend()
+	}
+	if err != nil {
+		return err
+	}
+
+	product := &capabilitiesv1beta1.Product{}
+	productExists, err := readResourceFile(openAPIProductFile, product)
+	if err != nil {
+		return err
+	}
+
+	if productExists {
+		err = importer.UpdateProduct(product)
+	} else {
+		product, err = importer.Product()
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := writeResource(openAPIBackendFile, backend); err != nil {
+		return err
+	}
+
+	return writeResource(openAPIProductFile, product)
+}
+
+// readResourceFile reads the resource from an existing file.
+// Returns false when the path is empty or the file does not exist.
+func readResourceFile(path string, obj runtime.Object) (bool, error) {
+	if path == "" {
+		return false, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if err := yaml.Unmarshal(data, obj); err != nil {
+		return false, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	return true, nil
+}
+
+// writeResource serializes the resource in YAML format to the given file.
+// Standard output when path is empty.
+func writeResource(path string, obj runtime.Object) error {
+	serializedResult, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return err
+	}
+
+	// Drop fields not meant to be set by users
+	delete(serializedResult, "status")
+	if metadata, ok := serializedResult["metadata"].(map[string]interface{}); ok {
+		delete(metadata, "creationTimestamp")
+	}
+
+	data, err := yaml.Marshal(serializedResult)
+	if err != nil {
+		return err
+	}
+
+	if path != "" {
+		return ioutil.WriteFile(path, data, 0644)
+	}
+
+	_, err = fmt.Fprintf(os.Stdout, "---\n%s", data)
+	return err
+}
+
+func init() {
+	rootCmd.AddCommand(openAPICmd)
+
+	openAPICmd.Flags().StringVar(&openAPISystemName, "system-name", "", "Product system name (default: sanitized document info.title)")
+	openAPICmd.Flags().StringVar(&openAPIBackendSystemName, "backend-system-name", "", "Backend system name (default: product system name with backend suffix)")
+	openAPICmd.Flags().StringVar(&openAPIPrivateBaseURL, "private-base-url", "", "Backend private base URL (default: read from the document)")
+	openAPICmd.Flags().StringVar(&openAPIProductFile, "product-file", "", "Product resource file. Updated in place when it exists")
+	openAPICmd.Flags().StringVar(&openAPIBackendFile, "backend-file", "", "Backend resource file. Updated in place when it exists")
+}
@@ -0,0 +1,30 @@
+package component
+
+import (
+	"fmt"
+
+	"github.com/3scale/3scale-operator/pkg/assets"
+	"github.com/3scale/3scale-operator/pkg/common"
+	grafanav1alpha1 "github.com/integr8ly/grafana-operator/v3/pkg/apis/integreatly/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func ThreescaleOperatorGrafanaDashboard(ns string) *grafanav1alpha1.GrafanaDashboard {
+	data := &struct {
+		Namespace string
+	}{
+		ns,
+	}
+	return &grafanav1alpha1.GrafanaDashboard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "threescale-operator",
+			Labels: map[string]string{
+				"monitoring-key": common.MonitoringKey,
+			},
+		},
+		Spec: grafanav1alpha1.GrafanaDashboardSpec{
+			Json: assets.TemplateAsset("monitoring/threescale-operator-grafana-dashboard-1.json.tpl", data),
+			Name: fmt.Sprintf("%s/threescale-operator-grafana-dashboard-1.json", ns),
+		},
+	}
+}
@@ -11,6 +11,22 @@ import (
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
+const (
+	// TenantFinalizer is the finalizer set on Tenant resources
+	// to schedule the 3scale tenant account deletion when the resource is deleted
+	TenantFinalizer = "tenant.capabilities.3scale.net/finalizer"
+
+	// OrphanOnDeleteAnnotation when set to "true", the 3scale tenant account is not scheduled for deletion
+	// when the custom resource is deleted
+	OrphanOnDeleteAnnotation = "capabilities.3scale.net/orphan-on-delete"
+
+	// TenantStateActive is the desired state of an active tenant account
+	TenantStateActive = "active"
+
+	// TenantStateSuspended is the desired state of a suspended tenant account
+	TenantStateSuspended = "suspended"
+)
+
 // TenantSpec defines the desired state of Tenant
 // +k8s:openapi-gen=true
 type TenantSpec struct {
@@ -21,6 +37,11 @@ type TenantSpec struct {
 	TenantSecretRef        v1.SecretReference `json:"tenantSecretRef"`
 	PasswordCredentialsRef v1.SecretReference `json:"passwordCredentialsRef"`
 	MasterCredentialsRef   v1.SecretReference `json:"masterCredentialsRef"`
+	// State is the desired state of the tenant account.
+	// When not set, tenant account state is not reconciled.
+	// +kubebuilder:validation:Enum=active;suspended
+	// +optional
+	State *string `json:"state,omitempty"`
 }
 
 // TenantStatus defines the observed state of Tenant
@@ -28,6 +49,9 @@ type TenantSpec struct {
 type TenantStatus struct {
 	TenantId int64 `json:"tenantId"`
 	AdminId  int64 `json:"adminId"`
+	// State is the current state of the tenant account in 3scale
+	// +optional
+	State string `json:"state,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -45,6 +69,12 @@ type Tenant struct {
 	Status TenantStatus `json:"status,omitempty"`
 }
 
+// OrphanOnDelete returns true when the 3scale tenant account must not be scheduled for deletion
+// when the resource is deleted
+func (t *Tenant) OrphanOnDelete() bool {
+	return t.GetAnnotations()[OrphanOnDeleteAnnotation] == "true"
+}
+
 // SetDefaults sets the default vaules for the tenant spec and returns true if the spec was changed
 func (t *Tenant) SetDefaults() bool {
 	changed := false
@@ -13,7 +13,7 @@ func (in *Tenant) DeepCopyInto(out *Tenant) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	out.Status = in.Status
 	return
 }
@@ -75,6 +75,11 @@ func (in *TenantSpec) DeepCopyInto(out *TenantSpec) {
 	out.TenantSecretRef = in.TenantSecretRef
 	out.PasswordCredentialsRef = in.PasswordCredentialsRef
 	out.MasterCredentialsRef = in.MasterCredentialsRef
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -107,6 +107,13 @@ func schema_pkg_apis_capabilities_v1alpha1_TenantSpec(ref common.ReferenceCallba
 							Ref: ref("k8s.io/api/core/v1.SecretReference"),
 						},
 					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the desired state of the tenant account. When not set, tenant account state is not reconciled.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"username", "email", "organizationName", "systemMasterUrl", "tenantSecretRef", "passwordCredentialsRef", "masterCredentialsRef"},
 			},
@@ -135,6 +142,13 @@ func schema_pkg_apis_capabilities_v1alpha1_TenantStatus(ref common.ReferenceCall
 							Format: "int64",
 						},
 					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the current state of the tenant account in 3scale",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"tenantId", "adminId"},
 			},
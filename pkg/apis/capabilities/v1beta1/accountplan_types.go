@@ -0,0 +1,203 @@
+package v1beta1
+
+import (
+	"reflect"
+
+	"github.com/3scale/3scale-operator/pkg/common"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	AccountPlanKind = "AccountPlan"
+
+	// AccountPlanFinalizer is the finalizer set on AccountPlan resources
+	// to remove the 3scale account plan when the resource is deleted
+	AccountPlanFinalizer = "accountplan.capabilities.3scale.net/finalizer"
+
+	// AccountPlanInvalidConditionType represents that the combination of configuration
+	// in the AccountPlanSpec is not supported. This is not a transient error, but
+	// indicates a state that must be fixed before progress can be made.
+	AccountPlanInvalidConditionType common.ConditionType = "Invalid"
+
+	// AccountPlanSyncedConditionType indicates the account plan has been successfully synchronized.
+	// Steady state
+	AccountPlanSyncedConditionType common.ConditionType = "Synced"
+
+	// AccountPlanFailedConditionType indicates that an error occurred during synchronization.
+	// The operator will retry.
+	AccountPlanFailedConditionType common.ConditionType = "Failed"
+
+	// AccountPlanUnavailableConditionType indicates that the 3scale admin portal could not be reached
+	// during synchronization. The operator will retry once 3scale is available again.
+	AccountPlanUnavailableConditionType common.ConditionType = "Unavailable"
+)
+
+// AccountPlanSpec defines the desired state of AccountPlan
+type AccountPlanSpec struct {
+	// SystemName identifies the account plan in the tenant. It cannot be modified once created
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_\-]+$`
+	SystemName string `json:"systemName"`
+
+	// Name is the friendly name of the account plan.
+	// Defaults to the system name on creation
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Set whether or not developer accounts can sign up on demand
+	// or if approval is required from you before they are activated.
+	// +optional
+	AccountsRequireApproval *bool `json:"accountsRequireApproval,omitempty"`
+
+	// Trial Period (days)
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TrialPeriod *int `json:"trialPeriod,omitempty"`
+
+	// Setup fee (USD)
+	// +kubebuilder:validation:Pattern=`^\d+(\.\d{2})?$`
+	// +optional
+	SetupFee *string `json:"setupFee,omitempty"`
+
+	// Cost per Month (USD)
+	// +kubebuilder:validation:Pattern=`^\d+(\.\d{2})?$`
+	// +optional
+	CostMonth *string `json:"costMonth,omitempty"`
+
+	// Published makes the plan available for developers to sign up. Otherwise, the plan is hidden.
+	// When not set, the plan state is not reconciled
+	// +optional
+	Published *bool `json:"published,omitempty"`
+
+	// Adopt allows taking over an existing 3scale account plan with the same system name
+	// not created by this resource. Otherwise, the existing 3scale account plan is reported as a conflict.
+	// +optional
+	Adopt bool `json:"adopt,omitempty"`
+
+	// ProviderAccountRef references account provider credentials
+	// +optional
+	ProviderAccountRef *ProviderAccountReference `json:"providerAccountRef,omitempty"`
+}
+
+// AccountPlanStatus defines the observed state of AccountPlan
+type AccountPlanStatus struct {
+	// +optional
+	ID *int64 `json:"accountPlanId,omitempty"`
+
+	// State is the current state of the 3scale account plan
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// 3scale control plane host
+	// +optional
+	ProviderAccountHost string `json:"providerAccountHost,omitempty"`
+
+	// ObservedGeneration reflects the generation of the most recently observed AccountPlan Spec.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Current state of the 3scale account plan.
+	// Conditions represent the latest available observations of an object's state
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions common.Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,2,rep,name=conditions"`
+}
+
+func (a *AccountPlanStatus) Equals(other *AccountPlanStatus, logger logr.Logger) bool {
+	if !reflect.DeepEqual(a.ID, other.ID) {
+		diff := cmp.Diff(a.ID, other.ID)
+		logger.V(1).Info("ID not equal", "difference", diff)
+		return false
+	}
+
+	if a.State != other.State {
+		diff := cmp.Diff(a.State, other.State)
+		logger.V(1).Info("State not equal", "difference", diff)
+		return false
+	}
+
+	if a.ProviderAccountHost != other.ProviderAccountHost {
+		diff := cmp.Diff(a.ProviderAccountHost, other.ProviderAccountHost)
+		logger.V(1).Info("ProviderAccountHost not equal", "difference", diff)
+		return false
+	}
+
+	if a.ObservedGeneration != other.ObservedGeneration {
+		diff := cmp.Diff(a.ObservedGeneration, other.ObservedGeneration)
+		logger.V(1).Info("ObservedGeneration not equal", "difference", diff)
+		return false
+	}
+
+	// Marshalling sorts by condition type
+	currentMarshaledJSON, _ := a.Conditions.MarshalJSON()
+	otherMarshaledJSON, _ := other.Conditions.MarshalJSON()
+	if string(currentMarshaledJSON) != string(otherMarshaledJSON) {
+		diff := cmp.Diff(string(currentMarshaledJSON), string(otherMarshaledJSON))
+		logger.V(1).Info("Conditions not equal", "difference", diff)
+		return false
+	}
+
+	return true
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccountPlan is the Schema for the accountplans API
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=accountplans,scope=Namespaced
+// +operator-sdk:gen-csv:customresourcedefinitions.displayName="3scale AccountPlan"
+type AccountPlan struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccountPlanSpec   `json:"spec,omitempty"`
+	Status AccountPlanStatus `json:"status,omitempty"`
+}
+
+func (plan *AccountPlan) Validate() field.ErrorList {
+	errors := field.ErrorList{}
+
+	specFldPath := field.NewPath("spec")
+	if plan.Spec.SystemName == "" {
+		errors = append(errors, field.Required(specFldPath.Child("systemName"), "system name must not be empty"))
+	}
+
+	return errors
+}
+
+func (plan *AccountPlan) IsSynced() bool {
+	return plan.Status.Conditions.IsTrueFor(AccountPlanSyncedConditionType)
+}
+
+// Owns returns true when the 3scale account plan with the given ID was created or adopted by this resource.
+// When the ID is unknown, the account plan claimed with its system name is owned
+func (plan *AccountPlan) Owns(id int64) bool {
+	if plan.Status.ID != nil {
+		return *plan.Status.ID == id
+	}
+	claim := plan.GetAnnotations()[ClaimedSystemNameAnnotation]
+	return claim != "" && claim == plan.Spec.SystemName
+}
+
+// OrphanOnDelete returns true when the 3scale account plan must not be deleted
+// when the resource is deleted
+func (plan *AccountPlan) OrphanOnDelete() bool {
+	return plan.GetAnnotations()[OrphanOnDeleteAnnotation] == "true"
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccountPlanList contains a list of AccountPlan
+type AccountPlanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccountPlan `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AccountPlan{}, &AccountPlanList{})
+}
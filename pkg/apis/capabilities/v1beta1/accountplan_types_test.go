@@ -0,0 +1,39 @@
+package v1beta1
+
+import (
+	"testing"
+)
+
+func TestValidateAccountPlan(t *testing.T) {
+	cases := []struct {
+		testName  string
+		spec      AccountPlanSpec
+		expectErr bool
+	}{
+		{"empty", AccountPlanSpec{}, true},
+		{"valid", AccountPlanSpec{SystemName: "partners"}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			plan := AccountPlan{Spec: tc.spec}
+			errors := plan.Validate()
+			if tc.expectErr != (len(errors) > 0) {
+				subT.Errorf("expected error: %t, got: %v", tc.expectErr, errors)
+			}
+		})
+	}
+}
+
+func TestAccountPlanOwns(t *testing.T) {
+	plan := AccountPlan{}
+	if plan.Owns(1) {
+		t.Error("account plan without ID must not own any 3scale account plan")
+	}
+
+	id := int64(1)
+	plan.Status.ID = &id
+	if !plan.Owns(1) || plan.Owns(2) {
+		t.Errorf("account plan must only own 3scale account plan %d", id)
+	}
+}
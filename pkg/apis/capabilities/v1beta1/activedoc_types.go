@@ -0,0 +1,269 @@
+package v1beta1
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/3scale/3scale-operator/pkg/common"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	ActiveDocKind = "ActiveDoc"
+
+	// ActiveDocFinalizer is the finalizer set on ActiveDoc resources
+	// to remove the 3scale activedoc when the resource is deleted
+	ActiveDocFinalizer = "activedoc.capabilities.3scale.net/finalizer"
+
+	// ActiveDocInvalidConditionType represents that the combination of configuration
+	// in the ActiveDocSpec is not supported. This is not a transient error, but
+	// indicates a state that must be fixed before progress can be made.
+	// Example: the spec does not have OpenAPI document source
+	ActiveDocInvalidConditionType common.ConditionType = "Invalid"
+
+	// ActiveDocOrphanConditionType represents that the spec references
+	// a product that does not exist in 3scale.
+	ActiveDocOrphanConditionType common.ConditionType = "Orphan"
+
+	// ActiveDocSyncedConditionType indicates the activedoc has been successfully synchronized.
+	// Steady state
+	ActiveDocSyncedConditionType common.ConditionType = "Synced"
+
+	// ActiveDocFailedConditionType indicates that an error occurred during synchronization.
+	// The operator will retry.
+	ActiveDocFailedConditionType common.ConditionType = "Failed"
+
+	// ActiveDocUnavailableConditionType indicates that the 3scale admin portal could not be reached
+	// during synchronization. The operator will retry once 3scale is available again.
+	ActiveDocUnavailableConditionType common.ConditionType = "Unavailable"
+)
+
+var (
+	//
+	activeDocSystemNameRegexp = regexp.MustCompile("[^a-zA-Z0-9]+")
+)
+
+// ActiveDocOpenAPIRefSpec defines the source of the OpenAPI document.
+// Only one source can be set.
+type ActiveDocOpenAPIRefSpec struct {
+	// Inline OpenAPI document in JSON or YAML format
+	// +optional
+	Inline *string `json:"inline,omitempty"`
+
+	// ConfigMapKeyRef selects a key of a ConfigMap holding the OpenAPI document in JSON or YAML format
+	// +optional
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// SecretKeyRef selects a key of a Secret holding the OpenAPI document in JSON or YAML format
+	// +optional
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// ActiveDocSpec defines the desired state of ActiveDoc
+type ActiveDocSpec struct {
+	// Name is human readable name for the activedoc
+	Name string `json:"name"`
+
+	// SystemName identifies uniquely the activedoc within the account provider
+	// Default value will be sanitized Name
+	// +optional
+	SystemName string `json:"systemName,omitempty"`
+
+	// Description is a human readable text of the activedoc
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// ActiveDocOpenAPIRef defines the source of the OpenAPI document
+	ActiveDocOpenAPIRef ActiveDocOpenAPIRefSpec `json:"activeDocOpenAPIRef"`
+
+	// ProductSystemName identifies uniquely the product the activedoc is bound to
+	// +optional
+	ProductSystemName *string `json:"productSystemName,omitempty"`
+
+	// Published switches to published the activedoc
+	// +optional
+	Published *bool `json:"published,omitempty"`
+
+	// SkipSwaggerValidations switches to skip OpenAPI validation
+	// +optional
+	SkipSwaggerValidations *bool `json:"skipSwaggerValidations,omitempty"`
+
+	// Adopt allows taking over an existing 3scale activedoc with the same system name
+	// not created by this resource. Otherwise, the existing 3scale activedoc is reported as a conflict.
+	// +optional
+	Adopt bool `json:"adopt,omitempty"`
+
+	// ProviderAccountRef references account provider credentials
+	// +optional
+	ProviderAccountRef *ProviderAccountReference `json:"providerAccountRef,omitempty"`
+}
+
+// ActiveDocStatus defines the observed state of ActiveDoc
+type ActiveDocStatus struct {
+	// +optional
+	ID *int64 `json:"activeDocId,omitempty"`
+
+	// ProductResolvedID is the ID of the product the activedoc is bound to
+	// +optional
+	ProductResolvedID *int64 `json:"productResolvedId,omitempty"`
+
+	// 3scale control plane host
+	// +optional
+	ProviderAccountHost string `json:"providerAccountHost,omitempty"`
+
+	// ObservedGeneration reflects the generation of the most recently observed ActiveDoc Spec.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Current state of the 3scale activedoc.
+	// Conditions represent the latest available observations of an object's state
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions common.Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,2,rep,name=conditions"`
+}
+
+func (a *ActiveDocStatus) Equals(other *ActiveDocStatus, logger logr.Logger) bool {
+	if !reflect.DeepEqual(a.ID, other.ID) {
+		diff := cmp.Diff(a.ID, other.ID)
+		logger.V(1).Info("ID not equal", "difference", diff)
+		return false
+	}
+
+	if !reflect.DeepEqual(a.ProductResolvedID, other.ProductResolvedID) {
+		diff := cmp.Diff(a.ProductResolvedID, other.ProductResolvedID)
+		logger.V(1).Info("ProductResolvedID not equal", "difference", diff)
+		return false
+	}
+
+	if a.ProviderAccountHost != other.ProviderAccountHost {
+		diff := cmp.Diff(a.ProviderAccountHost, other.ProviderAccountHost)
+		logger.V(1).Info("ProviderAccountHost not equal", "difference", diff)
+		return false
+	}
+
+	if a.ObservedGeneration != other.ObservedGeneration {
+		diff := cmp.Diff(a.ObservedGeneration, other.ObservedGeneration)
+		logger.V(1).Info("ObservedGeneration not equal", "difference", diff)
+		return false
+	}
+
+	// Marshalling sorts by condition type
+	currentMarshaledJSON, _ := a.Conditions.MarshalJSON()
+	otherMarshaledJSON, _ := other.Conditions.MarshalJSON()
+	if string(currentMarshaledJSON) != string(otherMarshaledJSON) {
+		diff := cmp.Diff(string(currentMarshaledJSON), string(otherMarshaledJSON))
+		logger.V(1).Info("Conditions not equal", "difference", diff)
+		return false
+	}
+
+	return true
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ActiveDoc is the Schema for the activedocs API
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=activedocs,scope=Namespaced
+// +operator-sdk:gen-csv:customresourcedefinitions.displayName="3scale ActiveDoc"
+type ActiveDoc struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ActiveDocSpec   `json:"spec,omitempty"`
+	Status ActiveDocStatus `json:"status,omitempty"`
+}
+
+func (activeDoc *ActiveDoc) SetDefaults(logger logr.Logger) bool {
+	updated := false
+
+	// Respect 3scale API defaults
+	if activeDoc.Spec.SystemName == "" {
+		activeDoc.Spec.SystemName = activeDocSystemNameRegexp.ReplaceAllString(activeDoc.Spec.Name, "")
+		updated = true
+	}
+
+	// 3scale API ignores case of the system name field
+	systemNameLowercase := strings.ToLower(activeDoc.Spec.SystemName)
+	if activeDoc.Spec.SystemName != systemNameLowercase {
+		logger.Info("System name updated", "from", activeDoc.Spec.SystemName, "to", systemNameLowercase)
+		activeDoc.Spec.SystemName = systemNameLowercase
+		updated = true
+	}
+
+	return updated
+}
+
+// UsesConfigMap returns true when the OpenAPI document is read from the configmap
+func (activeDoc *ActiveDoc) UsesConfigMap(configMap *corev1.ConfigMap) bool {
+	ref := activeDoc.Spec.ActiveDocOpenAPIRef.ConfigMapKeyRef
+	return ref != nil && ref.Name == configMap.Name && activeDoc.Namespace == configMap.Namespace
+}
+
+// UsesSecret returns true when the OpenAPI document is read from the secret
+func (activeDoc *ActiveDoc) UsesSecret(secret *corev1.Secret) bool {
+	ref := activeDoc.Spec.ActiveDocOpenAPIRef.SecretKeyRef
+	return ref != nil && ref.Name == secret.Name && activeDoc.Namespace == secret.Namespace
+}
+
+func (activeDoc *ActiveDoc) Validate() field.ErrorList {
+	errors := field.ErrorList{}
+
+	// check exactly one OpenAPI document source is set
+	openAPIRefFldPath := field.NewPath("spec").Child("activeDocOpenAPIRef")
+	sources := 0
+	if activeDoc.Spec.ActiveDocOpenAPIRef.Inline != nil {
+		sources++
+	}
+	if activeDoc.Spec.ActiveDocOpenAPIRef.ConfigMapKeyRef != nil {
+		sources++
+	}
+	if activeDoc.Spec.ActiveDocOpenAPIRef.SecretKeyRef != nil {
+		sources++
+	}
+
+	if sources != 1 {
+		errors = append(errors, field.Invalid(openAPIRefFldPath, nil, "exactly one of inline, configMapKeyRef or secretKeyRef must be set"))
+	}
+
+	return errors
+}
+
+func (activeDoc *ActiveDoc) IsSynced() bool {
+	return activeDoc.Status.Conditions.IsTrueFor(ActiveDocSyncedConditionType)
+}
+
+// Owns returns true when the 3scale activedoc with the given ID was created or adopted by this resource.
+// When the ID is unknown, the activedoc claimed with its system name is owned
+func (activeDoc *ActiveDoc) Owns(id int64) bool {
+	if activeDoc.Status.ID != nil {
+		return *activeDoc.Status.ID == id
+	}
+	claim := activeDoc.GetAnnotations()[ClaimedSystemNameAnnotation]
+	return claim != "" && claim == activeDoc.Spec.SystemName
+}
+
+// OrphanOnDelete returns true when the 3scale activedoc must not be deleted
+// when the resource is deleted
+func (activeDoc *ActiveDoc) OrphanOnDelete() bool {
+	return activeDoc.GetAnnotations()[OrphanOnDeleteAnnotation] == "true"
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ActiveDocList contains a list of ActiveDoc
+type ActiveDocList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ActiveDoc `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ActiveDoc{}, &ActiveDocList{})
+}
@@ -0,0 +1,78 @@
+package v1beta1
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestActiveDocSetDefaults(t *testing.T) {
+	activeDoc := ActiveDoc{
+		Spec: ActiveDocSpec{
+			Name: "Pet Store API",
+		},
+	}
+
+	if !activeDoc.SetDefaults(getv1beta1TestLogger()) {
+		t.Error("activedoc defaults not updated")
+	}
+
+	if activeDoc.Spec.SystemName != "petstoreapi" {
+		t.Errorf("activedoc system name default: expected petstoreapi, got %s", activeDoc.Spec.SystemName)
+	}
+}
+
+func TestValidateActiveDocOpenAPIRef(t *testing.T) {
+	inline := "openapi: 3.0.0"
+	configMapRef := &corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "petstore"},
+		Key:                  "openapi.yaml",
+	}
+
+	cases := []struct {
+		testName  string
+		openAPI   ActiveDocOpenAPIRefSpec
+		expectErr bool
+	}{
+		{"empty", ActiveDocOpenAPIRefSpec{}, true},
+		{"inline", ActiveDocOpenAPIRefSpec{Inline: &inline}, false},
+		{"configmap", ActiveDocOpenAPIRefSpec{ConfigMapKeyRef: configMapRef}, false},
+		{"inline and configmap", ActiveDocOpenAPIRefSpec{Inline: &inline, ConfigMapKeyRef: configMapRef}, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			activeDoc := ActiveDoc{
+				Spec: ActiveDocSpec{
+					Name:                "petstore",
+					ActiveDocOpenAPIRef: tc.openAPI,
+				},
+			}
+
+			errors := activeDoc.Validate()
+			hasErr := len(errors) > 0 && strings.Contains(errors.ToAggregate().Error(), "exactly one of")
+			if hasErr != tc.expectErr {
+				subT.Errorf("expected error: %t, got: %v", tc.expectErr, errors)
+			}
+		})
+	}
+}
+
+func TestActiveDocOwns(t *testing.T) {
+	activeDoc := ActiveDoc{Spec: ActiveDocSpec{SystemName: "activedoc1"}}
+	if activeDoc.Owns(1) {
+		t.Error("activedoc without ID nor claim must not own any 3scale activedoc")
+	}
+
+	activeDoc.Annotations = map[string]string{ClaimedSystemNameAnnotation: "activedoc1"}
+	if !activeDoc.Owns(1) {
+		t.Error("activedoc must own the 3scale activedoc claimed with its system name")
+	}
+
+	id := int64(1)
+	activeDoc.Status.ID = &id
+	if !activeDoc.Owns(1) || activeDoc.Owns(2) {
+		t.Errorf("activedoc must only own 3scale activedoc %d", id)
+	}
+}
@@ -0,0 +1,246 @@
+package v1beta1
+
+import (
+	"reflect"
+
+	"github.com/3scale/3scale-operator/pkg/common"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	ApplicationKind = "Application"
+
+	// ApplicationFinalizer is the finalizer set on Application resources
+	// to remove the 3scale application when the resource is deleted
+	ApplicationFinalizer = "application.capabilities.3scale.net/finalizer"
+
+	// Application credentials secret field names
+	ApplicationUserKeySecretField = "user_key"
+	ApplicationAppIDSecretField   = "app_id"
+	ApplicationAppKeySecretField  = "app_key"
+
+	// Application states
+	ApplicationStateLive      = "live"
+	ApplicationStateSuspended = "suspended"
+
+	// ClaimedApplicationNameAnnotation holds the name of the 3scale application created by the custom resource.
+	// Set before creating the 3scale application, it keeps the ownership when the ID recorded in the status is lost
+	ClaimedApplicationNameAnnotation = "capabilities.3scale.net/claimed-application-name"
+
+	// ApplicationInvalidConditionType represents that the combination of configuration
+	// in the ApplicationSpec is not supported. This is not a transient error, but
+	// indicates a state that must be fixed before progress can be made.
+	ApplicationInvalidConditionType common.ConditionType = "Invalid"
+
+	// ApplicationOrphanConditionType represents that the spec references
+	// a developer account, product or application plan that is not found or not yet synchronized.
+	ApplicationOrphanConditionType common.ConditionType = "Orphan"
+
+	// ApplicationSyncedConditionType indicates the application has been successfully synchronized.
+	// Steady state
+	ApplicationSyncedConditionType common.ConditionType = "Synced"
+
+	// ApplicationFailedConditionType indicates that an error occurred during synchronization.
+	// The operator will retry.
+	ApplicationFailedConditionType common.ConditionType = "Failed"
+
+	// ApplicationUnavailableConditionType indicates that the 3scale admin portal could not be reached
+	// during synchronization. The operator will retry once 3scale is available again.
+	ApplicationUnavailableConditionType common.ConditionType = "Unavailable"
+)
+
+// ApplicationSpec defines the desired state of Application
+type ApplicationSpec struct {
+	// Name is human readable name for the application
+	Name string `json:"name"`
+
+	// Description is a human readable text of the application
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// DeveloperAccountRef references the DeveloperAccount resource owning the application
+	DeveloperAccountRef corev1.LocalObjectReference `json:"developerAccountRef"`
+
+	// ProductRef references the Product resource the application subscribes to
+	ProductRef corev1.LocalObjectReference `json:"productRef"`
+
+	// ApplicationPlanName is the application plan key in the referenced product applicationPlans
+	ApplicationPlanName string `json:"applicationPlanName"`
+
+	// Suspend switches the application to suspended state.
+	// When not set, the application state is not reconciled
+	// +optional
+	Suspend *bool `json:"suspend,omitempty"`
+
+	// CredentialsSecretName is the name of the secret the application credentials are written to.
+	// Default value is the resource name with credentials suffix
+	// +optional
+	CredentialsSecretName string `json:"credentialsSecretName,omitempty"`
+
+	// Adopt allows taking over an existing 3scale application with the same name and product
+	// not created by this resource. Otherwise, the existing 3scale application is reported as a conflict.
+	// +optional
+	Adopt bool `json:"adopt,omitempty"`
+
+	// ProviderAccountRef references account provider credentials
+	// +optional
+	ProviderAccountRef *ProviderAccountReference `json:"providerAccountRef,omitempty"`
+}
+
+// ApplicationStatus defines the observed state of Application
+type ApplicationStatus struct {
+	// +optional
+	ID *int64 `json:"applicationId,omitempty"`
+
+	// DeveloperAccountID is the ID of the 3scale developer account owning the application
+	// +optional
+	DeveloperAccountID *int64 `json:"developerAccountId,omitempty"`
+
+	// State is the current state of the 3scale application
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// 3scale control plane host
+	// +optional
+	ProviderAccountHost string `json:"providerAccountHost,omitempty"`
+
+	// ObservedGeneration reflects the generation of the most recently observed Application Spec.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Current state of the 3scale application.
+	// Conditions represent the latest available observations of an object's state
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions common.Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,2,rep,name=conditions"`
+}
+
+func (a *ApplicationStatus) Equals(other *ApplicationStatus, logger logr.Logger) bool {
+	if !reflect.DeepEqual(a.ID, other.ID) {
+		diff := cmp.Diff(a.ID, other.ID)
+		logger.V(1).Info("ID not equal", "difference", diff)
+		return false
+	}
+
+	if !reflect.DeepEqual(a.DeveloperAccountID, other.DeveloperAccountID) {
+		diff := cmp.Diff(a.DeveloperAccountID, other.DeveloperAccountID)
+		logger.V(1).Info("DeveloperAccountID not equal", "difference", diff)
+		return false
+	}
+
+	if a.State != other.State {
+		diff := cmp.Diff(a.State, other.State)
+		logger.V(1).Info("State not equal", "difference", diff)
+		return false
+	}
+
+	if a.ProviderAccountHost != other.ProviderAccountHost {
+		diff := cmp.Diff(a.ProviderAccountHost, other.ProviderAccountHost)
+		logger.V(1).Info("ProviderAccountHost not equal", "difference", diff)
+		return false
+	}
+
+	if a.ObservedGeneration != other.ObservedGeneration {
+		diff := cmp.Diff(a.ObservedGeneration, other.ObservedGeneration)
+		logger.V(1).Info("ObservedGeneration not equal", "difference", diff)
+		return false
+	}
+
+	// Marshalling sorts by condition type
+	currentMarshaledJSON, _ := a.Conditions.MarshalJSON()
+	otherMarshaledJSON, _ := other.Conditions.MarshalJSON()
+	if string(currentMarshaledJSON) != string(otherMarshaledJSON) {
+		diff := cmp.Diff(string(currentMarshaledJSON), string(otherMarshaledJSON))
+		logger.V(1).Info("Conditions not equal", "difference", diff)
+		return false
+	}
+
+	return true
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Application is the Schema for the applications API
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=applications,scope=Namespaced
+// +operator-sdk:gen-csv:customresourcedefinitions.displayName="3scale Application"
+type Application struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApplicationSpec   `json:"spec,omitempty"`
+	Status ApplicationStatus `json:"status,omitempty"`
+}
+
+func (application *Application) SetDefaults(logger logr.Logger) bool {
+	updated := false
+
+	if application.Spec.CredentialsSecretName == "" {
+		application.Spec.CredentialsSecretName = application.Name + "-credentials"
+		updated = true
+	}
+
+	return updated
+}
+
+func (application *Application) Validate() field.ErrorList {
+	errors := field.ErrorList{}
+
+	specFldPath := field.NewPath("spec")
+	if application.Spec.Name == "" {
+		errors = append(errors, field.Required(specFldPath.Child("name"), "application name must not be empty"))
+	}
+
+	if application.Spec.DeveloperAccountRef.Name == "" {
+		errors = append(errors, field.Required(specFldPath.Child("developerAccountRef").Child("name"), "developer account reference must not be empty"))
+	}
+
+	if application.Spec.ProductRef.Name == "" {
+		errors = append(errors, field.Required(specFldPath.Child("productRef").Child("name"), "product reference must not be empty"))
+	}
+
+	if application.Spec.ApplicationPlanName == "" {
+		errors = append(errors, field.Required(specFldPath.Child("applicationPlanName"), "application plan name must not be empty"))
+	}
+
+	return errors
+}
+
+func (application *Application) IsSynced() bool {
+	return application.Status.Conditions.IsTrueFor(ApplicationSyncedConditionType)
+}
+
+// Owns returns true when the 3scale application was created by the resource.
+// When the ID is not recorded, the application of the claimed name is owned
+func (application *Application) Owns(id int64) bool {
+	if application.Status.ID != nil {
+		return *application.Status.ID == id
+	}
+	claim := application.GetAnnotations()[ClaimedApplicationNameAnnotation]
+	return claim != "" && claim == application.Spec.Name
+}
+
+// OrphanOnDelete returns true when the 3scale application must not be deleted
+// when the resource is deleted
+func (application *Application) OrphanOnDelete() bool {
+	return application.GetAnnotations()[OrphanOnDeleteAnnotation] == "true"
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ApplicationList contains a list of Application
+type ApplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Application `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Application{}, &ApplicationList{})
+}
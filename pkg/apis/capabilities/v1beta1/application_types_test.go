@@ -0,0 +1,76 @@
+package v1beta1
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestApplicationSetDefaults(t *testing.T) {
+	application := Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "myapp"},
+	}
+
+	if !application.SetDefaults(getv1beta1TestLogger()) {
+		t.Error("application defaults not updated")
+	}
+
+	if application.Spec.CredentialsSecretName != "myapp-credentials" {
+		t.Errorf("application credentials secret name default: expected myapp-credentials, got %s", application.Spec.CredentialsSecretName)
+	}
+
+	if application.SetDefaults(getv1beta1TestLogger()) {
+		t.Error("application defaults updated twice")
+	}
+}
+
+func TestValidateApplication(t *testing.T) {
+	validSpec := ApplicationSpec{
+		Name:                "myapp",
+		DeveloperAccountRef: corev1.LocalObjectReference{Name: "developeraccount1"},
+		ProductRef:          corev1.LocalObjectReference{Name: "product1"},
+		ApplicationPlanName: "basic",
+	}
+
+	missingPlanSpec := validSpec
+	missingPlanSpec.ApplicationPlanName = ""
+
+	cases := []struct {
+		testName  string
+		spec      ApplicationSpec
+		expectErr bool
+	}{
+		{"empty", ApplicationSpec{}, true},
+		{"missing plan", missingPlanSpec, true},
+		{"valid", validSpec, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			application := Application{Spec: tc.spec}
+			errors := application.Validate()
+			if tc.expectErr != (len(errors) > 0) {
+				subT.Errorf("expected error: %t, got: %v", tc.expectErr, errors)
+			}
+		})
+	}
+}
+
+func TestApplicationOwns(t *testing.T) {
+	application := Application{Spec: ApplicationSpec{Name: "myapp"}}
+	if application.Owns(1) {
+		t.Error("application without ID nor claim must not own any 3scale application")
+	}
+
+	application.SetAnnotations(map[string]string{ClaimedApplicationNameAnnotation: "myapp"})
+	if !application.Owns(1) {
+		t.Error("application must own the 3scale application of the claimed name")
+	}
+
+	id := int64(1)
+	application.Status.ID = &id
+	if !application.Owns(1) || application.Owns(2) {
+		t.Errorf("application must only own 3scale application %d", id)
+	}
+}
@@ -9,7 +9,6 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
-	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
@@ -20,6 +19,10 @@ import (
 const (
 	BackendKind = "Backend"
 
+	// BackendFinalizer is the finalizer set on Backend resources
+	// to remove the 3scale backend when the resource is deleted
+	BackendFinalizer = "backend.capabilities.3scale.net/finalizer"
+
 	// BackendInvalidConditionType represents that the combination of configuration
 	// in the BackendSpec is not supported. This is not a transient error, but
 	// indicates a state that must be fixed before progress can be made.
@@ -33,6 +36,15 @@ const (
 	// BackendFailedConditionType indicates that an error occurred during synchronization.
 	// The operator will retry.
 	BackendFailedConditionType common.ConditionType = "Failed"
+
+	// BackendUnavailableConditionType indicates that the 3scale admin portal could not be reached
+	// during synchronization. The operator will retry once 3scale is available again.
+	BackendUnavailableConditionType common.ConditionType = "Unavailable"
+
+	// BackendOutOfSyncConditionType indicates the 3scale backend was changed outside the operator
+	// after the BackendSpec had been synchronized. The message lists the drifted sections.
+	// With the report drift policy, the drift remains in 3scale until it is fixed.
+	BackendOutOfSyncConditionType common.ConditionType = "OutOfSync"
 )
 
 var (
@@ -81,9 +93,26 @@ type BackendSpec struct {
 	// +optional
 	Methods map[string]MethodSpec `json:"methods,omitempty"`
 
+	// DriftPolicy defines how 3scale changes not made through the custom resource are handled.
+	// "correct" overwrites them with the spec, "report" only reports them in the OutOfSync condition.
+	// Default value is "correct"
+	// +kubebuilder:validation:Enum=correct;report
+	// +optional
+	DriftPolicy *string `json:"driftPolicy,omitempty"`
+
+	// Adopt allows taking over an existing 3scale backend with the same system name
+	// not created by this resource. Otherwise, the existing 3scale backend is reported as a conflict.
+	// +optional
+	Adopt bool `json:"adopt,omitempty"`
+
 	// ProviderAccountRef references account provider credentials
 	// +optional
-	ProviderAccountRef *corev1.LocalObjectReference `json:"providerAccountRef,omitempty"`
+	ProviderAccountRef *ProviderAccountReference `json:"providerAccountRef,omitempty"`
+}
+
+// ReportDriftOnly returns true when drift must be reported and not corrected
+func (s *BackendSpec) ReportDriftOnly() bool {
+	return s.DriftPolicy != nil && *s.DriftPolicy == DriftPolicyReport
 }
 
 // BackendStatus defines the observed state of Backend
@@ -264,6 +293,28 @@ func (backend *Backend) IsSynced() bool {
 	return backend.Status.Conditions.IsTrueFor(BackendSyncedConditionType)
 }
 
+// OrphanOnDelete returns true when the 3scale backend must not be deleted
+// when the resource is deleted
+func (backend *Backend) OrphanOnDelete() bool {
+	return backend.GetAnnotations()[OrphanOnDeleteAnnotation] == "true"
+}
+
+// Owns returns true when the 3scale backend with the given ID was created or adopted by the resource.
+// The ID of the managed 3scale backend is recorded in the status.
+// When unknown, the backend claimed with its system name is owned
+func (backend *Backend) Owns(id int64) bool {
+	if backend.Status.ID != nil {
+		return *backend.Status.ID == id
+	}
+	return backend.ClaimsSystemName()
+}
+
+// ClaimsSystemName returns true when the resource created, or requested the creation of, the 3scale backend with its system name
+func (backend *Backend) ClaimsSystemName() bool {
+	claim := backend.GetAnnotations()[ClaimedSystemNameAnnotation]
+	return claim != "" && claim == backend.Spec.SystemName
+}
+
 func (backend *Backend) FindMetricOrMethod(ref string) bool {
 	if len(backend.Spec.Metrics) > 0 {
 		if _, ok := backend.Spec.Metrics[ref]; ok {
@@ -0,0 +1,211 @@
+package v1beta1
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/3scale/3scale-operator/pkg/common"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	CustomPolicyDefinitionKind = "CustomPolicyDefinition"
+
+	// CustomPolicyDefinitionFinalizer is the finalizer set on CustomPolicyDefinition resources
+	// to remove the policy from the 3scale policy registry when the resource is deleted
+	CustomPolicyDefinitionFinalizer = "custompolicydefinition.capabilities.3scale.net/finalizer"
+
+	// APIcastPolicyManifestSchema is the JSON schema APIcast policy manifests conform to
+	APIcastPolicyManifestSchema = "http://apicast.io/policy-v1/schema#manifest#"
+
+	// ClaimedPolicyAnnotation holds the name and version of the policy registered by the custom resource.
+	// Set before registering the policy, it keeps the ownership when the ID recorded in the status is lost
+	ClaimedPolicyAnnotation = "capabilities.3scale.net/claimed-policy"
+
+	// CustomPolicyDefinitionInvalidConditionType represents that the combination of configuration
+	// in the CustomPolicyDefinitionSpec is not supported. This is not a transient error, but
+	// indicates a state that must be fixed before progress can be made.
+	CustomPolicyDefinitionInvalidConditionType common.ConditionType = "Invalid"
+
+	// CustomPolicyDefinitionSyncedConditionType indicates the custom policy definition has been successfully synchronized.
+	// Steady state
+	CustomPolicyDefinitionSyncedConditionType common.ConditionType = "Synced"
+
+	// CustomPolicyDefinitionFailedConditionType indicates that an error occurred during synchronization.
+	// The operator will retry.
+	CustomPolicyDefinitionFailedConditionType common.ConditionType = "Failed"
+
+	// CustomPolicyDefinitionUnavailableConditionType indicates that the 3scale admin portal could not be reached
+	// during synchronization. The operator will retry once 3scale is available again.
+	CustomPolicyDefinitionUnavailableConditionType common.ConditionType = "Unavailable"
+)
+
+// CustomPolicyDefinitionSpec defines the desired state of CustomPolicyDefinition
+type CustomPolicyDefinitionSpec struct {
+	// Name is the policy name. Products reference the policy by name and version in the policy chain
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_.-]+$`
+	Name string `json:"name"`
+
+	// Version is the policy version
+	Version string `json:"version"`
+
+	// Schema is the APIcast policy manifest, including the policy configuration JSON schema
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Schema runtime.RawExtension `json:"schema"`
+
+	// Adopt allows taking over an existing custom policy with the same name and version
+	// not registered by this resource. Otherwise, the existing custom policy is reported as a conflict.
+	// +optional
+	Adopt bool `json:"adopt,omitempty"`
+
+	// ProviderAccountRef references account provider credentials
+	// +optional
+	ProviderAccountRef *ProviderAccountReference `json:"providerAccountRef,omitempty"`
+}
+
+// CustomPolicyDefinitionStatus defines the observed state of CustomPolicyDefinition
+type CustomPolicyDefinitionStatus struct {
+	// +optional
+	ID *int64 `json:"policyId,omitempty"`
+
+	// 3scale control plane host
+	// +optional
+	ProviderAccountHost string `json:"providerAccountHost,omitempty"`
+
+	// ObservedGeneration reflects the generation of the most recently observed CustomPolicyDefinition Spec.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Current state of the custom policy definition.
+	// Conditions represent the latest available observations of an object's state
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions common.Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,2,rep,name=conditions"`
+}
+
+func (c *CustomPolicyDefinitionStatus) Equals(other *CustomPolicyDefinitionStatus, logger logr.Logger) bool {
+	if !reflect.DeepEqual(c.ID, other.ID) {
+		diff := cmp.Diff(c.ID, other.ID)
+		logger.V(1).Info("ID not equal", "difference", diff)
+		return false
+	}
+
+	if c.ProviderAccountHost != other.ProviderAccountHost {
+		diff := cmp.Diff(c.ProviderAccountHost, other.ProviderAccountHost)
+		logger.V(1).Info("ProviderAccountHost not equal", "difference", diff)
+		return false
+	}
+
+	if c.ObservedGeneration != other.ObservedGeneration {
+		diff := cmp.Diff(c.ObservedGeneration, other.ObservedGeneration)
+		logger.V(1).Info("ObservedGeneration not equal", "difference", diff)
+		return false
+	}
+
+	// Marshalling sorts by condition type
+	currentMarshaledJSON, _ := c.Conditions.MarshalJSON()
+	otherMarshaledJSON, _ := other.Conditions.MarshalJSON()
+	if string(currentMarshaledJSON) != string(otherMarshaledJSON) {
+		diff := cmp.Diff(string(currentMarshaledJSON), string(otherMarshaledJSON))
+		logger.V(1).Info("Conditions not equal", "difference", diff)
+		return false
+	}
+
+	return true
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CustomPolicyDefinition is the Schema for the custompolicydefinitions API
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=custompolicydefinitions,scope=Namespaced
+// +operator-sdk:gen-csv:customresourcedefinitions.displayName="3scale CustomPolicyDefinition"
+type CustomPolicyDefinition struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CustomPolicyDefinitionSpec   `json:"spec,omitempty"`
+	Status CustomPolicyDefinitionStatus `json:"status,omitempty"`
+}
+
+// Validate checks the schema is a valid APIcast policy manifest
+// for the policy name and version of the spec
+func (policy *CustomPolicyDefinition) Validate() field.ErrorList {
+	errors := field.ErrorList{}
+
+	schemaFldPath := field.NewPath("spec").Child("schema")
+
+	manifest := map[string]interface{}{}
+	if err := json.Unmarshal(policy.Spec.Schema.Raw, &manifest); err != nil {
+		errors = append(errors, field.Invalid(schemaFldPath, nil, "schema must be a JSON object"))
+		return errors
+	}
+
+	if schemaRef, ok := manifest["$schema"]; ok && schemaRef != APIcastPolicyManifestSchema {
+		errors = append(errors, field.Invalid(schemaFldPath.Child("$schema"), schemaRef, "unsupported policy manifest schema"))
+	}
+
+	for _, requiredField := range []string{"name", "summary"} {
+		value, ok := manifest[requiredField].(string)
+		if !ok || value == "" {
+			errors = append(errors, field.Required(schemaFldPath.Child(requiredField), "policy manifest field must be a non empty string"))
+		}
+	}
+
+	if version, ok := manifest["version"]; !ok || version != policy.Spec.Version {
+		errors = append(errors, field.Invalid(schemaFldPath.Child("version"), version, "policy manifest version must match spec version"))
+	}
+
+	configuration, ok := manifest["configuration"].(map[string]interface{})
+	if !ok {
+		errors = append(errors, field.Required(schemaFldPath.Child("configuration"), "policy manifest configuration must be a JSON schema object"))
+	} else if _, ok := configuration["type"]; !ok {
+		errors = append(errors, field.Required(schemaFldPath.Child("configuration").Child("type"), "policy configuration JSON schema type is required"))
+	}
+
+	return errors
+}
+
+func (policy *CustomPolicyDefinition) IsSynced() bool {
+	return policy.Status.Conditions.IsTrueFor(CustomPolicyDefinitionSyncedConditionType)
+}
+
+// NameVersion returns the name and version identifying the policy in the 3scale policy registry
+func (policy *CustomPolicyDefinition) NameVersion() string {
+	return policy.Spec.Name + ":" + policy.Spec.Version
+}
+
+// Owns returns true when the custom policy with the given ID was registered or adopted by this resource.
+// When the ID is unknown, the custom policy claimed with its name and version is owned
+func (policy *CustomPolicyDefinition) Owns(id int64) bool {
+	if policy.Status.ID != nil {
+		return *policy.Status.ID == id
+	}
+	claim := policy.GetAnnotations()[ClaimedPolicyAnnotation]
+	return claim != "" && claim == policy.NameVersion()
+}
+
+// OrphanOnDelete returns true when the policy must not be removed from the 3scale policy registry
+// when the resource is deleted
+func (policy *CustomPolicyDefinition) OrphanOnDelete() bool {
+	return policy.GetAnnotations()[OrphanOnDeleteAnnotation] == "true"
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CustomPolicyDefinitionList contains a list of CustomPolicyDefinition
+type CustomPolicyDefinitionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CustomPolicyDefinition `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CustomPolicyDefinition{}, &CustomPolicyDefinitionList{})
+}
@@ -0,0 +1,69 @@
+package v1beta1
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestValidateCustomPolicyDefinition(t *testing.T) {
+	validSchema := `{
+		"$schema": "http://apicast.io/policy-v1/schema#manifest#",
+		"name": "Example Policy",
+		"summary": "Example policy summary",
+		"version": "0.1",
+		"configuration": {"type": "object", "properties": {}}
+	}`
+
+	cases := []struct {
+		testName  string
+		schema    string
+		expectErr bool
+	}{
+		{"not an object", `["name"]`, true},
+		{"missing fields", `{"version": "0.1"}`, true},
+		{"version mismatch", `{"name": "Example", "summary": "Example", "version": "0.2", "configuration": {"type": "object"}}`, true},
+		{"unknown manifest schema", `{"$schema": "http://example.com", "name": "Example", "summary": "Example", "version": "0.1", "configuration": {"type": "object"}}`, true},
+		{"configuration without type", `{"name": "Example", "summary": "Example", "version": "0.1", "configuration": {}}`, true},
+		{"valid", validSchema, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			policy := CustomPolicyDefinition{
+				Spec: CustomPolicyDefinitionSpec{
+					Name:    "example",
+					Version: "0.1",
+					Schema:  runtime.RawExtension{Raw: []byte(tc.schema)},
+				},
+			}
+			errors := policy.Validate()
+			if tc.expectErr != (len(errors) > 0) {
+				subT.Errorf("expected error: %t, got: %v", tc.expectErr, errors)
+			}
+		})
+	}
+}
+
+func TestCustomPolicyDefinitionOwns(t *testing.T) {
+	policy := CustomPolicyDefinition{Spec: CustomPolicyDefinitionSpec{Name: "example", Version: "0.1"}}
+	if policy.Owns(1) {
+		t.Error("custom policy definition without ID nor claim must not own any custom policy")
+	}
+
+	policy.Annotations = map[string]string{ClaimedPolicyAnnotation: "example:0.2"}
+	if policy.Owns(1) {
+		t.Error("custom policy definition must not own the custom policy claimed with another version")
+	}
+
+	policy.Annotations[ClaimedPolicyAnnotation] = "example:0.1"
+	if !policy.Owns(1) {
+		t.Error("custom policy definition must own the custom policy claimed with its name and version")
+	}
+
+	id := int64(1)
+	policy.Status.ID = &id
+	if !policy.Owns(1) || policy.Owns(2) {
+		t.Errorf("custom policy definition must only own custom policy %d", id)
+	}
+}
@@ -0,0 +1,263 @@
+package v1beta1
+
+import (
+	"reflect"
+
+	"github.com/3scale/3scale-operator/pkg/common"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	DeveloperAccountKind = "DeveloperAccount"
+
+	// DeveloperAccountFinalizer is the finalizer set on DeveloperAccount resources
+	// to remove the 3scale developer account when the resource is deleted
+	DeveloperAccountFinalizer = "developeraccount.capabilities.3scale.net/finalizer"
+
+	// DeveloperAccountPasswordSecretField is the secret field name with developer account admin user password
+	DeveloperAccountPasswordSecretField = "password"
+
+	// ClaimedAdminUsernameAnnotation holds the admin username of the 3scale developer account created by the custom resource.
+	// Set before creating the 3scale developer account, it keeps the ownership when the ID recorded in the status is lost
+	ClaimedAdminUsernameAnnotation = "capabilities.3scale.net/claimed-admin-username"
+
+	// Developer account approval states
+	DeveloperAccountStateApproved = "approved"
+	DeveloperAccountStatePending  = "pending"
+	DeveloperAccountStateRejected = "rejected"
+
+	// DeveloperAccountInvalidConditionType represents that the combination of configuration
+	// in the DeveloperAccountSpec is not supported. This is not a transient error, but
+	// indicates a state that must be fixed before progress can be made.
+	DeveloperAccountInvalidConditionType common.ConditionType = "Invalid"
+
+	// DeveloperAccountOrphanConditionType represents that the spec references
+	// an account plan that does not exist in 3scale.
+	DeveloperAccountOrphanConditionType common.ConditionType = "Orphan"
+
+	// DeveloperAccountSyncedConditionType indicates the developer account has been successfully synchronized.
+	// Steady state
+	DeveloperAccountSyncedConditionType common.ConditionType = "Synced"
+
+	// DeveloperAccountFailedConditionType indicates that an error occurred during synchronization.
+	// The operator will retry.
+	DeveloperAccountFailedConditionType common.ConditionType = "Failed"
+
+	// DeveloperAccountUnavailableConditionType indicates that the 3scale admin portal could not be reached
+	// during synchronization. The operator will retry once 3scale is available again.
+	DeveloperAccountUnavailableConditionType common.ConditionType = "Unavailable"
+)
+
+// DeveloperAccountAdminSpec defines the admin user of the developer account.
+// Only used when the developer account is created.
+type DeveloperAccountAdminSpec struct {
+	// Username of the admin user
+	Username string `json:"username"`
+
+	// Email of the admin user
+	Email string `json:"email"`
+
+	// PasswordCredentialsRef references the secret with the admin user password in the "password" field.
+	// When not set, the admin user will have to reset the password
+	// +optional
+	PasswordCredentialsRef *corev1.LocalObjectReference `json:"passwordCredentialsRef,omitempty"`
+}
+
+// BillingAddressSpec defines the developer account billing address
+type BillingAddressSpec struct {
+	// +optional
+	Company string `json:"company,omitempty"`
+	// +optional
+	Address1 string `json:"address1,omitempty"`
+	// +optional
+	Address2 string `json:"address2,omitempty"`
+	// +optional
+	City string `json:"city,omitempty"`
+	// +optional
+	State string `json:"state,omitempty"`
+	// +optional
+	Country string `json:"country,omitempty"`
+	// +optional
+	Zip string `json:"zip,omitempty"`
+	// +optional
+	Phone string `json:"phone,omitempty"`
+}
+
+// DeveloperAccountSpec defines the desired state of DeveloperAccount
+type DeveloperAccountSpec struct {
+	// OrgName is the organization name of the developer account
+	OrgName string `json:"orgName"`
+
+	// Admin defines the admin user created with the developer account
+	Admin DeveloperAccountAdminSpec `json:"admin"`
+
+	// BillingAddress of the developer account
+	// +optional
+	BillingAddress *BillingAddressSpec `json:"billingAddress,omitempty"`
+
+	// VatCode of the developer account
+	// +optional
+	VatCode *string `json:"vatCode,omitempty"`
+
+	// MonthlyBillingEnabled switches monthly billing
+	// +optional
+	MonthlyBillingEnabled *bool `json:"monthlyBillingEnabled,omitempty"`
+
+	// MonthlyChargingEnabled switches monthly charging
+	// +optional
+	MonthlyChargingEnabled *bool `json:"monthlyChargingEnabled,omitempty"`
+
+	// AccountPlan is the system name of the developer account's account plan.
+	// When not set, the default account plan is used on creation and it is not reconciled
+	// +optional
+	AccountPlan *string `json:"accountPlan,omitempty"`
+
+	// State is the desired approval state of the developer account.
+	// When not set, the approval state is not reconciled
+	// +kubebuilder:validation:Enum=approved;pending;rejected
+	// +optional
+	State *string `json:"state,omitempty"`
+
+	// Adopt allows taking over an existing 3scale developer account with the same admin username
+	// not created by this resource. Otherwise, the existing 3scale developer account is reported as a conflict.
+	// +optional
+	Adopt bool `json:"adopt,omitempty"`
+
+	// ProviderAccountRef references account provider credentials
+	// +optional
+	ProviderAccountRef *ProviderAccountReference `json:"providerAccountRef,omitempty"`
+}
+
+// DeveloperAccountStatus defines the observed state of DeveloperAccount
+type DeveloperAccountStatus struct {
+	// +optional
+	ID *int64 `json:"accountId,omitempty"`
+
+	// State is the current approval state of the 3scale developer account
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// 3scale control plane host
+	// +optional
+	ProviderAccountHost string `json:"providerAccountHost,omitempty"`
+
+	// ObservedGeneration reflects the generation of the most recently observed DeveloperAccount Spec.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Current state of the 3scale developer account.
+	// Conditions represent the latest available observations of an object's state
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions common.Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,2,rep,name=conditions"`
+}
+
+func (d *DeveloperAccountStatus) Equals(other *DeveloperAccountStatus, logger logr.Logger) bool {
+	if !reflect.DeepEqual(d.ID, other.ID) {
+		diff := cmp.Diff(d.ID, other.ID)
+		logger.V(1).Info("ID not equal", "difference", diff)
+		return false
+	}
+
+	if d.State != other.State {
+		diff := cmp.Diff(d.State, other.State)
+		logger.V(1).Info("State not equal", "difference", diff)
+		return false
+	}
+
+	if d.ProviderAccountHost != other.ProviderAccountHost {
+		diff := cmp.Diff(d.ProviderAccountHost, other.ProviderAccountHost)
+		logger.V(1).Info("ProviderAccountHost not equal", "difference", diff)
+		return false
+	}
+
+	if d.ObservedGeneration != other.ObservedGeneration {
+		diff := cmp.Diff(d.ObservedGeneration, other.ObservedGeneration)
+		logger.V(1).Info("ObservedGeneration not equal", "difference", diff)
+		return false
+	}
+
+	// Marshalling sorts by condition type
+	currentMarshaledJSON, _ := d.Conditions.MarshalJSON()
+	otherMarshaledJSON, _ := other.Conditions.MarshalJSON()
+	if string(currentMarshaledJSON) != string(otherMarshaledJSON) {
+		diff := cmp.Diff(string(currentMarshaledJSON), string(otherMarshaledJSON))
+		logger.V(1).Info("Conditions not equal", "difference", diff)
+		return false
+	}
+
+	return true
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DeveloperAccount is the Schema for the developeraccounts API
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=developeraccounts,scope=Namespaced
+// +operator-sdk:gen-csv:customresourcedefinitions.displayName="3scale DeveloperAccount"
+type DeveloperAccount struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DeveloperAccountSpec   `json:"spec,omitempty"`
+	Status DeveloperAccountStatus `json:"status,omitempty"`
+}
+
+func (account *DeveloperAccount) Validate() field.ErrorList {
+	errors := field.ErrorList{}
+
+	specFldPath := field.NewPath("spec")
+	if account.Spec.OrgName == "" {
+		errors = append(errors, field.Required(specFldPath.Child("orgName"), "organization name must not be empty"))
+	}
+
+	adminFldPath := specFldPath.Child("admin")
+	if account.Spec.Admin.Username == "" {
+		errors = append(errors, field.Required(adminFldPath.Child("username"), "admin username must not be empty"))
+	}
+
+	if account.Spec.Admin.Email == "" {
+		errors = append(errors, field.Required(adminFldPath.Child("email"), "admin email must not be empty"))
+	}
+
+	return errors
+}
+
+func (account *DeveloperAccount) IsSynced() bool {
+	return account.Status.Conditions.IsTrueFor(DeveloperAccountSyncedConditionType)
+}
+
+// Owns returns true when the 3scale developer account was created by the resource.
+// When the ID is not recorded, the account of the claimed admin username is owned
+func (account *DeveloperAccount) Owns(id int64) bool {
+	if account.Status.ID != nil {
+		return *account.Status.ID == id
+	}
+	claim := account.GetAnnotations()[ClaimedAdminUsernameAnnotation]
+	return claim != "" && claim == account.Spec.Admin.Username
+}
+
+// OrphanOnDelete returns true when the 3scale developer account must not be deleted
+// when the resource is deleted
+func (account *DeveloperAccount) OrphanOnDelete() bool {
+	return account.GetAnnotations()[OrphanOnDeleteAnnotation] == "true"
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DeveloperAccountList contains a list of DeveloperAccount
+type DeveloperAccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DeveloperAccount `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DeveloperAccount{}, &DeveloperAccountList{})
+}
@@ -0,0 +1,45 @@
+package v1beta1
+
+import (
+	"testing"
+)
+
+func TestValidateDeveloperAccount(t *testing.T) {
+	cases := []struct {
+		testName  string
+		spec      DeveloperAccountSpec
+		expectErr bool
+	}{
+		{"empty", DeveloperAccountSpec{}, true},
+		{"missing email", DeveloperAccountSpec{OrgName: "acme", Admin: DeveloperAccountAdminSpec{Username: "john"}}, true},
+		{"valid", DeveloperAccountSpec{OrgName: "acme", Admin: DeveloperAccountAdminSpec{Username: "john", Email: "john@example.com"}}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			account := DeveloperAccount{Spec: tc.spec}
+			errors := account.Validate()
+			if tc.expectErr != (len(errors) > 0) {
+				subT.Errorf("expected error: %t, got: %v", tc.expectErr, errors)
+			}
+		})
+	}
+}
+
+func TestDeveloperAccountOwns(t *testing.T) {
+	account := DeveloperAccount{Spec: DeveloperAccountSpec{Admin: DeveloperAccountAdminSpec{Username: "john"}}}
+	if account.Owns(1) {
+		t.Error("developer account without ID nor claim must not own any 3scale developer account")
+	}
+
+	account.SetAnnotations(map[string]string{ClaimedAdminUsernameAnnotation: "john"})
+	if !account.Owns(1) {
+		t.Error("developer account must own the 3scale developer account of the claimed admin username")
+	}
+
+	id := int64(1)
+	account.Status.ID = &id
+	if !account.Owns(1) || account.Owns(2) {
+		t.Errorf("developer account must only own 3scale developer account %d", id)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -22,6 +23,24 @@ import (
 const (
 	ProductKind = "Product"
 
+	// ProductFinalizer is the finalizer set on Product resources
+	// to remove the 3scale product when the resource is deleted
+	ProductFinalizer = "product.capabilities.3scale.net/finalizer"
+
+	// OrphanOnDeleteAnnotation when set to "true", the 3scale object is not deleted
+	// when the custom resource is deleted
+	OrphanOnDeleteAnnotation = "capabilities.3scale.net/orphan-on-delete"
+
+	// ClaimedSystemNameAnnotation holds the system name of the 3scale object created by the custom resource.
+	// Set before creating the 3scale object, it keeps the ownership when the ID recorded in the status is lost
+	ClaimedSystemNameAnnotation = "capabilities.3scale.net/claimed-system-name"
+
+	// DriftPolicyCorrect overwrites 3scale changes not made through the custom resource
+	DriftPolicyCorrect = "correct"
+
+	// DriftPolicyReport only reports 3scale changes not made through the custom resource
+	DriftPolicyReport = "report"
+
 	// ProductInvalidConditionType represents that the combination of configuration in the ProductSpec
 	// is not supported. This is not a transient error, but
 	// indicates a state that must be fixed before progress can be made.
@@ -42,6 +61,29 @@ const (
 	// ProductFailedConditionType indicates that an error occurred during synchronization.
 	// The operator will retry.
 	ProductFailedConditionType common.ConditionType = "Failed"
+
+	// ProductUnavailableConditionType indicates that the 3scale admin portal could not be reached
+	// during synchronization. The operator will retry once 3scale is available again.
+	ProductUnavailableConditionType common.ConditionType = "Unavailable"
+
+	// ProductPolicyChainDriftConditionType indicates the 3scale policy chain differed from
+	// the policy chain declared in the ProductSpec and it was overwritten during last synchronization.
+	ProductPolicyChainDriftConditionType common.ConditionType = "PolicyChainDrift"
+
+	// ProductOutOfSyncConditionType indicates the 3scale product was changed outside the operator
+	// after the ProductSpec had been synchronized. The message lists the drifted sections.
+	// With the report drift policy, the drift remains in 3scale until it is fixed.
+	ProductOutOfSyncConditionType common.ConditionType = "OutOfSync"
+
+	// ProductPlannedConditionType indicates the changes required to synchronize 3scale with the ProductSpec
+	// have been computed and not applied, because the product is in dry run mode.
+	// The planned changes are published in the status.
+	ProductPlannedConditionType common.ConditionType = "Planned"
+
+	// Planned change actions
+	PlannedChangeCreate = "create"
+	PlannedChangeUpdate = "update"
+	PlannedChangeDelete = "delete"
 )
 
 var (
@@ -133,7 +175,54 @@ type ApplicationPlanSpec struct {
 	// +optional
 	Limits []LimitSpec `json:"limits,omitempty"`
 
-	// TODO Features
+	// Features enabled in the plan.
+	// List of product feature system names
+	// When not set, the enabled features are not reconciled
+	// +optional
+	Features []string `json:"features,omitempty"`
+
+	// Published makes the plan available for developers to sign up. Otherwise, the plan is hidden.
+	// When not set, the plan state is not reconciled
+	// +optional
+	Published *bool `json:"published,omitempty"`
+}
+
+// ServicePlanSpec defines the desired state of Product's Service Plan
+type ServicePlanSpec struct {
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Set whether or not subscriptions to the product can be created on demand
+	// or if approval is required from you before they are activated.
+	// +optional
+	SubscriptionsRequireApproval *bool `json:"subscriptionsRequireApproval,omitempty"`
+
+	// Trial Period (days)
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TrialPeriod *int `json:"trialPeriod,omitempty"`
+
+	// Setup fee (USD)
+	// +kubebuilder:validation:Pattern=`^\d+(\.\d{2})?$`
+	// +optional
+	SetupFee *string `json:"setupFee,omitempty"`
+
+	// Cost per Month (USD)
+	// +kubebuilder:validation:Pattern=`^\d+(\.\d{2})?$`
+	// +optional
+	CostMonth *string `json:"costMonth,omitempty"`
+
+	// Published makes the plan available for developers to subscribe. Otherwise, the plan is hidden.
+	// When not set, the plan state is not reconciled
+	// +optional
+	Published *bool `json:"published,omitempty"`
+}
+
+// FeatureSpec defines the desired state of Product's Feature
+type FeatureSpec struct {
+	Name string `json:"name"`
+	// +optional
+	Description string `json:"description,omitempty"`
 }
 
 // MethodSpec defines the desired state of Product's Method
@@ -165,6 +254,28 @@ type MappingRuleSpec struct {
 // BackendUsageSpec defines the desired state of Product's Backend Usages
 type BackendUsageSpec struct {
 	Path string `json:"path"`
+
+	// Namespace of the Backend resource. Defaults to the product namespace.
+	// The Backend resource must use the same 3scale provider account
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// PolicyConfig defines the desired state of Product's policy chain item
+type PolicyConfig struct {
+	// Name defines the policy unique name
+	Name string `json:"name"`
+
+	// Version defines the policy version
+	Version string `json:"version"`
+
+	// Configuration defines the policy configuration
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Configuration runtime.RawExtension `json:"configuration,omitempty"`
+
+	// Enabled defines activation state
+	Enabled bool `json:"enabled"`
 }
 
 // SecuritySpec defines the desired state of Authentication Security
@@ -188,6 +299,57 @@ func (s *SecuritySpec) HostRewrite() *string {
 	return s.HostHeader
 }
 
+// GatewayResponseSpec defines the desired gateway response configuration
+type GatewayResponseSpec struct {
+	// ErrorStatusAuthFailed specifies the response code when authentication fails
+	// +optional
+	ErrorStatusAuthFailed *int32 `json:"errorStatusAuthFailed,omitempty"`
+
+	// ErrorHeadersAuthFailed specifies the Content-Type header when authentication fails
+	// +optional
+	ErrorHeadersAuthFailed *string `json:"errorHeadersAuthFailed,omitempty"`
+
+	// ErrorAuthFailed specifies the response body when authentication fails
+	// +optional
+	ErrorAuthFailed *string `json:"errorAuthFailed,omitempty"`
+
+	// ErrorStatusAuthMissing specifies the response code when authentication is missing
+	// +optional
+	ErrorStatusAuthMissing *int32 `json:"errorStatusAuthMissing,omitempty"`
+
+	// ErrorHeadersAuthMissing specifies the Content-Type header when authentication is missing
+	// +optional
+	ErrorHeadersAuthMissing *string `json:"errorHeadersAuthMissing,omitempty"`
+
+	// ErrorAuthMissing specifies the response body when authentication is missing
+	// +optional
+	ErrorAuthMissing *string `json:"errorAuthMissing,omitempty"`
+
+	// ErrorStatusNoMatch specifies the response code when no match error
+	// +optional
+	ErrorStatusNoMatch *int32 `json:"errorStatusNoMatch,omitempty"`
+
+	// ErrorHeadersNoMatch specifies the Content-Type header when no match error
+	// +optional
+	ErrorHeadersNoMatch *string `json:"errorHeadersNoMatch,omitempty"`
+
+	// ErrorNoMatch specifies the response body when no match error
+	// +optional
+	ErrorNoMatch *string `json:"errorNoMatch,omitempty"`
+
+	// ErrorStatusLimitsExceeded specifies the response code when usage limit exceeded
+	// +optional
+	ErrorStatusLimitsExceeded *int32 `json:"errorStatusLimitsExceeded,omitempty"`
+
+	// ErrorHeadersLimitsExceeded specifies the Content-Type header when usage limit exceeded
+	// +optional
+	ErrorHeadersLimitsExceeded *string `json:"errorHeadersLimitsExceeded,omitempty"`
+
+	// ErrorLimitsExceeded specifies the response body when usage limit exceeded
+	// +optional
+	ErrorLimitsExceeded *string `json:"errorLimitsExceeded,omitempty"`
+}
+
 // AppKeyAppIDAuthenticationSpec defines the desired state of AppKey&AppId Authentication
 type AppKeyAppIDAuthenticationSpec struct {
 	// AppID is the name of the parameter that acts of behalf of app id
@@ -209,7 +371,8 @@ type AppKeyAppIDAuthenticationSpec struct {
 	// +optional
 	Security *SecuritySpec `json:"security,omitempty"`
 
-	// TODO GATEWAY RESPONSE
+	// +optional
+	GatewayResponse *GatewayResponseSpec `json:"gatewayResponse,omitempty"`
 }
 
 func (a *AppKeyAppIDAuthenticationSpec) SecuritySecretToken() *string {
@@ -232,6 +395,10 @@ func (a *AppKeyAppIDAuthenticationSpec) CredentialsLocation() *string {
 	return a.CredentialsLoc
 }
 
+func (a *AppKeyAppIDAuthenticationSpec) GatewayResponseSpec() *GatewayResponseSpec {
+	return a.GatewayResponse
+}
+
 func (a *AppKeyAppIDAuthenticationSpec) AuthAppID() *string {
 	return a.AppID
 }
@@ -256,7 +423,8 @@ type UserKeyAuthenticationSpec struct {
 	// +optional
 	Security *SecuritySpec `json:"security,omitempty"`
 
-	// TODO GATEWAY RESPONSE
+	// +optional
+	GatewayResponse *GatewayResponseSpec `json:"gatewayResponse,omitempty"`
 }
 
 func (u *UserKeyAuthenticationSpec) SecuritySecretToken() *string {
@@ -279,18 +447,100 @@ func (u *UserKeyAuthenticationSpec) CredentialsLocation() *string {
 	return u.CredentialsLoc
 }
 
+func (u *UserKeyAuthenticationSpec) GatewayResponseSpec() *GatewayResponseSpec {
+	return u.GatewayResponse
+}
+
 func (u *UserKeyAuthenticationSpec) AuthUserKey() *string {
 	return u.Key
 }
 
+// OIDCAuthenticationFlowSpec defines the desired OAuth2.0 flows for the OpenID Connect Authentication
+type OIDCAuthenticationFlowSpec struct {
+	// StandardFlowEnabled enables Authorization Code Flow (Standard Flow)
+	StandardFlowEnabled bool `json:"standardFlowEnabled"`
+
+	// ImplicitFlowEnabled enables Implicit Flow
+	ImplicitFlowEnabled bool `json:"implicitFlowEnabled"`
+
+	// ServiceAccountsEnabled enables Client Credentials Flow (Service Accounts)
+	ServiceAccountsEnabled bool `json:"serviceAccountsEnabled"`
+
+	// DirectAccessGrantsEnabled enables Resource Owner Password Credentials Flow (Direct Access Grants)
+	DirectAccessGrantsEnabled bool `json:"directAccessGrantsEnabled"`
+}
+
+// OIDCSpec defines the desired configuration of OpenID Connect Authentication
+type OIDCSpec struct {
+	// IssuerType is the type of the OpenID Provider
+	// +kubebuilder:validation:Enum=keycloak;rest
+	IssuerType string `json:"issuerType"`
+
+	// IssuerEndpoint is the location of your OpenID Provider
+	// The format of this endpoint is determined on your OpenID Provider setup.
+	// A common guidance would be "https://<CLIENT_ID>:<CLIENT_SECRET>@<HOST>:<PORT>/auth/realms/<REALM_NAME>"
+	IssuerEndpoint string `json:"issuerEndpoint"`
+
+	// JwtClaimWithClientID is the JSON Web Token (JWT) Claim with ClientID that contains the clientID.
+	// Defaults to 'azp'.
+	// +optional
+	JwtClaimWithClientID *string `json:"jwtClaimWithClientID,omitempty"`
+
+	// JwtClaimWithClientIDType sets to process the ClientID Token Claim value as a string or as a liquid template.
+	// +kubebuilder:validation:Enum=plain;liquid
+	// +optional
+	JwtClaimWithClientIDType *string `json:"jwtClaimWithClientIDType,omitempty"`
+
+	// AuthenticationFlow specifies OAuth2.0 authorization grant type
+	// +optional
+	AuthenticationFlow *OIDCAuthenticationFlowSpec `json:"authenticationFlow,omitempty"`
+
+	// Credentials Location available options:
+	// headers: As HTTP Headers
+	// query: As query parameters (GET) or body parameters (POST/PUT/DELETE)
+	// +optional
+	// +kubebuilder:validation:Enum=headers;query
+	CredentialsLoc *string `json:"credentials,omitempty"`
+
+	// +optional
+	Security *SecuritySpec `json:"security,omitempty"`
+
+	// +optional
+	GatewayResponse *GatewayResponseSpec `json:"gatewayResponse,omitempty"`
+}
+
+func (o *OIDCSpec) SecuritySecretToken() *string {
+	if o.Security == nil {
+		return nil
+	}
+
+	return o.Security.SecuritySecretToken()
+}
+
+func (o *OIDCSpec) HostRewrite() *string {
+	if o.Security == nil {
+		return nil
+	}
+
+	return o.Security.HostRewrite()
+}
+
+func (o *OIDCSpec) CredentialsLocation() *string {
+	return o.CredentialsLoc
+}
+
+func (o *OIDCSpec) GatewayResponseSpec() *GatewayResponseSpec {
+	return o.GatewayResponse
+}
+
 // AuthenticationSpec defines the desired state of Product Authentication
 type AuthenticationSpec struct {
 	// +optional
 	UserKeyAuthentication *UserKeyAuthenticationSpec `json:"userkey,omitempty"`
 	// +optional
 	AppKeyAppIDAuthentication *AppKeyAppIDAuthenticationSpec `json:"appKeyAppID,omitempty"`
-
-	// TODO OpenID
+	// +optional
+	OIDC *OIDCSpec `json:"oidc,omitempty"`
 }
 
 func (a *AuthenticationSpec) AuthenticationMode() string {
@@ -299,6 +549,10 @@ func (a *AuthenticationSpec) AuthenticationMode() string {
 		return "1"
 	}
 
+	if a.OIDC != nil {
+		return "oidc"
+	}
+
 	// must be appKey&appID
 	return "2"
 }
@@ -309,8 +563,12 @@ func (a *AuthenticationSpec) SecuritySecretToken() *string {
 		return a.UserKeyAuthentication.SecuritySecretToken()
 	}
 
+	if a.OIDC != nil {
+		return a.OIDC.SecuritySecretToken()
+	}
+
 	if a.AppKeyAppIDAuthentication == nil {
-		panic("product authenticationspec: both userkey and appid_appkeyare nil")
+		panic("product authenticationspec: userkey, appid_appkey and oidc are nil")
 	}
 
 	return a.AppKeyAppIDAuthentication.SecuritySecretToken()
@@ -322,8 +580,12 @@ func (a *AuthenticationSpec) HostRewrite() *string {
 		return a.UserKeyAuthentication.HostRewrite()
 	}
 
+	if a.OIDC != nil {
+		return a.OIDC.HostRewrite()
+	}
+
 	if a.AppKeyAppIDAuthentication == nil {
-		panic("product authenticationspec: both userkey and appid_appkeyare nil")
+		panic("product authenticationspec: userkey, appid_appkey and oidc are nil")
 	}
 
 	return a.AppKeyAppIDAuthentication.HostRewrite()
@@ -335,13 +597,34 @@ func (a *AuthenticationSpec) CredentialsLocation() *string {
 		return a.UserKeyAuthentication.CredentialsLocation()
 	}
 
+	if a.OIDC != nil {
+		return a.OIDC.CredentialsLocation()
+	}
+
 	if a.AppKeyAppIDAuthentication == nil {
-		panic("product authenticationspec: both userkey and appid_appkeyare nil")
+		panic("product authenticationspec: userkey, appid_appkey and oidc are nil")
 	}
 
 	return a.AppKeyAppIDAuthentication.CredentialsLocation()
 }
 
+func (a *AuthenticationSpec) GatewayResponseSpec() *GatewayResponseSpec {
+	// authentication is oneOf by CRD openapiV3 validation
+	if a.UserKeyAuthentication != nil {
+		return a.UserKeyAuthentication.GatewayResponseSpec()
+	}
+
+	if a.OIDC != nil {
+		return a.OIDC.GatewayResponseSpec()
+	}
+
+	if a.AppKeyAppIDAuthentication == nil {
+		panic("product authenticationspec: userkey, appid_appkey and oidc are nil")
+	}
+
+	return a.AppKeyAppIDAuthentication.GatewayResponseSpec()
+}
+
 func (a *AuthenticationSpec) AuthUserKey() *string {
 	// authentication is oneOf by CRD openapiV3 validation
 	if a.UserKeyAuthentication != nil {
@@ -369,6 +652,11 @@ func (a *AuthenticationSpec) AuthAppKey() *string {
 	return nil
 }
 
+func (a *AuthenticationSpec) OIDCSpec() *OIDCSpec {
+	// authentication is oneOf by CRD openapiV3 validation
+	return a.OIDC
+}
+
 // ApicastHostedSpec defines the desired state of Product Apicast Hosted
 type ApicastHostedSpec struct {
 	// +optional
@@ -404,6 +692,13 @@ func (a *ApicastHostedSpec) CredentialsLocation() *string {
 	return a.Authentication.CredentialsLocation()
 }
 
+func (a *ApicastHostedSpec) GatewayResponseSpec() *GatewayResponseSpec {
+	if a.Authentication == nil {
+		return nil
+	}
+	return a.Authentication.GatewayResponseSpec()
+}
+
 func (a *ApicastHostedSpec) AuthUserKey() *string {
 	if a.Authentication == nil {
 		return nil
@@ -425,6 +720,13 @@ func (a *ApicastHostedSpec) AuthAppKey() *string {
 	return a.Authentication.AuthAppKey()
 }
 
+func (a *ApicastHostedSpec) OIDCSpec() *OIDCSpec {
+	if a.Authentication == nil {
+		return nil
+	}
+	return a.Authentication.OIDCSpec()
+}
+
 // ApicastSelfManagedSpec defines the desired state of Product Apicast Self Managed
 type ApicastSelfManagedSpec struct {
 	// +optional
@@ -474,6 +776,13 @@ func (a *ApicastSelfManagedSpec) CredentialsLocation() *string {
 	return a.Authentication.CredentialsLocation()
 }
 
+func (a *ApicastSelfManagedSpec) GatewayResponseSpec() *GatewayResponseSpec {
+	if a.Authentication == nil {
+		return nil
+	}
+	return a.Authentication.GatewayResponseSpec()
+}
+
 func (a *ApicastSelfManagedSpec) AuthUserKey() *string {
 	if a.Authentication == nil {
 		return nil
@@ -495,6 +804,13 @@ func (a *ApicastSelfManagedSpec) AuthAppKey() *string {
 	return a.Authentication.AuthAppKey()
 }
 
+func (a *ApicastSelfManagedSpec) OIDCSpec() *OIDCSpec {
+	if a.Authentication == nil {
+		return nil
+	}
+	return a.Authentication.OIDCSpec()
+}
+
 // ProductDeploymentSpec defines the desired state of Product Deployment
 type ProductDeploymentSpec struct {
 	// +optional
@@ -594,6 +910,19 @@ func (d *ProductDeploymentSpec) CredentialsLocation() *string {
 	return d.ApicastSelfManaged.CredentialsLocation()
 }
 
+func (d *ProductDeploymentSpec) GatewayResponseSpec() *GatewayResponseSpec {
+	// spec.deployment is oneOf by CRD openapiV3 validation
+	if d.ApicastHosted != nil {
+		return d.ApicastHosted.GatewayResponseSpec()
+	}
+
+	if d.ApicastSelfManaged == nil {
+		panic("product spec.deployment apicasthosted and selfmanaged are nil")
+	}
+
+	return d.ApicastSelfManaged.GatewayResponseSpec()
+}
+
 func (d *ProductDeploymentSpec) AuthUserKey() *string {
 	// spec.deployment is oneOf by CRD openapiV3 validation
 	if d.ApicastHosted != nil {
@@ -633,6 +962,19 @@ func (d *ProductDeploymentSpec) AuthAppKey() *string {
 	return d.ApicastSelfManaged.AuthAppKey()
 }
 
+func (d *ProductDeploymentSpec) OIDCSpec() *OIDCSpec {
+	// spec.deployment is oneOf by CRD openapiV3 validation
+	if d.ApicastHosted != nil {
+		return d.ApicastHosted.OIDCSpec()
+	}
+
+	if d.ApicastSelfManaged == nil {
+		panic("product spec.deployment apicasthosted and selfmanaged are nil")
+	}
+
+	return d.ApicastSelfManaged.OIDCSpec()
+}
+
 // ProductSpec defines the desired state of Product
 // +k8s:openapi-gen=true
 type ProductSpec struct {
@@ -682,9 +1024,61 @@ type ProductSpec struct {
 	// +optional
 	ApplicationPlans map[string]ApplicationPlanSpec `json:"applicationPlans,omitempty"`
 
+	// DefaultApplicationPlan is the system name of the application plan assigned by default to new applications.
+	// It must be one of the application plans. When not set, the default plan is not reconciled
+	// +optional
+	DefaultApplicationPlan *string `json:"defaultApplicationPlan,omitempty"`
+
+	// Service Plans
+	// Map: system_name -> Service Plan Spec
+	// Service plans gate developer account subscriptions to the product.
+	// When not set, service plans are not reconciled
+	// +optional
+	ServicePlans map[string]ServicePlanSpec `json:"servicePlans,omitempty"`
+
+	// Features
+	// Map: system_name -> FeatureSpec
+	// Features can be enabled in application plans
+	// When not set, features are not reconciled
+	// +optional
+	Features map[string]FeatureSpec `json:"features,omitempty"`
+
+	// Policies holds the product's policy chain.
+	// Order in the array matters. Policies are executed in the declared order.
+	// +optional
+	Policies []PolicyConfig `json:"policies,omitempty"`
+
+	// ProductionConfigVersion is the staging proxy configuration version to be promoted to production.
+	// When not set, production proxy configuration is not reconciled.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ProductionConfigVersion *int64 `json:"productionConfigVersion,omitempty"`
+
+	// DriftPolicy defines how 3scale changes not made through the custom resource are handled.
+	// "correct" overwrites them with the spec, "report" only reports them in the OutOfSync condition.
+	// Default value is "correct"
+	// +kubebuilder:validation:Enum=correct;report
+	// +optional
+	DriftPolicy *string `json:"driftPolicy,omitempty"`
+
+	// Adopt allows taking over an existing 3scale product with the same system name
+	// not created by this resource. Otherwise, the existing 3scale product is reported as a conflict.
+	// +optional
+	Adopt bool `json:"adopt,omitempty"`
+
+	// DryRun computes the changes required to synchronize 3scale with the spec without applying them.
+	// The planned changes are published in the status.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+
 	// ProviderAccountRef references account provider credentials
 	// +optional
-	ProviderAccountRef *corev1.LocalObjectReference `json:"providerAccountRef,omitempty"`
+	ProviderAccountRef *ProviderAccountReference `json:"providerAccountRef,omitempty"`
+}
+
+// ReportDriftOnly returns true when drift must be reported and not corrected
+func (s *ProductSpec) ReportDriftOnly() bool {
+	return s.DriftPolicy != nil && *s.DriftPolicy == DriftPolicyReport
 }
 
 func (s *ProductSpec) DeploymentOption() *string {
@@ -737,6 +1131,13 @@ func (s *ProductSpec) CredentialsLocation() *string {
 	return s.Deployment.CredentialsLocation()
 }
 
+func (s *ProductSpec) GatewayResponseSpec() *GatewayResponseSpec {
+	if s.Deployment == nil {
+		return nil
+	}
+	return s.Deployment.GatewayResponseSpec()
+}
+
 func (s *ProductSpec) AuthUserKey() *string {
 	if s.Deployment == nil {
 		return nil
@@ -758,6 +1159,45 @@ func (s *ProductSpec) AuthAppKey() *string {
 	return s.Deployment.AuthAppKey()
 }
 
+func (s *ProductSpec) OIDCSpec() *OIDCSpec {
+	if s.Deployment == nil {
+		return nil
+	}
+	return s.Deployment.OIDCSpec()
+}
+
+// PlannedChange describes a change to be applied to 3scale
+type PlannedChange struct {
+	// Section of the 3scale object. For instance, metrics or mappingRules
+	Section string `json:"section"`
+
+	// Action is one of create, update or delete
+	Action string `json:"action"`
+
+	// Name identifies the item in the section. For instance, the metric system name
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Fields lists the changed fields of updated items
+	// +optional
+	Fields []string `json:"fields,omitempty"`
+}
+
+// PlanStatus reports the changes computed in dry run mode
+type PlanStatus struct {
+	// Total number of planned changes
+	Total int `json:"total"`
+
+	// Changes lists the planned changes. Empty when the plan is published in a ConfigMap
+	// +optional
+	Changes []PlannedChange `json:"changes,omitempty"`
+
+	// ConfigMapRef references the ConfigMap holding the planned changes
+	// when there are too many to be published in the status
+	// +optional
+	ConfigMapRef *corev1.LocalObjectReference `json:"configMapRef,omitempty"`
+}
+
 // ProductStatus defines the observed state of Product
 // +k8s:openapi-gen=true
 type ProductStatus struct {
@@ -766,6 +1206,14 @@ type ProductStatus struct {
 	// +optional
 	State *string `json:"state,omitempty"`
 
+	// StagingConfigVersion is the latest proxy configuration version in the staging environment
+	// +optional
+	StagingConfigVersion *int64 `json:"stagingConfigVersion,omitempty"`
+
+	// ProductionConfigVersion is the latest proxy configuration version in the production environment
+	// +optional
+	ProductionConfigVersion *int64 `json:"productionConfigVersion,omitempty"`
+
 	// 3scale control plane host
 	// +optional
 	ProviderAccountHost string `json:"providerAccountHost,omitempty"`
@@ -774,6 +1222,10 @@ type ProductStatus struct {
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 
+	// Plan reports the changes computed in dry run mode
+	// +optional
+	Plan *PlanStatus `json:"plan,omitempty"`
+
 	// Current state of the 3scale product.
 	// Conditions represent the latest available observations of an object's state
 	// +optional
@@ -795,6 +1247,18 @@ func (p *ProductStatus) Equals(other *ProductStatus, logger logr.Logger) bool {
 		return false
 	}
 
+	if !reflect.DeepEqual(p.StagingConfigVersion, other.StagingConfigVersion) {
+		diff := cmp.Diff(p.StagingConfigVersion, other.StagingConfigVersion)
+		logger.V(1).Info("StagingConfigVersion not equal", "difference", diff)
+		return false
+	}
+
+	if !reflect.DeepEqual(p.ProductionConfigVersion, other.ProductionConfigVersion) {
+		diff := cmp.Diff(p.ProductionConfigVersion, other.ProductionConfigVersion)
+		logger.V(1).Info("ProductionConfigVersion not equal", "difference", diff)
+		return false
+	}
+
 	if p.ProviderAccountHost != other.ProviderAccountHost {
 		diff := cmp.Diff(p.ProviderAccountHost, other.ProviderAccountHost)
 		logger.V(1).Info("ProviderAccountHost not equal", "difference", diff)
@@ -807,6 +1271,12 @@ func (p *ProductStatus) Equals(other *ProductStatus, logger logr.Logger) bool {
 		return false
 	}
 
+	if !reflect.DeepEqual(p.Plan, other.Plan) {
+		diff := cmp.Diff(p.Plan, other.Plan)
+		logger.V(1).Info("Plan not equal", "difference", diff)
+		return false
+	}
+
 	// Marshalling sorts by condition type
 	currentMarshaledJSON, _ := p.Conditions.MarshalJSON()
 	otherMarshaledJSON, _ := other.Conditions.MarshalJSON()
@@ -955,6 +1425,24 @@ func (product *Product) Validate() field.ErrorList {
 		}
 	}
 
+	// Check application plan features refs exists
+	for planSystemName, planSpec := range product.Spec.ApplicationPlans {
+		planFldPath := applicationPlansFldPath.Key(planSystemName)
+		featuresFldPath := planFldPath.Child("features")
+		for idx, featureRef := range planSpec.Features {
+			if _, ok := product.Spec.Features[featureRef]; !ok {
+				errors = append(errors, field.Invalid(featuresFldPath.Index(idx), featureRef, "feature reference not found in product features."))
+			}
+		}
+	}
+
+	// Check default application plan ref exists
+	if product.Spec.DefaultApplicationPlan != nil {
+		if _, ok := product.Spec.ApplicationPlans[*product.Spec.DefaultApplicationPlan]; !ok {
+			errors = append(errors, field.Invalid(specFldPath.Child("defaultApplicationPlan"), *product.Spec.DefaultApplicationPlan, "default application plan not found in product application plans."))
+		}
+	}
+
 	// Check application plan limits keys (periods, metric) are unique
 	for planSystemName, planSpec := range product.Spec.ApplicationPlans {
 		planFldPath := applicationPlansFldPath.Key(planSystemName)
@@ -1017,6 +1505,52 @@ func (product *Product) IsSynced() bool {
 	return product.Status.Conditions.IsTrueFor(ProductSyncedConditionType)
 }
 
+// IsGenerationSynced returns true when the current spec generation has already been synchronized with 3scale.
+// Differences found afterwards were made in 3scale, not in the spec
+func (product *Product) IsGenerationSynced() bool {
+	return product.Status.ObservedGeneration == product.Generation && product.IsSynced()
+}
+
+// OrphanOnDelete returns true when the 3scale product must not be deleted
+// when the resource is deleted
+func (product *Product) OrphanOnDelete() bool {
+	return product.GetAnnotations()[OrphanOnDeleteAnnotation] == "true"
+}
+
+// Owns returns true when the 3scale product with the given ID was created or adopted by the resource.
+// The ID of the managed 3scale product is recorded in the status.
+// When unknown, the product claimed with its system name is owned
+func (product *Product) Owns(id int64) bool {
+	if product.Status.ID != nil {
+		return *product.Status.ID == id
+	}
+	return product.ClaimsSystemName()
+}
+
+// ClaimsSystemName returns true when the resource created, or requested the creation of, the 3scale product with its system name
+func (product *Product) ClaimsSystemName() bool {
+	claim := product.GetAnnotations()[ClaimedSystemNameAnnotation]
+	return claim != "" && claim == product.Spec.SystemName
+}
+
+// BackendUsageNamespace returns the namespace of the Backend resource used by the backend usage
+func (product *Product) BackendUsageNamespace(backendSystemName string) string {
+	if backendUsage, ok := product.Spec.BackendUsages[backendSystemName]; ok && backendUsage.Namespace != "" {
+		return backendUsage.Namespace
+	}
+
+	return product.Namespace
+}
+
+// UsesBackend returns true when the backend resource is referenced in the backend usages
+func (product *Product) UsesBackend(backend *Backend) bool {
+	if _, ok := product.Spec.BackendUsages[backend.Spec.SystemName]; !ok {
+		return false
+	}
+
+	return product.BackendUsageNamespace(backend.Spec.SystemName) == backend.Namespace
+}
+
 func (product *Product) FindMetricOrMethod(ref string) bool {
 	if len(product.Spec.Metrics) > 0 {
 		if _, ok := product.Spec.Metrics[ref]; ok {
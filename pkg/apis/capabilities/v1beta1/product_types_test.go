@@ -4,7 +4,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/3scale/3scale-operator/pkg/common"
+
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -124,6 +127,46 @@ func TestValidateProductPlanLimitUnkonwnRef(t *testing.T) {
 	}
 }
 
+func TestValidateProductPlanFeatureUnknownRef(t *testing.T) {
+	product := defaultTestingProduct()
+
+	product.Spec.Features = map[string]FeatureSpec{
+		"feature01": FeatureSpec{Name: "Feature 01"},
+	}
+
+	product.Spec.ApplicationPlans = map[string]ApplicationPlanSpec{
+		"plan01": ApplicationPlanSpec{
+			Features: []string{"feature01", "unknownRef"},
+		},
+	}
+
+	errors := product.Validate()
+	if len(errors) != 1 || !strings.Contains(errors.ToAggregate().Error(), "feature reference not found in product features.") {
+		t.Error("valition passes and plan feature does not have valid product feature reference.")
+	}
+}
+
+func TestValidateProductDefaultPlanUnknownRef(t *testing.T) {
+	product := defaultTestingProduct()
+
+	product.Spec.ApplicationPlans = map[string]ApplicationPlanSpec{
+		"plan01": ApplicationPlanSpec{},
+	}
+
+	defaultPlan := "plan01"
+	product.Spec.DefaultApplicationPlan = &defaultPlan
+	errors := product.Validate()
+	if len(errors) != 0 {
+		t.Errorf("default plan reference is valid: %v", errors)
+	}
+
+	defaultPlan = "unknownRef"
+	errors = product.Validate()
+	if len(errors) != 1 || !strings.Contains(errors.ToAggregate().Error(), "default application plan not found in product application plans.") {
+		t.Error("valition passes and default plan does not have valid plan reference.")
+	}
+}
+
 func TestValidateProductPlanPricingRuleUnkonwnRef(t *testing.T) {
 	product := defaultTestingProduct()
 
@@ -260,3 +303,121 @@ func TestValidateProductHappyPath(t *testing.T) {
 		t.Errorf("product validation fails: %s", errors.ToAggregate().Error())
 	}
 }
+
+func TestProductReportDriftOnly(t *testing.T) {
+	product := defaultTestingProduct()
+	if product.Spec.ReportDriftOnly() {
+		t.Errorf("default drift policy should correct drift")
+	}
+
+	policy := DriftPolicyCorrect
+	product.Spec.DriftPolicy = &policy
+	if product.Spec.ReportDriftOnly() {
+		t.Errorf("correct drift policy should correct drift")
+	}
+
+	policy = DriftPolicyReport
+	if !product.Spec.ReportDriftOnly() {
+		t.Errorf("report drift policy should only report drift")
+	}
+}
+
+func TestProductOwns(t *testing.T) {
+	product := defaultTestingProduct()
+	if product.Owns(3) {
+		t.Errorf("product without recorded ID should not own any 3scale product")
+	}
+
+	productID := int64(3)
+	product.Status.ID = &productID
+	if !product.Owns(3) {
+		t.Errorf("product should own the 3scale product with the recorded ID")
+	}
+
+	if product.Owns(4) {
+		t.Errorf("product should not own a 3scale product with a different ID")
+	}
+}
+
+func TestProductOwnsClaimedSystemName(t *testing.T) {
+	product := defaultTestingProduct()
+	product.Annotations = map[string]string{ClaimedSystemNameAnnotation: product.Spec.SystemName}
+	if !product.Owns(3) {
+		t.Errorf("product without recorded ID should own the 3scale product claimed with its system name")
+	}
+
+	productID := int64(4)
+	product.Status.ID = &productID
+	if product.Owns(3) {
+		t.Errorf("recorded ID should take precedence over the claimed system name")
+	}
+
+	product.Status.ID = nil
+	product.Annotations[ClaimedSystemNameAnnotation] = "other"
+	if product.Owns(3) {
+		t.Errorf("product should not own 3scale products claimed with another system name")
+	}
+}
+
+func TestProductUsesBackend(t *testing.T) {
+	product := defaultTestingProduct()
+	product.Namespace = "team-a"
+	product.Spec.BackendUsages = map[string]BackendUsageSpec{
+		"local":  {Path: "/local"},
+		"remote": {Path: "/remote", Namespace: "shared"},
+	}
+
+	cases := []struct {
+		testName   string
+		systemName string
+		namespace  string
+		expected   bool
+	}{
+		{"local backend", "local", "team-a", true},
+		{"local backend in another namespace", "local", "shared", false},
+		{"remote backend", "remote", "shared", true},
+		{"remote backend in the product namespace", "remote", "team-a", false},
+		{"unused backend", "other", "team-a", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			backend := &Backend{}
+			backend.Namespace = tc.namespace
+			backend.Spec.SystemName = tc.systemName
+			if product.UsesBackend(backend) != tc.expected {
+				subT.Errorf("expected %t", tc.expected)
+			}
+		})
+	}
+}
+
+func TestProductIsGenerationSynced(t *testing.T) {
+	synced := common.Conditions{{Type: ProductSyncedConditionType, Status: corev1.ConditionTrue}}
+	failed := common.Conditions{{Type: ProductFailedConditionType, Status: corev1.ConditionTrue}}
+
+	cases := []struct {
+		testName           string
+		generation         int64
+		observedGeneration int64
+		conditions         common.Conditions
+		expected           bool
+	}{
+		{"first sync", 1, 0, nil, false},
+		{"spec changed", 2, 1, synced, false},
+		{"previous sync failed", 1, 1, failed, false},
+		{"synced", 1, 1, synced, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			product := defaultTestingProduct()
+			product.Generation = tc.generation
+			product.Status.ObservedGeneration = tc.observedGeneration
+			product.Status.Conditions = tc.conditions
+			if synced := product.IsGenerationSynced(); synced != tc.expected {
+				subT.Errorf("expected generation synced %t, got %t", tc.expected, synced)
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package v1beta1
+
+import (
+	"net/url"
+
+	"github.com/3scale/3scale-operator/pkg/common"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	ProviderAccountKind = "ProviderAccount"
+
+	// ProviderAccountAllNamespaces allows resources in any namespace to reference the provider account
+	ProviderAccountAllNamespaces = "*"
+
+	// ProviderAccountInvalidConditionType represents that the combination of configuration
+	// in the ProviderAccountSpec is not supported. This is not a transient error, but
+	// indicates a state that must be fixed before progress can be made.
+	ProviderAccountInvalidConditionType common.ConditionType = "Invalid"
+
+	// ProviderAccountReadyConditionType indicates the provider account credentials have been successfully verified.
+	// Steady state
+	ProviderAccountReadyConditionType common.ConditionType = "Ready"
+
+	// ProviderAccountReachableConditionType indicates the 3scale Account Management API responded,
+	// regardless of the credentials being accepted or not.
+	ProviderAccountReachableConditionType common.ConditionType = "Reachable"
+
+	// ProviderAccountFailedConditionType indicates that an error occurred during credentials verification.
+	// The operator will retry.
+	ProviderAccountFailedConditionType common.ConditionType = "Failed"
+)
+
+// ProviderAccountReference references the provider account credentials:
+// a ProviderAccount resource or, only from the same namespace, a provider account secret
+type ProviderAccountReference struct {
+	// Name of the ProviderAccount resource or the provider account secret
+	Name string `json:"name"`
+
+	// Namespace of the ProviderAccount resource. Defaults to the namespace of the referencing resource.
+	// The ProviderAccount resource must allow the referencing namespace in spec.allowedNamespaces
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// ProviderAccountTLSSpec defines the TLS settings used to connect to the 3scale admin portal
+type ProviderAccountTLSSpec struct {
+	// CACertificateRef references the secret key holding the PEM encoded CA certificate bundle
+	// trusted to verify the admin portal certificate, in addition to the system CA certificates
+	// +optional
+	CACertificateRef *corev1.SecretKeySelector `json:"caCertificateRef,omitempty"`
+
+	// InsecureSkipVerify disables the verification of the admin portal certificate
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// ProviderAccountSpec defines the desired state of ProviderAccount
+type ProviderAccountSpec struct {
+	// AdminURL is the 3scale tenant admin portal URL
+	// +kubebuilder:validation:Pattern=`^https?:\/\/.*$`
+	AdminURL string `json:"adminURL"`
+
+	// TokenSecretRef references the secret key holding the 3scale access token
+	TokenSecretRef corev1.SecretKeySelector `json:"tokenSecretRef"`
+
+	// TLS settings used to connect to the admin portal
+	// +optional
+	TLS *ProviderAccountTLSSpec `json:"tls,omitempty"`
+
+	// AllowedNamespaces lists the namespaces whose resources can reference this provider account.
+	// Resources in the provider account namespace are always allowed. "*" allows all namespaces
+	// +optional
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty"`
+}
+
+// ProviderAccountStatus defines the observed state of ProviderAccount
+type ProviderAccountStatus struct {
+	// TenantName is the organization name of the 3scale provider account
+	// +optional
+	TenantName string `json:"tenantName,omitempty"`
+
+	// 3scale control plane host
+	// +optional
+	ProviderAccountHost string `json:"providerAccountHost,omitempty"`
+
+	// ObservedGeneration reflects the generation of the most recently observed ProviderAccount Spec.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Current state of the provider account.
+	// Conditions represent the latest available observations of an object's state
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions common.Conditions `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,2,rep,name=conditions"`
+}
+
+func (p *ProviderAccountStatus) Equals(other *ProviderAccountStatus, logger logr.Logger) bool {
+	if p.TenantName != other.TenantName {
+		diff := cmp.Diff(p.TenantName, other.TenantName)
+		logger.V(1).Info("TenantName not equal", "difference", diff)
+		return false
+	}
+
+	if p.ProviderAccountHost != other.ProviderAccountHost {
+		diff := cmp.Diff(p.ProviderAccountHost, other.ProviderAccountHost)
+		logger.V(1).Info("ProviderAccountHost not equal", "difference", diff)
+		return false
+	}
+
+	if p.ObservedGeneration != other.ObservedGeneration {
+		diff := cmp.Diff(p.ObservedGeneration, other.ObservedGeneration)
+		logger.V(1).Info("ObservedGeneration not equal", "difference", diff)
+		return false
+	}
+
+	// Marshalling sorts by condition type
+	currentMarshaledJSON, _ := p.Conditions.MarshalJSON()
+	otherMarshaledJSON, _ := other.Conditions.MarshalJSON()
+	if string(currentMarshaledJSON) != string(otherMarshaledJSON) {
+		diff := cmp.Diff(string(currentMarshaledJSON), string(otherMarshaledJSON))
+		logger.V(1).Info("Conditions not equal", "difference", diff)
+		return false
+	}
+
+	return true
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProviderAccount is the Schema for the provideraccounts API
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=provideraccounts,scope=Namespaced
+// +operator-sdk:gen-csv:customresourcedefinitions.displayName="3scale ProviderAccount"
+type ProviderAccount struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProviderAccountSpec   `json:"spec,omitempty"`
+	Status ProviderAccountStatus `json:"status,omitempty"`
+}
+
+// Validate checks the admin URL is an absolute HTTP(S) URL
+// and the secret references are complete
+func (providerAccount *ProviderAccount) Validate() field.ErrorList {
+	errors := field.ErrorList{}
+
+	specFldPath := field.NewPath("spec")
+	adminURLFldPath := specFldPath.Child("adminURL")
+
+	adminURL, err := url.Parse(providerAccount.Spec.AdminURL)
+	if err != nil {
+		errors = append(errors, field.Invalid(adminURLFldPath, providerAccount.Spec.AdminURL, err.Error()))
+	} else if (adminURL.Scheme != "http" && adminURL.Scheme != "https") || adminURL.Host == "" {
+		errors = append(errors, field.Invalid(adminURLFldPath, providerAccount.Spec.AdminURL, "admin URL must be an absolute http or https URL"))
+	}
+
+	tokenFldPath := specFldPath.Child("tokenSecretRef")
+	if providerAccount.Spec.TokenSecretRef.Name == "" {
+		errors = append(errors, field.Required(tokenFldPath.Child("name"), "token secret name is required"))
+	}
+	if providerAccount.Spec.TokenSecretRef.Key == "" {
+		errors = append(errors, field.Required(tokenFldPath.Child("key"), "token secret key is required"))
+	}
+
+	if providerAccount.Spec.TLS != nil && providerAccount.Spec.TLS.CACertificateRef != nil {
+		caFldPath := specFldPath.Child("tls").Child("caCertificateRef")
+		if providerAccount.Spec.TLS.CACertificateRef.Name == "" {
+			errors = append(errors, field.Required(caFldPath.Child("name"), "CA certificate secret name is required"))
+		}
+		if providerAccount.Spec.TLS.CACertificateRef.Key == "" {
+			errors = append(errors, field.Required(caFldPath.Child("key"), "CA certificate secret key is required"))
+		}
+	}
+
+	return errors
+}
+
+// AllowsNamespace returns true when resources in the given namespace can reference this provider account
+func (providerAccount *ProviderAccount) AllowsNamespace(namespace string) bool {
+	if namespace == providerAccount.Namespace {
+		return true
+	}
+
+	for _, allowed := range providerAccount.Spec.AllowedNamespaces {
+		if allowed == ProviderAccountAllNamespaces || allowed == namespace {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (providerAccount *ProviderAccount) IsReady() bool {
+	return providerAccount.Status.Conditions.IsTrueFor(ProviderAccountReadyConditionType)
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProviderAccountList contains a list of ProviderAccount
+type ProviderAccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProviderAccount `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ProviderAccount{}, &ProviderAccountList{})
+}
@@ -0,0 +1,71 @@
+package v1beta1
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestValidateProviderAccount(t *testing.T) {
+	tokenRef := corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "tenant-secret"},
+		Key:                  "token",
+	}
+
+	cases := []struct {
+		testName  string
+		adminURL  string
+		tokenRef  corev1.SecretKeySelector
+		tls       *ProviderAccountTLSSpec
+		expectErr bool
+	}{
+		{"relative URL", "example-admin.3scale.net", tokenRef, nil, true},
+		{"unsupported scheme", "ftp://example-admin.3scale.net", tokenRef, nil, true},
+		{"missing token key", "https://example-admin.3scale.net", corev1.SecretKeySelector{LocalObjectReference: tokenRef.LocalObjectReference}, nil, true},
+		{"missing CA certificate secret", "https://example-admin.3scale.net", tokenRef, &ProviderAccountTLSSpec{CACertificateRef: &corev1.SecretKeySelector{Key: "ca.crt"}}, true},
+		{"valid", "https://example-admin.3scale.net", tokenRef, nil, false},
+		{"valid with TLS settings", "https://example-admin.3scale.net:8443", tokenRef, &ProviderAccountTLSSpec{CACertificateRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "ca"}, Key: "ca.crt"}}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			providerAccount := ProviderAccount{
+				Spec: ProviderAccountSpec{
+					AdminURL:       tc.adminURL,
+					TokenSecretRef: tc.tokenRef,
+					TLS:            tc.tls,
+				},
+			}
+			errors := providerAccount.Validate()
+			if tc.expectErr != (len(errors) > 0) {
+				subT.Errorf("expected error: %t, got: %v", tc.expectErr, errors)
+			}
+		})
+	}
+}
+
+func TestProviderAccountAllowsNamespace(t *testing.T) {
+	cases := []struct {
+		testName          string
+		allowedNamespaces []string
+		namespace         string
+		expected          bool
+	}{
+		{"same namespace", nil, "shared", true},
+		{"not allowed", nil, "team-a", false},
+		{"allowed", []string{"team-a", "team-b"}, "team-b", true},
+		{"all namespaces", []string{ProviderAccountAllNamespaces}, "team-c", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			providerAccount := ProviderAccount{
+				Spec: ProviderAccountSpec{AllowedNamespaces: tc.allowedNamespaces},
+			}
+			providerAccount.Namespace = "shared"
+			if providerAccount.AllowsNamespace(tc.namespace) != tc.expected {
+				subT.Errorf("expected %t", tc.expected)
+			}
+		})
+	}
+}
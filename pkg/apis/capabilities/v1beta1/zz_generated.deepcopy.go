@@ -11,103 +11,76 @@ import (
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ApicastHostedSpec) DeepCopyInto(out *ApicastHostedSpec) {
+func (in *AccountPlan) DeepCopyInto(out *AccountPlan) {
 	*out = *in
-	if in.Authentication != nil {
-		in, out := &in.Authentication, &out.Authentication
-		*out = new(AuthenticationSpec)
-		(*in).DeepCopyInto(*out)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApicastHostedSpec.
-func (in *ApicastHostedSpec) DeepCopy() *ApicastHostedSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPlan.
+func (in *AccountPlan) DeepCopy() *AccountPlan {
 	if in == nil {
 		return nil
 	}
-	out := new(ApicastHostedSpec)
+	out := new(AccountPlan)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ApicastSelfManagedSpec) DeepCopyInto(out *ApicastSelfManagedSpec) {
-	*out = *in
-	if in.Authentication != nil {
-		in, out := &in.Authentication, &out.Authentication
-		*out = new(AuthenticationSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.StagingPublicBaseURL != nil {
-		in, out := &in.StagingPublicBaseURL, &out.StagingPublicBaseURL
-		*out = new(string)
-		**out = **in
-	}
-	if in.ProductionPublicBaseURL != nil {
-		in, out := &in.ProductionPublicBaseURL, &out.ProductionPublicBaseURL
-		*out = new(string)
-		**out = **in
-	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApicastSelfManagedSpec.
-func (in *ApicastSelfManagedSpec) DeepCopy() *ApicastSelfManagedSpec {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccountPlan) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(ApicastSelfManagedSpec)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AppKeyAppIDAuthenticationSpec) DeepCopyInto(out *AppKeyAppIDAuthenticationSpec) {
+func (in *AccountPlanList) DeepCopyInto(out *AccountPlanList) {
 	*out = *in
-	if in.AppID != nil {
-		in, out := &in.AppID, &out.AppID
-		*out = new(string)
-		**out = **in
-	}
-	if in.AppKey != nil {
-		in, out := &in.AppKey, &out.AppKey
-		*out = new(string)
-		**out = **in
-	}
-	if in.CredentialsLoc != nil {
-		in, out := &in.CredentialsLoc, &out.CredentialsLoc
-		*out = new(string)
-		**out = **in
-	}
-	if in.Security != nil {
-		in, out := &in.Security, &out.Security
-		*out = new(SecuritySpec)
-		(*in).DeepCopyInto(*out)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccountPlan, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppKeyAppIDAuthenticationSpec.
-func (in *AppKeyAppIDAuthenticationSpec) DeepCopy() *AppKeyAppIDAuthenticationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPlanList.
+func (in *AccountPlanList) DeepCopy() *AccountPlanList {
 	if in == nil {
 		return nil
 	}
-	out := new(AppKeyAppIDAuthenticationSpec)
+	out := new(AccountPlanList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccountPlanList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ApplicationPlanSpec) DeepCopyInto(out *ApplicationPlanSpec) {
+func (in *AccountPlanSpec) DeepCopyInto(out *AccountPlanSpec) {
 	*out = *in
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.AppsRequireApproval != nil {
-		in, out := &in.AppsRequireApproval, &out.AppsRequireApproval
+	if in.AccountsRequireApproval != nil {
+		in, out := &in.AccountsRequireApproval, &out.AccountsRequireApproval
 		*out = new(bool)
 		**out = **in
 	}
@@ -126,61 +99,59 @@ func (in *ApplicationPlanSpec) DeepCopyInto(out *ApplicationPlanSpec) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.PricingRules != nil {
-		in, out := &in.PricingRules, &out.PricingRules
-		*out = make([]PricingRuleSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Published != nil {
+		in, out := &in.Published, &out.Published
+		*out = new(bool)
+		**out = **in
 	}
-	if in.Limits != nil {
-		in, out := &in.Limits, &out.Limits
-		*out = make([]LimitSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.ProviderAccountRef != nil {
+		in, out := &in.ProviderAccountRef, &out.ProviderAccountRef
+		*out = new(ProviderAccountReference)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationPlanSpec.
-func (in *ApplicationPlanSpec) DeepCopy() *ApplicationPlanSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPlanSpec.
+func (in *AccountPlanSpec) DeepCopy() *AccountPlanSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ApplicationPlanSpec)
+	out := new(AccountPlanSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AuthenticationSpec) DeepCopyInto(out *AuthenticationSpec) {
+func (in *AccountPlanStatus) DeepCopyInto(out *AccountPlanStatus) {
 	*out = *in
-	if in.UserKeyAuthentication != nil {
-		in, out := &in.UserKeyAuthentication, &out.UserKeyAuthentication
-		*out = new(UserKeyAuthenticationSpec)
-		(*in).DeepCopyInto(*out)
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int64)
+		**out = **in
 	}
-	if in.AppKeyAppIDAuthentication != nil {
-		in, out := &in.AppKeyAppIDAuthentication, &out.AppKeyAppIDAuthentication
-		*out = new(AppKeyAppIDAuthenticationSpec)
-		(*in).DeepCopyInto(*out)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(common.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationSpec.
-func (in *AuthenticationSpec) DeepCopy() *AuthenticationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPlanStatus.
+func (in *AccountPlanStatus) DeepCopy() *AccountPlanStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(AuthenticationSpec)
+	out := new(AccountPlanStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Backend) DeepCopyInto(out *Backend) {
+func (in *ActiveDoc) DeepCopyInto(out *ActiveDoc) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -189,18 +160,18 @@ func (in *Backend) DeepCopyInto(out *Backend) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Backend.
-func (in *Backend) DeepCopy() *Backend {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActiveDoc.
+func (in *ActiveDoc) DeepCopy() *ActiveDoc {
 	if in == nil {
 		return nil
 	}
-	out := new(Backend)
+	out := new(ActiveDoc)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Backend) DeepCopyObject() runtime.Object {
+func (in *ActiveDoc) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -208,13 +179,13 @@ func (in *Backend) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BackendList) DeepCopyInto(out *BackendList) {
+func (in *ActiveDocList) DeepCopyInto(out *ActiveDocList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Backend, len(*in))
+		*out = make([]ActiveDoc, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -222,18 +193,18 @@ func (in *BackendList) DeepCopyInto(out *BackendList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendList.
-func (in *BackendList) DeepCopy() *BackendList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActiveDocList.
+func (in *ActiveDocList) DeepCopy() *ActiveDocList {
 	if in == nil {
 		return nil
 	}
-	out := new(BackendList)
+	out := new(ActiveDocList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *BackendList) DeepCopyObject() runtime.Object {
+func (in *ActiveDocList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -241,178 +212,1175 @@ func (in *BackendList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BackendSpec) DeepCopyInto(out *BackendSpec) {
+func (in *ActiveDocOpenAPIRefSpec) DeepCopyInto(out *ActiveDocOpenAPIRefSpec) {
 	*out = *in
-	if in.MappingRules != nil {
-		in, out := &in.MappingRules, &out.MappingRules
-		*out = make([]MappingRuleSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Inline != nil {
+		in, out := &in.Inline, &out.Inline
+		*out = new(string)
+		**out = **in
 	}
-	if in.Metrics != nil {
-		in, out := &in.Metrics, &out.Metrics
-		*out = make(map[string]MetricSpec, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Methods != nil {
-		in, out := &in.Methods, &out.Methods
-		*out = make(map[string]MethodSpec, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActiveDocOpenAPIRefSpec.
+func (in *ActiveDocOpenAPIRefSpec) DeepCopy() *ActiveDocOpenAPIRefSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ActiveDocOpenAPIRefSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActiveDocSpec) DeepCopyInto(out *ActiveDocSpec) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	in.ActiveDocOpenAPIRef.DeepCopyInto(&out.ActiveDocOpenAPIRef)
+	if in.ProductSystemName != nil {
+		in, out := &in.ProductSystemName, &out.ProductSystemName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Published != nil {
+		in, out := &in.Published, &out.Published
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SkipSwaggerValidations != nil {
+		in, out := &in.SkipSwaggerValidations, &out.SkipSwaggerValidations
+		*out = new(bool)
+		**out = **in
 	}
 	if in.ProviderAccountRef != nil {
 		in, out := &in.ProviderAccountRef, &out.ProviderAccountRef
-		*out = new(v1.LocalObjectReference)
+		*out = new(ProviderAccountReference)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendSpec.
-func (in *BackendSpec) DeepCopy() *BackendSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActiveDocSpec.
+func (in *ActiveDocSpec) DeepCopy() *ActiveDocSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(BackendSpec)
+	out := new(ActiveDocSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BackendStatus) DeepCopyInto(out *BackendStatus) {
+func (in *ActiveDocStatus) DeepCopyInto(out *ActiveDocStatus) {
 	*out = *in
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(int64)
 		**out = **in
 	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make(common.Conditions, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	if in.ProductResolvedID != nil {
+		in, out := &in.ProductResolvedID, &out.ProductResolvedID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(common.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActiveDocStatus.
+func (in *ActiveDocStatus) DeepCopy() *ActiveDocStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ActiveDocStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApicastHostedSpec) DeepCopyInto(out *ApicastHostedSpec) {
+	*out = *in
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(AuthenticationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApicastHostedSpec.
+func (in *ApicastHostedSpec) DeepCopy() *ApicastHostedSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApicastHostedSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApicastSelfManagedSpec) DeepCopyInto(out *ApicastSelfManagedSpec) {
+	*out = *in
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(AuthenticationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StagingPublicBaseURL != nil {
+		in, out := &in.StagingPublicBaseURL, &out.StagingPublicBaseURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProductionPublicBaseURL != nil {
+		in, out := &in.ProductionPublicBaseURL, &out.ProductionPublicBaseURL
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApicastSelfManagedSpec.
+func (in *ApicastSelfManagedSpec) DeepCopy() *ApicastSelfManagedSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApicastSelfManagedSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppKeyAppIDAuthenticationSpec) DeepCopyInto(out *AppKeyAppIDAuthenticationSpec) {
+	*out = *in
+	if in.AppID != nil {
+		in, out := &in.AppID, &out.AppID
+		*out = new(string)
+		**out = **in
+	}
+	if in.AppKey != nil {
+		in, out := &in.AppKey, &out.AppKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.CredentialsLoc != nil {
+		in, out := &in.CredentialsLoc, &out.CredentialsLoc
+		*out = new(string)
+		**out = **in
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(SecuritySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GatewayResponse != nil {
+		in, out := &in.GatewayResponse, &out.GatewayResponse
+		*out = new(GatewayResponseSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppKeyAppIDAuthenticationSpec.
+func (in *AppKeyAppIDAuthenticationSpec) DeepCopy() *AppKeyAppIDAuthenticationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AppKeyAppIDAuthenticationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Application) DeepCopyInto(out *Application) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Application.
+func (in *Application) DeepCopy() *Application {
+	if in == nil {
+		return nil
+	}
+	out := new(Application)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Application) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationList) DeepCopyInto(out *ApplicationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Application, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationList.
+func (in *ApplicationList) DeepCopy() *ApplicationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApplicationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationPlanSpec) DeepCopyInto(out *ApplicationPlanSpec) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.AppsRequireApproval != nil {
+		in, out := &in.AppsRequireApproval, &out.AppsRequireApproval
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TrialPeriod != nil {
+		in, out := &in.TrialPeriod, &out.TrialPeriod
+		*out = new(int)
+		**out = **in
+	}
+	if in.SetupFee != nil {
+		in, out := &in.SetupFee, &out.SetupFee
+		*out = new(string)
+		**out = **in
+	}
+	if in.CostMonth != nil {
+		in, out := &in.CostMonth, &out.CostMonth
+		*out = new(string)
+		**out = **in
+	}
+	if in.PricingRules != nil {
+		in, out := &in.PricingRules, &out.PricingRules
+		*out = make([]PricingRuleSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make([]LimitSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Published != nil {
+		in, out := &in.Published, &out.Published
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationPlanSpec.
+func (in *ApplicationPlanSpec) DeepCopy() *ApplicationPlanSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationPlanSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	out.DeveloperAccountRef = in.DeveloperAccountRef
+	out.ProductRef = in.ProductRef
+	if in.Suspend != nil {
+		in, out := &in.Suspend, &out.Suspend
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ProviderAccountRef != nil {
+		in, out := &in.ProviderAccountRef, &out.ProviderAccountRef
+		*out = new(ProviderAccountReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSpec.
+func (in *ApplicationSpec) DeepCopy() *ApplicationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationStatus) DeepCopyInto(out *ApplicationStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DeveloperAccountID != nil {
+		in, out := &in.DeveloperAccountID, &out.DeveloperAccountID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(common.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationStatus.
+func (in *ApplicationStatus) DeepCopy() *ApplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationSpec) DeepCopyInto(out *AuthenticationSpec) {
+	*out = *in
+	if in.UserKeyAuthentication != nil {
+		in, out := &in.UserKeyAuthentication, &out.UserKeyAuthentication
+		*out = new(UserKeyAuthenticationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppKeyAppIDAuthentication != nil {
+		in, out := &in.AppKeyAppIDAuthentication, &out.AppKeyAppIDAuthentication
+		*out = new(AppKeyAppIDAuthenticationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OIDC != nil {
+		in, out := &in.OIDC, &out.OIDC
+		*out = new(OIDCSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationSpec.
+func (in *AuthenticationSpec) DeepCopy() *AuthenticationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Backend) DeepCopyInto(out *Backend) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Backend.
+func (in *Backend) DeepCopy() *Backend {
+	if in == nil {
+		return nil
+	}
+	out := new(Backend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Backend) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendList) DeepCopyInto(out *BackendList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Backend, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendList.
+func (in *BackendList) DeepCopy() *BackendList {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackendList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendSpec) DeepCopyInto(out *BackendSpec) {
+	*out = *in
+	if in.MappingRules != nil {
+		in, out := &in.MappingRules, &out.MappingRules
+		*out = make([]MappingRuleSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make(map[string]MetricSpec, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make(map[string]MethodSpec, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProviderAccountRef != nil {
+		in, out := &in.ProviderAccountRef, &out.ProviderAccountRef
+		*out = new(ProviderAccountReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendSpec.
+func (in *BackendSpec) DeepCopy() *BackendSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendStatus) DeepCopyInto(out *BackendStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(common.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendStatus.
+func (in *BackendStatus) DeepCopy() *BackendStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendUsageSpec) DeepCopyInto(out *BackendUsageSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendUsageSpec.
+func (in *BackendUsageSpec) DeepCopy() *BackendUsageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendUsageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BillingAddressSpec) DeepCopyInto(out *BillingAddressSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BillingAddressSpec.
+func (in *BillingAddressSpec) DeepCopy() *BillingAddressSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BillingAddressSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomPolicyDefinition) DeepCopyInto(out *CustomPolicyDefinition) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomPolicyDefinition.
+func (in *CustomPolicyDefinition) DeepCopy() *CustomPolicyDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomPolicyDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomPolicyDefinition) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomPolicyDefinitionList) DeepCopyInto(out *CustomPolicyDefinitionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CustomPolicyDefinition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomPolicyDefinitionList.
+func (in *CustomPolicyDefinitionList) DeepCopy() *CustomPolicyDefinitionList {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomPolicyDefinitionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomPolicyDefinitionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomPolicyDefinitionSpec) DeepCopyInto(out *CustomPolicyDefinitionSpec) {
+	*out = *in
+	in.Schema.DeepCopyInto(&out.Schema)
+	if in.ProviderAccountRef != nil {
+		in, out := &in.ProviderAccountRef, &out.ProviderAccountRef
+		*out = new(ProviderAccountReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomPolicyDefinitionSpec.
+func (in *CustomPolicyDefinitionSpec) DeepCopy() *CustomPolicyDefinitionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomPolicyDefinitionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomPolicyDefinitionStatus) DeepCopyInto(out *CustomPolicyDefinitionStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(common.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomPolicyDefinitionStatus.
+func (in *CustomPolicyDefinitionStatus) DeepCopy() *CustomPolicyDefinitionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomPolicyDefinitionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeveloperAccount) DeepCopyInto(out *DeveloperAccount) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeveloperAccount.
+func (in *DeveloperAccount) DeepCopy() *DeveloperAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(DeveloperAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeveloperAccount) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeveloperAccountAdminSpec) DeepCopyInto(out *DeveloperAccountAdminSpec) {
+	*out = *in
+	if in.PasswordCredentialsRef != nil {
+		in, out := &in.PasswordCredentialsRef, &out.PasswordCredentialsRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeveloperAccountAdminSpec.
+func (in *DeveloperAccountAdminSpec) DeepCopy() *DeveloperAccountAdminSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeveloperAccountAdminSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeveloperAccountList) DeepCopyInto(out *DeveloperAccountList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeveloperAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeveloperAccountList.
+func (in *DeveloperAccountList) DeepCopy() *DeveloperAccountList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeveloperAccountList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeveloperAccountList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeveloperAccountSpec) DeepCopyInto(out *DeveloperAccountSpec) {
+	*out = *in
+	in.Admin.DeepCopyInto(&out.Admin)
+	if in.BillingAddress != nil {
+		in, out := &in.BillingAddress, &out.BillingAddress
+		*out = new(BillingAddressSpec)
+		**out = **in
+	}
+	if in.VatCode != nil {
+		in, out := &in.VatCode, &out.VatCode
+		*out = new(string)
+		**out = **in
+	}
+	if in.MonthlyBillingEnabled != nil {
+		in, out := &in.MonthlyBillingEnabled, &out.MonthlyBillingEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MonthlyChargingEnabled != nil {
+		in, out := &in.MonthlyChargingEnabled, &out.MonthlyChargingEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AccountPlan != nil {
+		in, out := &in.AccountPlan, &out.AccountPlan
+		*out = new(string)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProviderAccountRef != nil {
+		in, out := &in.ProviderAccountRef, &out.ProviderAccountRef
+		*out = new(ProviderAccountReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeveloperAccountSpec.
+func (in *DeveloperAccountSpec) DeepCopy() *DeveloperAccountSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeveloperAccountSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeveloperAccountStatus) DeepCopyInto(out *DeveloperAccountStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(common.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeveloperAccountStatus.
+func (in *DeveloperAccountStatus) DeepCopy() *DeveloperAccountStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeveloperAccountStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureSpec) DeepCopyInto(out *FeatureSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureSpec.
+func (in *FeatureSpec) DeepCopy() *FeatureSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayResponseSpec) DeepCopyInto(out *GatewayResponseSpec) {
+	*out = *in
+	if in.ErrorStatusAuthFailed != nil {
+		in, out := &in.ErrorStatusAuthFailed, &out.ErrorStatusAuthFailed
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ErrorHeadersAuthFailed != nil {
+		in, out := &in.ErrorHeadersAuthFailed, &out.ErrorHeadersAuthFailed
+		*out = new(string)
+		**out = **in
+	}
+	if in.ErrorAuthFailed != nil {
+		in, out := &in.ErrorAuthFailed, &out.ErrorAuthFailed
+		*out = new(string)
+		**out = **in
+	}
+	if in.ErrorStatusAuthMissing != nil {
+		in, out := &in.ErrorStatusAuthMissing, &out.ErrorStatusAuthMissing
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ErrorHeadersAuthMissing != nil {
+		in, out := &in.ErrorHeadersAuthMissing, &out.ErrorHeadersAuthMissing
+		*out = new(string)
+		**out = **in
+	}
+	if in.ErrorAuthMissing != nil {
+		in, out := &in.ErrorAuthMissing, &out.ErrorAuthMissing
+		*out = new(string)
+		**out = **in
+	}
+	if in.ErrorStatusNoMatch != nil {
+		in, out := &in.ErrorStatusNoMatch, &out.ErrorStatusNoMatch
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ErrorHeadersNoMatch != nil {
+		in, out := &in.ErrorHeadersNoMatch, &out.ErrorHeadersNoMatch
+		*out = new(string)
+		**out = **in
+	}
+	if in.ErrorNoMatch != nil {
+		in, out := &in.ErrorNoMatch, &out.ErrorNoMatch
+		*out = new(string)
+		**out = **in
+	}
+	if in.ErrorStatusLimitsExceeded != nil {
+		in, out := &in.ErrorStatusLimitsExceeded, &out.ErrorStatusLimitsExceeded
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ErrorHeadersLimitsExceeded != nil {
+		in, out := &in.ErrorHeadersLimitsExceeded, &out.ErrorHeadersLimitsExceeded
+		*out = new(string)
+		**out = **in
+	}
+	if in.ErrorLimitsExceeded != nil {
+		in, out := &in.ErrorLimitsExceeded, &out.ErrorLimitsExceeded
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayResponseSpec.
+func (in *GatewayResponseSpec) DeepCopy() *GatewayResponseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayResponseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LimitSpec) DeepCopyInto(out *LimitSpec) {
+	*out = *in
+	in.MetricMethodRef.DeepCopyInto(&out.MetricMethodRef)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LimitSpec.
+func (in *LimitSpec) DeepCopy() *LimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MappingRuleSpec) DeepCopyInto(out *MappingRuleSpec) {
+	*out = *in
+	if in.Last != nil {
+		in, out := &in.Last, &out.Last
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MappingRuleSpec.
+func (in *MappingRuleSpec) DeepCopy() *MappingRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MappingRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MethodSpec) DeepCopyInto(out *MethodSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MethodSpec.
+func (in *MethodSpec) DeepCopy() *MethodSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MethodSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricMethodRefSpec) DeepCopyInto(out *MetricMethodRefSpec) {
+	*out = *in
+	if in.BackendSystemName != nil {
+		in, out := &in.BackendSystemName, &out.BackendSystemName
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendStatus.
-func (in *BackendStatus) DeepCopy() *BackendStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricMethodRefSpec.
+func (in *MetricMethodRefSpec) DeepCopy() *MetricMethodRefSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(BackendStatus)
+	out := new(MetricMethodRefSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BackendUsageSpec) DeepCopyInto(out *BackendUsageSpec) {
+func (in *MetricSpec) DeepCopyInto(out *MetricSpec) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendUsageSpec.
-func (in *BackendUsageSpec) DeepCopy() *BackendUsageSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricSpec.
+func (in *MetricSpec) DeepCopy() *MetricSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(BackendUsageSpec)
+	out := new(MetricSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LimitSpec) DeepCopyInto(out *LimitSpec) {
+func (in *OIDCAuthenticationFlowSpec) DeepCopyInto(out *OIDCAuthenticationFlowSpec) {
 	*out = *in
-	in.MetricMethodRef.DeepCopyInto(&out.MetricMethodRef)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LimitSpec.
-func (in *LimitSpec) DeepCopy() *LimitSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCAuthenticationFlowSpec.
+func (in *OIDCAuthenticationFlowSpec) DeepCopy() *OIDCAuthenticationFlowSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LimitSpec)
+	out := new(OIDCAuthenticationFlowSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MappingRuleSpec) DeepCopyInto(out *MappingRuleSpec) {
+func (in *OIDCSpec) DeepCopyInto(out *OIDCSpec) {
 	*out = *in
-	if in.Last != nil {
-		in, out := &in.Last, &out.Last
-		*out = new(bool)
+	if in.JwtClaimWithClientID != nil {
+		in, out := &in.JwtClaimWithClientID, &out.JwtClaimWithClientID
+		*out = new(string)
+		**out = **in
+	}
+	if in.JwtClaimWithClientIDType != nil {
+		in, out := &in.JwtClaimWithClientIDType, &out.JwtClaimWithClientIDType
+		*out = new(string)
+		**out = **in
+	}
+	if in.AuthenticationFlow != nil {
+		in, out := &in.AuthenticationFlow, &out.AuthenticationFlow
+		*out = new(OIDCAuthenticationFlowSpec)
+		**out = **in
+	}
+	if in.CredentialsLoc != nil {
+		in, out := &in.CredentialsLoc, &out.CredentialsLoc
+		*out = new(string)
 		**out = **in
 	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(SecuritySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GatewayResponse != nil {
+		in, out := &in.GatewayResponse, &out.GatewayResponse
+		*out = new(GatewayResponseSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MappingRuleSpec.
-func (in *MappingRuleSpec) DeepCopy() *MappingRuleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCSpec.
+func (in *OIDCSpec) DeepCopy() *OIDCSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MappingRuleSpec)
+	out := new(OIDCSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MethodSpec) DeepCopyInto(out *MethodSpec) {
+func (in *PlanStatus) DeepCopyInto(out *PlanStatus) {
 	*out = *in
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]PlannedChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MethodSpec.
-func (in *MethodSpec) DeepCopy() *MethodSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanStatus.
+func (in *PlanStatus) DeepCopy() *PlanStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MethodSpec)
+	out := new(PlanStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MetricMethodRefSpec) DeepCopyInto(out *MetricMethodRefSpec) {
+func (in *PlannedChange) DeepCopyInto(out *PlannedChange) {
 	*out = *in
-	if in.BackendSystemName != nil {
-		in, out := &in.BackendSystemName, &out.BackendSystemName
-		*out = new(string)
-		**out = **in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricMethodRefSpec.
-func (in *MetricMethodRefSpec) DeepCopy() *MetricMethodRefSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlannedChange.
+func (in *PlannedChange) DeepCopy() *PlannedChange {
 	if in == nil {
 		return nil
 	}
-	out := new(MetricMethodRefSpec)
+	out := new(PlannedChange)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MetricSpec) DeepCopyInto(out *MetricSpec) {
+func (in *PolicyConfig) DeepCopyInto(out *PolicyConfig) {
 	*out = *in
+	in.Configuration.DeepCopyInto(&out.Configuration)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricSpec.
-func (in *MetricSpec) DeepCopy() *MetricSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyConfig.
+func (in *PolicyConfig) DeepCopy() *PolicyConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(MetricSpec)
+	out := new(PolicyConfig)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -564,9 +1532,45 @@ func (in *ProductSpec) DeepCopyInto(out *ProductSpec) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.DefaultApplicationPlan != nil {
+		in, out := &in.DefaultApplicationPlan, &out.DefaultApplicationPlan
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServicePlans != nil {
+		in, out := &in.ServicePlans, &out.ServicePlans
+		*out = make(map[string]ServicePlanSpec, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = make(map[string]FeatureSpec, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]PolicyConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ProductionConfigVersion != nil {
+		in, out := &in.ProductionConfigVersion, &out.ProductionConfigVersion
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(string)
+		**out = **in
+	}
 	if in.ProviderAccountRef != nil {
 		in, out := &in.ProviderAccountRef, &out.ProviderAccountRef
-		*out = new(v1.LocalObjectReference)
+		*out = new(ProviderAccountReference)
 		**out = **in
 	}
 	return
@@ -595,6 +1599,21 @@ func (in *ProductStatus) DeepCopyInto(out *ProductStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.StagingConfigVersion != nil {
+		in, out := &in.StagingConfigVersion, &out.StagingConfigVersion
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ProductionConfigVersion != nil {
+		in, out := &in.ProductionConfigVersion, &out.ProductionConfigVersion
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(PlanStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(common.Conditions, len(*in))
@@ -615,6 +1634,154 @@ func (in *ProductStatus) DeepCopy() *ProductStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderAccount) DeepCopyInto(out *ProviderAccount) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderAccount.
+func (in *ProviderAccount) DeepCopy() *ProviderAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProviderAccount) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderAccountList) DeepCopyInto(out *ProviderAccountList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProviderAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderAccountList.
+func (in *ProviderAccountList) DeepCopy() *ProviderAccountList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderAccountList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProviderAccountList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderAccountReference) DeepCopyInto(out *ProviderAccountReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderAccountReference.
+func (in *ProviderAccountReference) DeepCopy() *ProviderAccountReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderAccountReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderAccountSpec) DeepCopyInto(out *ProviderAccountSpec) {
+	*out = *in
+	in.TokenSecretRef.DeepCopyInto(&out.TokenSecretRef)
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ProviderAccountTLSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderAccountSpec.
+func (in *ProviderAccountSpec) DeepCopy() *ProviderAccountSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderAccountSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderAccountStatus) DeepCopyInto(out *ProviderAccountStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(common.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderAccountStatus.
+func (in *ProviderAccountStatus) DeepCopy() *ProviderAccountStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderAccountStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderAccountTLSSpec) DeepCopyInto(out *ProviderAccountTLSSpec) {
+	*out = *in
+	if in.CACertificateRef != nil {
+		in, out := &in.CACertificateRef, &out.CACertificateRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderAccountTLSSpec.
+func (in *ProviderAccountTLSSpec) DeepCopy() *ProviderAccountTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderAccountTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecuritySpec) DeepCopyInto(out *SecuritySpec) {
 	*out = *in
@@ -641,6 +1808,52 @@ func (in *SecuritySpec) DeepCopy() *SecuritySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServicePlanSpec) DeepCopyInto(out *ServicePlanSpec) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubscriptionsRequireApproval != nil {
+		in, out := &in.SubscriptionsRequireApproval, &out.SubscriptionsRequireApproval
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TrialPeriod != nil {
+		in, out := &in.TrialPeriod, &out.TrialPeriod
+		*out = new(int)
+		**out = **in
+	}
+	if in.SetupFee != nil {
+		in, out := &in.SetupFee, &out.SetupFee
+		*out = new(string)
+		**out = **in
+	}
+	if in.CostMonth != nil {
+		in, out := &in.CostMonth, &out.CostMonth
+		*out = new(string)
+		**out = **in
+	}
+	if in.Published != nil {
+		in, out := &in.Published, &out.Published
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePlanSpec.
+func (in *ServicePlanSpec) DeepCopy() *ServicePlanSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServicePlanSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserKeyAuthenticationSpec) DeepCopyInto(out *UserKeyAuthenticationSpec) {
 	*out = *in
@@ -659,6 +1872,11 @@ func (in *UserKeyAuthenticationSpec) DeepCopyInto(out *UserKeyAuthenticationSpec
 		*out = new(SecuritySpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GatewayResponse != nil {
+		in, out := &in.GatewayResponse, &out.GatewayResponse
+		*out = new(GatewayResponseSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
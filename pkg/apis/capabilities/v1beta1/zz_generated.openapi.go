@@ -164,10 +164,86 @@ func schema_pkg_apis_capabilities_v1beta1_ProductSpec(ref common.ReferenceCallba
 							},
 						},
 					},
+					"defaultApplicationPlan": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultApplicationPlan is the system name of the application plan assigned by default to new applications. It must be one of the application plans. When not set, the default plan is not reconciled",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"servicePlans": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Service Plans Map: system_name -> Service Plan Spec Service plans gate developer account subscriptions to the product. When not set, service plans are not reconciled",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/capabilities/v1beta1.ServicePlanSpec"),
+									},
+								},
+							},
+						},
+					},
+					"features": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Features Map: system_name -> FeatureSpec Features can be enabled in application plans When not set, features are not reconciled",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/capabilities/v1beta1.FeatureSpec"),
+									},
+								},
+							},
+						},
+					},
+					"policies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policies holds the product's policy chain. Order in the array matters. Policies are executed in the declared order.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/capabilities/v1beta1.PolicyConfig"),
+									},
+								},
+							},
+						},
+					},
+					"productionConfigVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProductionConfigVersion is the staging proxy configuration version to be promoted to production. When not set, production proxy configuration is not reconciled.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"driftPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriftPolicy defines how 3scale changes not made through the custom resource are handled. \"correct\" overwrites them with the spec, \"report\" only reports them in the OutOfSync condition. Default value is \"correct\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"adopt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Adopt allows taking over an existing 3scale product with the same system name not created by this resource. Otherwise, the existing 3scale product is reported as a conflict.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"dryRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DryRun computes the changes required to synchronize 3scale with the spec without applying them. The planned changes are published in the status.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"providerAccountRef": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ProviderAccountRef references account provider credentials",
-							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							Ref:         ref("./pkg/apis/capabilities/v1beta1.ProviderAccountReference"),
 						},
 					},
 				},
@@ -175,7 +251,7 @@ func schema_pkg_apis_capabilities_v1beta1_ProductSpec(ref common.ReferenceCallba
 			},
 		},
 		Dependencies: []string{
-			"./pkg/apis/capabilities/v1beta1.ApplicationPlanSpec", "./pkg/apis/capabilities/v1beta1.BackendUsageSpec", "./pkg/apis/capabilities/v1beta1.MappingRuleSpec", "./pkg/apis/capabilities/v1beta1.MethodSpec", "./pkg/apis/capabilities/v1beta1.MetricSpec", "./pkg/apis/capabilities/v1beta1.ProductDeploymentSpec", "k8s.io/api/core/v1.LocalObjectReference"},
+			"./pkg/apis/capabilities/v1beta1.ApplicationPlanSpec", "./pkg/apis/capabilities/v1beta1.BackendUsageSpec", "./pkg/apis/capabilities/v1beta1.FeatureSpec", "./pkg/apis/capabilities/v1beta1.MappingRuleSpec", "./pkg/apis/capabilities/v1beta1.MethodSpec", "./pkg/apis/capabilities/v1beta1.MetricSpec", "./pkg/apis/capabilities/v1beta1.PolicyConfig", "./pkg/apis/capabilities/v1beta1.ProductDeploymentSpec", "./pkg/apis/capabilities/v1beta1.ProviderAccountReference", "./pkg/apis/capabilities/v1beta1.ServicePlanSpec"},
 	}
 }
 
@@ -198,6 +274,27 @@ func schema_pkg_apis_capabilities_v1beta1_ProductStatus(ref common.ReferenceCall
 							Format: "",
 						},
 					},
+					"stagingConfigVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StagingConfigVersion is the latest proxy configuration version in the staging environment",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"productionConfigVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProductionConfigVersion is the latest proxy configuration version in the production environment",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"providerAccountHost": {
+						SchemaProps: spec.SchemaProps{
+							Description: "3scale control plane host",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ObservedGeneration reflects the generation of the most recently observed Product Spec.",
@@ -205,6 +302,12 @@ func schema_pkg_apis_capabilities_v1beta1_ProductStatus(ref common.ReferenceCall
 							Format:      "int64",
 						},
 					},
+					"plan": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Plan reports the changes computed in dry run mode",
+							Ref:         ref("./pkg/apis/capabilities/v1beta1.PlanStatus"),
+						},
+					},
 					"conditions": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -228,6 +331,6 @@ func schema_pkg_apis_capabilities_v1beta1_ProductStatus(ref common.ReferenceCall
 			},
 		},
 		Dependencies: []string{
-			"github.com/3scale/3scale-operator/pkg/common.Condition"},
+			"./pkg/apis/capabilities/v1beta1.PlanStatus", "github.com/3scale/3scale-operator/pkg/common.Condition"},
 	}
 }
@@ -6,6 +6,7 @@
 // assets/monitoring/kubernetes-resources-by-namespace-grafana-dashboard-1.json.tpl
 // assets/monitoring/kubernetes-resources-by-pod-grafana-dashboard-1.json.tpl
 // assets/monitoring/system-grafana-dashboard-1.json.tpl
+// assets/monitoring/threescale-operator-grafana-dashboard-1.json.tpl
 // assets/monitoring/zync-grafana-dashboard-1.json.tpl
 // DO NOT EDIT!
 
@@ -194,6 +195,26 @@ func monitoringSystemGrafanaDashboard1JsonTpl() (*asset, error) {
 	return a, nil
 }
 
+var _monitoringThreescaleOperatorGrafanaDashboard1JsonTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5c\x7b\x6f\xdb\x38\x12\xff\xdf\x9f\x82\x47\xec\x5d\x93\x83\x92\xd8\x4e\xbc\x69\x0c\xf4\x8f\xec\x2e\x7a\x2d\xd0\xde\xf6\xda\xde\x02\x45\x11\x78\x69\x71\x2c\x13\xa1\x48\x95\xa4\x12\x67\x03\xdf\x67\x3f\x90\x7a\x51\x0f\x27\x6e\xf3\xd8\xb6\x2b\xb8\x45\xc5\x11\x45\xce\xfb\x37\x1c\xd9\xbd\x1e\x20\x84\x89\x10\xd2\x10\xc3\xa4\xd0\x78\x8a\x2c\x09\x21\xcc\x99\x36\x78\x8a\x3e\xba\x11\xca\xa9\xf6\x0f\x9e\xa7\x8c\x9b\x97\x02\x4f\xd1\x28\xa8\xa8\x94\x18\xa2\x65\xaa\x42\xc0\x53\x84\xf7\xf6\xd0\xbf\x14\x59\x10\x41\xd0\xde\x1e\xf6\xa6\x81\x20\x73\x6e\xa7\x18\x95\x82\x47\x5f\x32\xda\x41\x65\xa1\x14\x3f\x4b\x2e\x95\x5d\x53\x45\x73\xb2\x33\x0c\xd0\x78\x34\x0a\xd0\x78\x32\x09\xd0\x68\xd7\x5f\x5a\x90\xd8\x2e\x81\x4f\x2b\x71\xd0\x3f\xd0\x29\x07\x65\xb4\x3f\xcf\x5c\x25\x6e\x1e\x25\x7a\x39\x97\x44\x51\x9c\xdf\x5b\xbb\x7f\xcf\x06\x08\xad\xed\x74\x0c\x94\x99\x06\xb7\x38\x12\x60\x5e\x52\x3c\x45\x22\xe5\xdc\xcd\x8a\x14\x49\x96\xef\xa5\xe4\x86\x25\x85\x4e\x30\x33\xa0\x1c\x0b\x25\x85\x33\x71\x6e\xd5\xfb\xf1\xcc\x0d\x13\x22\x80\xeb\x52\xc1\x85\x7a\x71\x28\x39\x27\x89\x06\xbb\xc5\x82\x70\x5d\x6a\x03\x47\x8a\xd1\x37\xb2\xb2\x90\xfd\xe0\x65\xb1\x7e\x3e\xbe\xc4\x53\x34\x3e\xf2\x08\x2b\x3c\x45\x43\x6f\x7c\x65\xc7\xf9\x70\x5d\xd0\x31\xa3\xfe\x3a\x1e\x73\x67\x25\xcd\x30\xe3\x34\x81\x7f\x26\x09\x99\x33\xce\x0c\x03\x8d\x14\x64\x36\xaf\x14\x5c\xaa\x57\xc9\x4b\x3c\xf0\xb6\x29\x45\x24\x9c\x11\xed\xac\xea\x84\xa9\xb8\x98\x13\xa5\x5b\x62\x5b\x2b\xbd\x02\x11\x19\x27\x6a\x29\x8a\xa3\x43\xd7\x74\xdf\x0d\x7f\xf0\x86\xe5\x94\x05\xe3\xbc\x26\xee\x46\xcd\x3e\x6d\x68\x76\x34\xbe\x45\xb3\xa3\x7c\x58\xc9\xe4\x34\x5b\x3e\x86\x39\x44\x20\x68\x7d\x27\x72\x11\x35\xc5\xb0\x8e\x90\x2a\x05\xc2\x74\xdc\x89\xc9\xaa\x8b\xca\x44\x07\x55\x2f\xe5\x65\x3b\xac\x8c\x34\x84\x77\xcc\xbe\x20\x3c\xad\x74\xda\x92\x85\x33\x01\xba\xb1\x9a\x23\x5e\x32\x6a\x6a\x9e\xd8\xf0\x76\x47\xb2\x01\xf3\x46\x32\x61\x5e\x4b\x17\xea\x8e\x50\xb9\x8d\x4c\xca\x04\x54\xed\x98\x80\x0a\x41\x18\x12\x41\x93\x5b\x9c\xd8\xa5\x14\xa1\x2c\xd5\x35\x0d\x3b\x7a\xdb\x2f\x14\x08\x0a\x0a\x5c\x22\x59\x70\x69\xaa\x8d\x35\x28\x06\xfa\xd7\x0b\x50\x8a\x51\x68\x30\xad\x13\x12\x42\x97\xfb\x69\x43\xc2\xf3\xd6\x2e\xda\x40\x92\x00\x7d\xc5\x44\x9b\x61\x43\x54\x04\xa6\x0a\x79\x3f\x26\xec\x07\xc3\x2a\x71\xec\xe9\x34\xde\x31\x4b\x05\xa0\x43\xc2\x61\x26\x13\x9b\x4a\xa4\x9a\x15\xb1\x36\xd3\x57\x22\x04\x7a\x0d\xab\x44\x2a\x03\x74\x66\x13\x9f\x63\xf4\xd9\x93\x1f\xca\xeb\x27\xeb\x5d\x34\xbf\x42\x3b\xe7\x4c\x50\x3f\x4d\xda\x00\x90\x2a\x26\xd6\xb3\xb0\x61\x31\xcc\x32\x05\xd4\xa7\x30\x61\x40\x5d\x10\xfe\x9c\x84\x46\x2a\xdf\xb4\x9e\x1b\x3f\x2f\xd7\xb9\xbe\xfe\xfd\xfa\xda\xee\xb4\x5e\xff\xbe\x5e\xa3\x8c\xc1\xfa\x8a\x0a\x16\x2e\x6f\xe2\xd3\x22\xdd\x7a\xae\xb5\x41\x13\xa1\x4c\x85\xf9\xc6\x75\x91\xc5\x5a\xb7\x2a\x7e\xf2\x54\x91\x5f\x55\xae\x67\xc5\xd6\x4b\xc9\x69\xc3\x25\x2d\x9f\xcf\x95\x8c\x3d\x0c\x2a\xe9\x6f\x21\xca\x83\xa8\xf1\xc0\xbb\x25\x5b\x98\xf6\x13\x79\x4a\x7f\x9b\x6b\x53\x5b\x2d\x59\x33\xa2\x9d\x4c\xaf\xe8\x20\xe3\xbf\x52\x1a\x36\x25\xd2\x55\x26\xc3\x7a\x49\x14\xd0\x46\x6a\xb0\x74\xa9\x4c\x23\x4d\xba\x1c\x33\x2b\x40\x82\x09\xca\x2e\x18\x4d\x09\xc7\x83\x86\x4f\x94\x40\xe2\x00\xb6\x62\x60\x45\x56\xac\x91\xab\xe7\x69\x78\x9e\x85\x96\x2f\x1f\x42\x38\xce\x53\x8d\xd5\x59\x47\xa9\xd0\x98\xdd\x9d\x2c\xcb\xa4\xf8\xf1\xac\xc5\xe2\x15\x59\xc1\x0d\x11\x4d\x21\x64\x31\xe1\xba\xae\x82\x9a\xd7\xe9\xa5\x55\x51\xed\x26\x27\x73\xb0\xd9\x19\x37\xc8\x32\xfa\x89\x68\x68\xf9\x5f\x06\x07\x75\x51\x4a\x3c\xa8\x6f\xeb\x09\x58\x92\xd7\x41\x37\xef\x5b\xb1\xd8\xda\xf5\x8b\x98\x6c\x91\x3b\xf9\xcc\xaf\x2a\xbf\xbe\x6a\x3b\x02\xe1\x2c\xea\x82\x41\x47\x7f\x05\x17\x25\xd3\xf9\xad\x75\x67\x81\x12\x4a\x9e\xc6\xcd\x28\xda\xa6\xb0\x90\xc2\xbc\x63\x7f\x58\x13\xe1\xd1\x70\xf8\x77\x7c\x0f\x15\x46\x8d\x70\x43\x89\x71\x78\x13\xf4\x76\x63\x2b\x89\x20\xe7\xd6\x37\x00\xd6\xa1\x92\x9c\x37\xe2\xc0\x99\xe4\x05\x10\x0a\xaa\x75\xc7\x7a\x47\x4d\xb0\x50\xf2\xba\xeb\x61\x0a\x3a\x2c\xcc\xd2\xe2\x5f\x9b\x2b\x7e\x53\x14\xb9\x7a\xd1\xea\xf4\x7d\x3d\x8a\x33\xa3\x40\x95\x79\x3f\x7c\xf8\xf0\x61\xef\xf5\xeb\xbd\x5f\x7e\x41\x2f\x5e\x4c\xe3\x78\xaa\x1b\x99\x3c\x21\xc6\x80\x12\xdd\x6b\x15\xe9\x66\xc9\x28\x05\xe1\x65\xe6\xe0\x16\xb6\xde\x75\xa0\x5d\x68\xcb\xdb\xa2\xd0\x09\x81\xf3\x8e\xdb\x75\x91\xed\x27\x3b\xe2\x8c\x8f\x26\x01\x9a\x1c\x65\x7f\x87\xfb\x27\x75\xc4\x2a\x67\x1d\x1e\x07\x68\x34\x3e\x09\xd0\xd1\xd0\x4e\x7b\xba\x61\xde\x64\x18\xa0\xd1\xf1\x38\x40\x76\xd5\xe1\xfe\xc9\xf1\x6e\x25\x9a\x17\x4d\x37\xe7\x2b\x4f\x71\xbf\xd9\x74\x58\xdb\xa9\x81\x54\xde\x1d\x84\xf0\x70\x7f\xd2\x64\xcb\x92\x36\xb2\x50\x58\x41\xa4\xf1\x1c\x54\x7d\x9f\x54\x30\x2f\x21\x95\x77\x6e\x35\xd0\x66\xd3\xb4\x32\xcf\xd7\x6f\x98\xf1\x26\xc3\x1c\xec\xff\xf3\xe0\x26\xbb\x74\xeb\x59\x1b\xc5\x44\xb4\x9d\x9e\x07\x0d\xd6\xb6\x2f\x69\xef\xa3\x84\x43\xcf\x9e\xa1\x21\x0e\xba\x31\x2a\x3b\xa7\xd7\x6e\x32\xa1\x0d\x11\xa6\x91\xae\xb6\xa9\xe8\x3a\x4b\xd5\xb6\xf0\x37\xd4\x61\xdb\x96\x5b\x42\x9a\x66\xb1\x8c\x8d\x22\x42\x5b\xc9\xda\x72\x95\x56\xcb\xc8\x9b\xc0\xeb\xc1\x1b\x08\x27\x83\x46\xe4\x65\x0d\x84\xa3\xed\x1a\x08\x6f\x21\x94\x22\x64\x1c\x90\x21\xfa\x5c\xb7\xa5\xfb\x4b\x76\x0e\x36\x34\x65\x26\x7d\xeb\xa0\x6f\x1d\x6c\xdd\x3a\x58\x32\x6d\x64\xa4\x48\x3c\xfb\x94\x12\x61\x18\x87\x9d\xe1\xfe\xc9\x24\x40\xb6\xa7\xa0\x88\x81\x0d\x87\xe9\x3c\x20\x67\x36\x20\x67\x34\xcd\x5a\x97\x33\x6d\x03\x95\xea\x59\x76\xbc\xba\xf6\xf3\x72\xf9\xac\x97\xa0\x3f\x4e\xe2\xb3\xdd\xac\xe9\x10\x4a\x61\x6c\x15\x09\x2a\x70\x41\x1e\x20\x0e\xbb\xbb\x1b\x93\xf7\x7d\x9e\xbc\xab\xad\xb3\x5e\x84\x3b\x8e\x5b\x1e\xdc\x10\x7f\x49\xa2\xef\x86\xd1\x87\x38\x88\xbf\x27\xfa\x1c\x15\xfa\x47\xc9\xc9\x04\xed\xcc\xaf\x50\x25\x12\x22\x82\x3a\x85\xf6\x07\xf2\x2f\x3a\x90\x57\x3e\xa7\xbb\x0f\xb4\xfd\x99\xfb\x4f\x3a\x73\x7f\x47\xd0\x3e\x1a\x6f\x89\xed\x3f\xf6\xd8\xde\x63\xfb\xd6\xd8\x7e\x27\x08\x77\x9d\xf4\xbb\x20\x78\x0f\xde\xdb\x81\x37\xac\x20\x4c\xad\xea\x35\x4a\x40\xa1\xac\x84\xea\x51\xfc\x81\x50\x5c\x26\x3d\x8e\x7f\x5d\x38\xfe\x18\xed\x87\xd1\xd3\x41\x43\xff\x59\xff\xe1\x78\xbb\xfe\xc3\xa1\xcb\x9d\xe8\xf4\xcd\x4b\xdc\x0a\x8a\xbf\x64\xeb\x61\x43\x3b\xe7\x69\x5f\x9e\x7c\xaf\xe5\x49\x4d\x17\x0f\x5f\x9d\x90\x84\xcd\x14\x7c\x4a\x41\x1b\x3d\x73\x66\xfc\xbc\x52\x84\xc2\x63\x95\x1f\x14\xbe\x85\x4a\xa3\xca\x60\xa8\x50\x6b\xbb\xda\xa0\xd0\x97\x17\x77\x2c\x2f\x14\x7c\x4a\xfa\x46\x41\xdf\x28\x78\xb4\x46\xc1\x06\x24\x3e\xe9\x91\xf8\x7b\x45\xe2\xc7\x6e\x14\x7c\x3e\x14\x07\x16\x4a\xfe\xf6\xbf\x27\xe3\xfd\xfd\x1a\x2c\xc7\x60\x96\x92\x06\x08\x04\x75\xd5\x4b\xd0\xc0\x9c\x87\x04\xea\x6c\x6f\xaf\x47\x50\x30\xe1\x91\xbe\x41\x30\x5f\x10\xc6\x81\x6e\xc4\xf4\x42\x48\xf7\x16\xa0\x07\xf8\x1e\xe0\x7b\x80\xff\xaa\x00\xfe\xd6\xc6\xc5\xf8\x38\x1f\x57\x42\x31\x5a\xe7\xbb\x7f\xcb\xdf\xbf\xe5\x7f\x98\xb7\xfc\x1e\xf2\xdf\xc7\x2b\xfe\x36\xfc\x3f\xda\x2b\xfe\x9b\xd1\xff\xdb\x81\x7b\x4e\x0c\x88\xf0\xaa\x7c\xcf\x5f\x88\xd1\xc3\xfa\x1d\x61\xbd\x87\xf4\x3f\x19\xd2\x07\xf9\xb2\xb6\xda\xb6\x91\x65\x95\x3f\x1a\x66\x66\xc1\x3a\x5c\x42\x4c\x7e\x03\xa5\xf3\xdf\x47\xba\x46\x73\xf6\x35\x70\x3b\x91\x12\x75\x9e\xcd\x34\x24\xaa\xec\x9d\x47\x4e\xae\x37\x5c\xa4\x27\x5c\xee\x65\x20\x4e\x38\x31\xf6\xeb\xac\x5b\xfc\x8a\x35\xff\xc1\xa9\x1f\x2c\x4c\x84\x3c\xa5\x70\xca\xbb\xa0\xaf\xdb\x42\x38\x4e\xb9\x61\x1d\xd3\x73\x9f\xf7\x0b\x0e\xef\x6e\x85\x65\x55\xee\x40\x08\x7f\x4a\x41\xd9\x8e\x3c\x4e\x94\xb4\x49\x0e\x52\xdf\x93\x3d\x65\x7a\xbe\x80\x15\x44\xb0\x6a\x78\x37\xd6\xe7\x2c\xf9\xaf\xe2\xf6\xab\xe9\x1d\xcc\x15\xb1\xee\x31\x37\x68\x38\x6f\xcd\x07\x78\xf6\xa5\xef\x96\xf0\x55\x09\x52\x4d\xf7\xcc\xe6\x89\x66\xa9\xb0\xca\x53\x38\xda\xff\x77\x01\x29\xa8\x99\xaf\x2f\xf2\x9d\xda\xd3\xba\x02\xec\x8b\xad\x88\x4b\x50\xc3\x9f\x61\xcc\xce\x87\x3c\x5b\x96\x44\x5f\x7f\x79\x0d\xc1\x21\x34\x1d\x49\x7b\x7b\xd5\x6c\xa7\x9c\x2a\xae\xf3\xb0\x68\xba\xd6\x0d\x7b\x6c\xe9\x35\x61\xaa\x8d\x8c\xf1\xa0\x61\x8d\xad\x3c\xe6\xf6\xfa\xdb\x4e\x82\x05\x13\x2c\xff\xf5\x74\x96\xbd\x67\xce\x2f\xb4\x5f\xd8\x5c\x64\x09\x64\xc6\xc4\x42\x06\xa8\x34\xcd\x2e\xbe\x0f\xf7\x28\xb2\x4b\xb5\xee\xe7\xf8\x49\xbb\x74\xda\xe0\x30\x9d\x16\xba\x8b\xc0\xf7\x96\x22\xf2\x5a\xc2\x5f\xc5\x90\xc8\x59\x55\xff\xa7\xe0\xd4\x5f\xae\x23\xe8\x5d\x22\xe8\x9e\x9c\xbb\x52\x26\xb3\x77\x23\xd5\xf0\x9e\x44\xad\x13\x46\xe3\x87\xf9\xae\xc0\x28\x73\xfc\x22\xeb\xc7\x60\x21\x2f\xf7\x46\x45\xe9\x82\x8d\xcc\x69\xb8\xf6\x58\xc2\xc2\x73\x50\x15\x40\xe4\x0a\x9b\x15\x75\xa8\x1f\xc7\x78\x52\xa5\xdf\x12\xbe\xdc\xe0\xd0\x1f\x8c\xe2\xea\x7a\xe2\x5d\x8f\xfc\xc1\xe1\xd0\xbf\xe3\x15\x58\x63\xef\x7a\x94\xff\x57\x04\xb9\x0e\x5d\x35\x39\x6b\xe7\x97\xcd\xbb\xf8\x0b\xff\xe8\x2f\xec\xef\x32\x3e\xf2\x07\xde\x2f\x0f\x8e\xbd\xeb\xc3\x21\xc5\x1d\x5a\xff\x43\xba\x83\x0b\xce\xf1\xb9\x28\x6a\x9b\x49\x05\x1d\xa0\xbc\xce\x3d\x40\xbf\xe6\xc1\x90\x3d\x92\x3b\x31\x9e\xa2\xd1\x60\xfd\xff\x01\x00\xf3\xe2\xfd\x26\x7e\x42\x00\x00")
+
+func monitoringThreescaleOperatorGrafanaDashboard1JsonTplBytes() ([]byte, error) {
+	return bindataRead(
+		_monitoringThreescaleOperatorGrafanaDashboard1JsonTpl,
+		"monitoring/threescale-operator-grafana-dashboard-1.json.tpl",
+	)
+}
+
+func monitoringThreescaleOperatorGrafanaDashboard1JsonTpl() (*asset, error) {
+	bytes, err := monitoringThreescaleOperatorGrafanaDashboard1JsonTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "monitoring/threescale-operator-grafana-dashboard-1.json.tpl", size: 17022, mode: os.FileMode(436), modTime: time.Unix(1792295657, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _monitoringZyncGrafanaDashboard1JsonTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x6d\x73\xdb\xb6\x96\xfe\x9e\x5f\x81\x65\x7b\xaf\x9d\xae\x64\x4b\xb2\xe5\xb7\x99\xcc\x4e\x9c\x34\xdb\xbb\x93\x64\xdd\xc4\xed\x4c\x9a\xc9\xe8\x42\xe4\x91\x84\x35\x09\x30\x00\x68\x4b\xf1\xb8\xbf\x7d\x87\xe0\x1b\xf8\x26\xc9\x36\x6d\x4b\x16\x3e\xb4\xb1\x00\x12\x04\xcf\x39\x38\xcf\x83\x83\x03\xe2\xfa\x05\x42\x16\xa6\x94\x49\x2c\x09\xa3\xc2\x3a\x41\x61\x11\x42\x96\x4b\x84\xb4\x4e\xd0\x57\xf5\x0b\xc5\xa5\xaa\x66\x18\x10\x57\xfe\x8b\x5a\x27\xa8\xdb\xca\x4a\x1d\x2c\xb1\x60\x01\xb7\xc1\x3a\x41\x56\xbb\x8d\xfe\x9b\xe3\x11\xa6\x18\xb5\xdb\x96\x76\x19\x50\x3c\x74\xc3\x4b\x24\x0f\x40\x2b\x9f\x10\xa7\xa2\x94\xd8\x8c\xbe\x61\x2e\xe3\x61\x9b\x7c\x3c\xc4\xdb\x9d\x16\xea\x75\xbb\x2d\xd4\xeb\xf7\x5b\xa8\xfb\x52\x6f\x9a\x62\x4f\x3d\xfb\x75\xf6\x3a\xe8\x9f\xe8\xb5\x0b\x5c\x0a\xfd\x3a\x39\xf3\xd5\x75\x0e\x16\x93\x21\xc3\xdc\xb1\xe2\xba\x1b\xf5\xef\xb7\x17\x08\xdd\x84\x97\x5b\xe0\x10\x59\xe8\xad\x35\xa6\x20\xff\xe5\x58\x27\x88\x06\xae\x1b\x95\x70\xec\x4f\xce\x19\x73\x25\xf1\x13\x99\x58\x44\x02\x57\x5d\x48\x4b\x5c\x42\x2f\x42\xf1\x7e\xfd\xa6\x7e\xfa\x98\x82\x2b\x52\x01\x27\xe2\xb5\x6c\xe6\xba\xd8\x17\x10\x3e\x62\x84\x5d\x91\x4a\xc3\x1a\x73\xe2\x9c\xb1\x4c\x43\x91\xd8\x0a\x5a\xb8\xb2\x4e\x50\x6f\x5f\x2b\x98\x5a\x27\xa8\xa3\xfd\x9e\x85\xbf\x93\xf7\x4d\xdb\x26\xe1\xe3\x8e\xfa\xe9\xef\xac\x77\xdf\xd2\x32\x49\xa4\x12\x85\xf5\xd7\x8c\xda\x56\x56\x1c\x4b\x93\xb3\xab\x48\x8e\x71\xab\xe9\x1b\x61\x97\x60\xa1\x94\xa8\xfa\x9e\x3d\x74\x88\x55\x49\xfe\x2d\x43\xa5\xbc\x07\x3a\x96\xea\xcd\x3a\xb9\x72\xa8\xba\x5c\xb7\xba\x9f\xb5\x9f\xe9\x25\x23\xe2\xba\xba\x94\xea\x05\x79\x54\x10\x64\xb7\xb7\x40\x90\xdd\x6a\x41\x1e\x1e\xa6\xbf\x5d\x18\x03\x75\xf2\x8f\xc2\x97\xe3\xe2\x7b\x84\x8a\x0f\x38\x07\x2a\x2b\x6a\x3c\x3c\xad\x2a\x25\xb4\xa2\x54\x4c\xd8\x55\x79\x18\x49\x26\xb1\x5b\x71\xf5\x25\x76\x83\x4c\xa8\xa5\x97\x71\x09\x55\xb5\x7a\x6b\xaa\xf0\x8a\x38\x32\x67\x79\x05\xeb\x56\x45\xe1\x00\x39\x63\x84\xca\x0f\x4c\x0d\x6d\x55\x90\xa9\x85\xf9\xa9\xc3\xc9\x9e\xe8\x03\xb7\x81\x4a\x3c\x86\x92\xa6\xfd\xb0\x29\x8e\x1d\x12\x84\xf7\xf4\xf2\xe5\x65\xc3\xe0\x40\x1d\xe0\xa0\x1c\xc7\xc8\x65\x32\x7b\xb0\x00\x4e\x40\xfc\xef\x25\x70\x4e\x1c\x28\x74\x5a\xf8\xd8\x86\x2a\xfb\x13\x12\xdb\x17\x45\x59\x08\x09\xbe\x0f\xce\x7b\x42\xcb\xfd\x95\x98\x8f\x41\x0a\xcd\x85\xea\x4e\x34\xf4\x2e\x53\x5f\xf5\x4e\x04\xde\x36\xc7\x12\xb6\x39\x26\xae\x18\x70\xf8\x1e\x80\x90\x62\xa0\x94\x76\x1d\x3a\x35\xd5\xa9\x57\x5b\x3f\xa7\x7f\x6f\xb5\x7c\xe6\xbc\xfa\x7b\xeb\xc7\x8c\xda\xed\xaf\xb8\xfd\xa3\xd3\x3e\xfe\xf6\x9f\xd9\x5f\x5b\x37\x5f\xbb\xde\xb7\x97\x2f\xd1\x70\x86\xb6\x85\xc4\x32\x10\xba\xa7\x0c\x07\x05\xe3\x1e\x0e\x8d\xcd\x92\xc4\x83\x41\x24\x93\xfc\x25\x84\x4a\xe0\x97\xca\x6e\xac\xae\x57\x5d\xf7\x0e\xdb\x52\x39\xe7\x6e\xae\x3a\xb2\xfa\x77\xe9\x33\xae\xaf\xff\x7d\x7d\x1d\xf5\xe3\xe6\xe6\xdf\x37\x37\xf9\xc6\x38\x8c\x94\x47\xb5\x5e\x5b\x69\xf1\x4d\xfc\x97\xe6\x7e\x26\x1c\xc4\x84\xb9\x4e\xc9\x2d\x79\xf0\x8e\x33\x4f\x73\xc9\x69\xf9\x27\x18\xc7\x36\x56\xb8\xe1\xf3\x84\x8c\x64\xf9\x0e\xcd\xc1\xa1\x44\x0f\xc8\x07\x8e\x04\xd8\x8c\x3a\x68\x7b\x38\x43\x45\x71\x5a\x32\x75\xfd\xd7\xfa\x38\xc4\x5c\xf9\xf0\xc2\x48\x14\x8c\xcb\x82\x23\x51\x83\x70\x90\xb8\x51\x42\x1d\x72\x49\x9c\x00\xbb\x56\x69\x3c\x26\xd7\x28\xc4\xc9\x3a\x30\xc5\x53\x52\xf0\x66\xc3\xc0\xbe\x88\x8c\x4f\x7f\xc3\xd0\x6b\xc4\x63\x31\x14\x42\x05\x76\x16\xae\xae\xf6\x26\xa9\xd7\xf8\xfa\xad\xd4\xc5\x19\x9e\xc2\x1c\x9b\x77\xc0\x26\x1e\x56\xc8\xd2\xad\xb1\x47\x0e\xdf\xfd\x82\x25\xba\x78\x08\xca\x0c\x0b\xc5\x6c\x7c\x8a\x05\x94\xda\x8a\xfc\x65\xfe\x55\x52\x87\x59\x2a\xd6\xde\x31\xb3\xbe\x56\x75\xf7\xb3\x5e\x8a\x49\xa8\xc8\xca\x5e\x96\x9e\xf0\x80\xfd\x2c\x8d\x92\x59\xd9\x16\xb0\x4b\xc6\x55\x50\xa1\xca\xdf\xc3\x65\xda\xe9\x1c\x0b\x7a\xc6\x28\x9e\x2b\x98\x07\xe3\x47\x06\xc6\x0d\x8c\xaf\x0a\x8c\xdb\x8c\x4a\xce\x5c\x17\xf8\xd3\x43\x79\xd6\x97\xb5\x87\xf3\x2a\xb1\x1a\x48\x37\x90\x8e\x0c\xa4\xaf\x11\xa4\x17\x27\xe6\xc7\x35\x88\x7e\x6c\x10\xdd\x20\xfa\x53\x22\x7a\x2b\x9a\x3e\xbe\xfa\x7b\xab\xf7\x35\x2c\xf9\xc5\x60\x7c\x73\x18\xdf\x9b\x4e\x0d\xce\xa7\x6f\x61\x70\xde\xe0\x3c\x5a\x6f\x9c\x3f\x28\xc0\x7c\x69\xe6\x5e\x83\xf3\x47\x1d\x83\xf3\x0d\xe0\x7c\xee\xb1\x2b\x02\xf3\xf9\xa7\xac\x01\xce\xef\x1b\x9c\x6f\x1a\xe7\xf7\x0d\xce\x6b\x6f\xf1\xec\x70\xde\xea\x58\x06\xe6\x37\x1a\xe6\x8f\x96\x84\xf9\xae\x81\xf9\xe7\x3a\x9d\x5f\x3b\x9c\xef\x1b\x9c\x6f\x1a\xe7\xfb\x06\xe7\xb5\xb7\x30\x38\x5f\xd9\x49\x83\xf3\x6b\x83\xf3\x8b\xf3\xe9\x0e\x6b\x80\x7e\xcf\x00\xbd\x01\xfa\x7b\x02\xfd\xc0\x09\xa2\x94\xdc\x41\x04\x23\x62\x20\x02\xef\x9e\x0b\xf3\xbb\x68\xd9\x67\xd9\x2c\xa0\xf2\x7e\x4f\xbb\x33\x85\x58\x92\x26\x9c\x2b\xa3\x5e\xcc\x0b\x6a\x5c\x72\x8d\xf4\x9d\xe1\x80\x07\x34\xee\xe3\x83\xc9\xbd\xe2\x29\x6b\x20\xf1\xd7\xb6\x24\x97\xf0\x09\x6c\xc6\x9d\x1a\xc1\x9f\xde\x59\xf0\x97\x04\xae\x1e\x43\xf4\x95\xcf\x59\x03\xe1\xff\x49\xe0\xaa\x46\xe8\x6f\x56\x84\x05\x7f\x0a\x05\x8c\xf0\x25\x70\x3c\x06\xc4\x41\xf8\x8c\x0a\x40\x39\xc6\x68\x48\xef\xdd\x48\x6f\x89\xd6\x69\x94\xd2\xac\x61\xad\x1a\xe9\xb5\x31\x77\x0a\x0d\x87\x45\x67\xd8\x71\x08\x1d\x97\x2d\x27\xac\xfc\xc4\x02\xea\x14\x1a\x4f\x7b\x6a\xc7\xbb\x8a\x0a\x0d\xa6\x9b\x8d\x7e\xea\x1f\x1e\xef\xbf\xeb\xe9\x36\xaa\x6e\xf9\x6c\xe3\x68\x6c\x8a\xef\x39\x0d\x24\xb5\x13\xf0\xe2\x71\x24\x81\xfb\xcc\xc5\x12\x4e\x95\xb9\x6a\x97\xc2\xd4\x67\x34\xa2\xa6\x9d\x9d\x7e\xc5\xe8\x60\x3e\xb6\x89\x9c\x95\x47\x60\xc8\xc7\x33\x0f\x26\x45\x32\xce\x6e\xc3\xdf\x1d\x10\x36\x27\x7e\xbc\x39\x29\x33\xea\x46\x33\x6a\xcb\x44\x7e\x02\x58\x7a\xd8\xcf\xd3\xd3\x09\x71\xe0\x2f\xe0\xec\x34\xf5\x17\x79\xca\x37\x21\xe3\x89\x4b\xc6\x13\xf9\x26\xd6\x7f\x8e\x39\x47\x73\x83\xf9\x9b\x6d\x62\xbb\xad\xe5\xe3\x45\x92\x5d\xc9\xa2\x39\x5c\x02\x17\xf0\xa5\xae\x9b\x8d\x33\xd3\x48\xaf\x4b\xe0\xe7\x4e\x3e\xe0\xe4\x42\x2d\x6c\x26\xf2\xaf\x84\xcc\xe5\x82\x4c\x9d\xb9\x78\xaa\xa2\x4c\x2e\xdc\x3d\xba\x34\x07\x3f\x17\xc0\x64\x45\x8c\x28\x7e\x5d\x15\x2b\xca\x01\x27\x8a\x64\x8b\x08\x8d\x2f\x5d\x62\x2f\x47\x15\x0a\x85\xa5\xbf\x11\x21\xd9\x98\x63\xaf\xd6\xc2\x12\xc8\x2c\x4a\xdf\x9a\xbe\x2e\x79\xca\xb2\x8b\xcd\xda\x99\x46\xa6\xf7\x31\xf0\x86\x6a\x0a\x96\x13\x44\x5c\xf9\x99\xfc\x28\x62\xa8\x35\x2b\x3f\x46\xc3\x40\x9d\x06\x54\xc3\x5f\x35\x7a\x54\x62\x47\x25\x72\xd4\x09\xcf\x77\x89\x4c\x0d\xab\xd2\x41\xcf\xa2\x97\x3a\x8d\x9d\xb8\x85\x03\xc9\xac\x62\x6d\xb5\x3c\x66\x25\x79\x18\x4c\xb9\x07\xa6\xd4\xe2\xc2\xe1\x6d\xe3\x3b\xbd\xfe\xe3\xc1\xc2\xfc\xcd\x1b\xcf\x1c\x16\x2a\x97\x2a\xb2\x90\xf9\xab\x2d\xca\x24\x19\x11\x3b\xda\x25\x6d\x20\xc4\xfa\xa8\xcb\xa3\x72\xd1\xc1\x00\x0a\x32\x80\x62\x00\xe5\x91\x26\x29\x77\xc7\x9c\xd2\x5c\xe4\x31\x41\x67\xfe\xfe\x82\x4d\x07\x1d\x09\x14\x53\x69\xe0\x06\x59\xe7\x91\x24\x0c\xd0\x18\xa0\x31\x40\xb3\xa6\x40\x53\x9c\xdc\xec\xf5\x1e\x0d\x67\x8e\xe7\x27\xbe\x6d\x3a\xce\x44\x39\x59\xbb\x2e\xb9\x04\x83\x35\xd6\x7b\x72\x09\x14\x84\x01\x1b\x03\x36\x06\x6c\xd6\x15\x6c\x4a\xb3\x9a\xc7\x44\x9b\xf9\xbb\xa9\x0c\xda\x28\xb4\xe1\x80\x9d\x99\x81\x1b\xeb\x13\x60\x87\x18\xbc\x31\x78\x73\x67\xbc\x79\x84\x2f\x60\xee\xd5\xec\x28\xe9\x6b\x69\x90\x8b\x3e\x81\x89\x7e\x0f\xf4\x2c\x9c\x55\xff\x0c\x66\x61\xd9\x3f\x52\x11\x62\x23\xf4\x7f\x6c\x28\x10\xd0\xef\x01\x04\xe0\x20\x4c\x1d\xa4\x5c\x19\x92\x0c\x0d\x01\xc1\x14\xec\x40\x82\x83\x5e\x7f\x7e\x7d\x86\xb6\x69\xe8\xa7\xd1\x08\x13\x17\x9c\x16\xa2\x8c\xa3\x31\x93\x08\xa6\x3e\xe1\xe0\xbc\xdc\x69\x22\x4b\x78\xa1\xf2\xf6\x6b\xbe\x5f\xba\x6f\xbe\xee\x61\xb2\x84\x97\x67\x0a\x1e\x9e\x6e\x7f\x0f\x60\x10\xda\xff\x40\xd8\x13\x70\x02\x17\x9c\xa5\x77\x03\x7d\x0f\x60\xe7\x97\xad\x56\x38\xee\x5f\x6d\xc5\xe0\x1f\xe1\x7e\xc8\x31\xb9\x04\x27\x6c\xf9\xa1\xf7\xfd\x2c\xc1\x02\xf4\xee\xac\xc3\xd6\x9f\x4f\xca\xfb\xfc\x4f\xe8\x95\x54\x16\xe9\xfd\x32\x1d\x7b\xc5\x71\xb7\x79\x99\x8e\x9d\x1a\x13\xa4\x8c\xc2\x43\xe6\x10\x9a\xfd\x3d\x7a\xf9\xda\xec\xef\x59\x92\x28\x14\xe8\x81\x60\x5e\x4c\xe4\x09\x45\x72\x02\x68\x14\xc8\x80\x43\x0b\x0d\x03\x89\x28\x0b\xff\xbb\x7a\x14\xfa\xb0\x30\x4e\xbb\x7f\x54\x4d\x1f\x8e\xcd\x26\x23\x43\x1f\x9e\x86\x3e\xa4\xb7\x1b\x0a\xd1\x08\x85\xf8\x9c\xc8\xd3\xd0\x08\x43\x23\x0c\x8d\x58\x41\x1a\x21\x27\x58\x6a\xe4\x21\xb0\x6d\x10\x62\x14\xb8\xee\xec\x61\x48\x40\x29\x7e\x5e\xcb\x02\xf6\x0d\x0b\x30\x2c\xe0\x49\x58\x40\x44\x8b\x0d\x05\x68\x84\x02\xbc\x53\xc2\x34\xf8\x6f\xf0\xdf\xe0\xff\xaa\xe2\x7f\xe4\xf0\x10\x96\xc8\x05\x2c\x24\x62\xd4\x86\x16\x72\x88\xa3\x02\x06\x3c\xa0\x88\x05\x32\xbc\x05\x4b\x09\x9e\x2f\x05\x92\x0c\x71\x90\x7c\xa6\x96\x29\xe4\x04\x38\x8c\x18\x07\x84\x39\xa0\xd4\xf9\xa2\x11\xe3\xe9\x55\xb3\x28\x26\x21\x18\xa3\x77\x22\x16\xb7\xce\x02\xeb\x1f\xd4\xf0\x8a\x03\xc3\x2b\x0c\xaf\x78\x1a\x5e\x41\x28\x11\x13\xc3\x2c\x9a\x62\x16\xb1\x38\x0d\xb7\x30\xdc\xc2\x70\x8b\x55\xe7\x16\xd4\x41\x1c\xd7\x13\x89\x96\xc6\x22\xae\x18\xdd\x92\x68\x08\xaa\x86\x84\x37\x8f\x31\x79\x20\xda\x50\x8a\x47\xd4\xf2\x86\xbe\xe1\x0d\x86\x37\x3c\x09\x6f\x88\x97\xe5\x0c\x6d\x68\x84\x36\xfc\x1a\x49\xd3\xb0\x06\xc3\x1a\x36\x94\x35\x3c\x46\x62\xe7\xc1\x5e\x35\x8c\x76\xb3\xc5\xfd\xaa\xc4\x4e\x0e\x3e\x44\x5a\x71\xc0\x77\xd9\xcc\x03\x2a\xdf\x30\x3a\x22\x63\xab\x3c\x92\xcf\x98\x23\xd0\xf6\xcf\xc5\x2b\x5f\xde\x22\x19\xd4\xc6\xf6\x04\xce\x89\x07\x2c\x28\x79\x0c\xb5\x9b\xe1\x14\xdb\x17\x63\x1e\x27\xd9\xe6\x30\x53\x55\xff\x19\x8e\x9f\x92\x18\xed\x84\x91\x65\x43\xc7\xfa\xe9\x5d\x6f\xff\xb8\xff\x46\x1f\xa8\xea\x1c\xfd\xde\xde\x61\x0b\x75\x7b\xc7\x2d\xb4\xdf\x69\xa1\xce\xce\xd1\x71\xee\x2c\xfd\x9f\x7a\xc7\xc7\xf6\xfe\x81\x55\xb2\x8e\xa5\xd8\x59\x79\x84\xd6\x8d\x4e\x6b\x8c\x03\x05\xd0\xd7\x39\x8e\x92\xbc\x5f\xb7\xd3\xc9\xd3\x94\xa4\xa2\x53\x76\x31\x45\xe3\x4c\x7d\xfb\xfb\x70\x54\x95\x50\x5d\xbf\xe2\x03\xe6\x17\xc0\x45\x5d\x96\x77\xad\x79\xee\x15\xcc\xb3\xf8\x21\xfb\x92\x75\xee\x97\xda\x9e\x10\x47\x19\x42\xc2\x21\x2a\x77\x60\x1c\x64\x8b\x52\x3a\x8e\x5a\xf3\x28\x93\x87\x7d\x9f\xd0\xf1\x79\x64\x8a\xdd\xaa\xf2\x39\x5e\x36\x76\xe6\x91\xa3\x0e\xd9\xb2\x84\x69\xc1\x59\x5d\x26\x3a\x5a\xe8\xf3\x92\xc6\x38\xa6\xe3\x05\x8d\xf5\xe6\x38\x26\x0f\x4f\xdf\x62\x89\xcf\x12\x92\xa6\x19\x47\x99\x20\xda\x8c\x52\xb0\x25\x64\xdf\xd5\x54\xd7\x9c\x87\x4f\x2e\x0c\xb8\x6a\xf6\xe8\x06\x63\x42\xff\x04\x2e\xe2\x49\xc6\xc1\x4e\x6f\x67\xdf\xd2\xa8\xa2\x90\x23\x32\xcd\xab\x21\x2e\x7c\xc7\x68\x92\xe5\x6e\xf5\x3b\xff\xd0\xea\x39\x94\xef\x51\x65\xb5\xb7\x28\x99\x7d\xc0\xfe\x1c\x5d\x8d\x22\xa2\x92\xe7\xc4\xaa\x46\x46\x6f\x6b\x7d\xdc\x7d\x5d\xa8\x60\xe9\x0d\x73\x04\x2e\x7c\xcc\x2f\xdc\x88\x8f\x6a\x96\x1f\x4e\x83\xd2\xcd\x5a\xca\x99\xec\x75\x5b\xa8\xdb\x3d\x6a\xa1\xee\xd1\x71\xe8\x4c\xba\x47\x39\x67\x32\x0a\xdc\xaa\x19\x43\xd8\xb2\xde\x4e\xd4\x4c\xaf\xd3\x42\xdd\xe3\xbd\x5c\x03\x73\xb7\x18\x49\x3c\x74\xc3\x76\x02\xaf\xf0\x3d\xbb\x5b\xed\x19\xba\x08\x86\x30\xe0\xe0\xbb\xf1\x47\x60\xb2\x1d\x3e\x83\x68\x83\xcf\x40\xe5\xf8\x26\x97\x88\x3a\x32\x5d\xd9\xc4\xab\xbf\xb7\x4a\x70\xd1\xfe\x1a\x7f\xed\xb4\xf1\x0f\x9d\xde\x81\xfa\x5a\xdd\x56\xcf\xaa\x62\xbf\xd6\x5e\x47\x58\x95\x2c\xb7\x58\x93\x64\xef\x06\x94\x12\x3a\x46\x3e\x73\x44\x19\x10\x05\xa1\x63\x17\x42\x81\x66\x75\x6a\xe8\xeb\xf6\x7f\xa4\xdb\xbf\xaa\x9d\x6f\xff\x2c\x24\xce\xd6\xab\x6a\xd3\xef\x54\xfb\x99\x85\xb6\xaf\x2e\xfc\x18\x3b\xae\x70\x96\xfd\x20\x38\x7e\x96\x38\x84\x0a\x20\xbf\x05\xc6\xc7\x60\x7d\x5b\x8c\x8f\xa9\x81\xc1\xf8\xfb\x60\xfc\x41\x53\x18\xdf\xaf\xc2\xf8\x9c\x45\x19\x94\x6f\x1c\xe5\x0d\x8a\x6f\x0c\x8a\xfb\x60\x3f\x04\x7a\xa3\x36\x32\xfc\xa1\x39\xfe\xf0\x07\xc5\x97\x98\xb8\xa1\x35\x18\x0e\x61\x62\x01\xcf\x87\x27\x94\x56\x7c\xee\x4c\x14\x0e\x4c\x30\xc0\x04\x03\x0c\x8d\x78\x20\x1a\xa1\x96\x66\xb6\x93\xff\x53\x89\x09\x05\x3e\xf0\xc0\x63\x7c\x36\x08\x04\x1e\xc3\x60\x38\x93\x50\x0b\xe0\xd1\x6a\x5a\x05\x5c\x57\x9d\x74\x12\xad\xac\x51\xe6\x40\xf3\xe7\x9d\x3c\x29\x8c\xab\x18\xb9\x43\x84\xe4\x64\xa8\x52\xfc\x19\x45\x13\x26\xa4\xc1\xf3\x26\xf1\xfc\x8e\xf3\x7e\x67\x7f\x1f\xef\x61\x83\xe7\xf7\xc3\xf3\xe2\x21\xb5\x77\xc5\xf3\xbd\x4a\x3c\x37\x13\x7f\x33\xf1\x37\x88\xbd\x74\xce\x4b\x38\x05\x77\xc0\x95\x38\x9a\x88\xfb\xcc\x19\x64\xe0\x9d\x4e\xc0\x85\xc4\x7c\xb9\x03\x7f\x97\xc4\xef\xaf\x7d\xef\xdb\xcb\xf8\x73\x5f\x3e\x73\x1e\xfc\xcc\xb2\x9a\x1c\x97\xd3\x27\x40\xf8\x0f\x78\xaa\x26\xe8\x28\x11\x2b\xda\x76\xb1\x90\xa8\x8f\x3c\x42\x03\x09\xa2\x62\x3d\xfc\xb9\x41\xfd\x53\xe7\x41\xde\x3d\x21\x71\x71\x22\x45\xcd\x51\xac\xdd\x66\xcf\x5c\x0f\x11\xf2\x57\xcf\x97\xb3\x72\x8e\x50\xf2\xe5\xc2\x72\xcd\x9a\x26\x31\x7a\x78\x7a\x06\xfc\x93\xea\xcf\x5e\x3d\xb4\x85\x05\x08\x0b\xf4\x23\x7c\xf7\x05\x28\xb6\x6c\x8a\x63\x3f\x5f\x7e\x9b\x14\xc7\x34\x35\x26\x07\xaa\x51\xe9\x5b\xc2\xc1\x8e\xb3\x70\x73\xd5\x2b\x97\x17\xf9\x44\xc1\xe1\xfb\xe3\x41\x6f\x2e\x1e\x28\x3b\x6e\xe7\xe2\xa4\xda\x75\xef\x09\xbd\xa8\xca\x03\xd3\x66\x86\xb9\xf2\x50\xd2\x4a\x23\x0b\x29\xdf\x33\x5d\x39\x2f\x8a\x57\x85\xa2\x2b\xc4\x7b\xd7\xd3\x59\xcd\xf2\xc4\x03\x28\x29\xa0\x0b\xd5\xf4\x66\x79\x35\x3d\x9b\xf0\x4f\x49\x4c\x02\x9c\x76\x3e\x04\x93\x17\xd2\xdb\x15\x49\x94\x3e\x63\x4e\x94\x1f\x8d\xb6\x95\x7b\x6b\x21\xa5\xdf\x16\x0a\x68\xf8\xef\x4b\xb5\xa7\x43\x31\x4f\xf5\x36\x59\x94\x29\xc4\xa1\xac\x39\x93\x52\xdd\x64\x4a\xf5\x83\x27\x2b\xe7\x1f\xbc\x66\x19\xd5\x3a\x5b\xdc\xa8\x8d\x58\xcb\x4f\x40\x0e\x6e\x3b\x01\x39\x2c\x87\xd3\xa2\x0d\x51\x66\xfe\xf1\xf0\xf3\x8f\xa6\xe6\x1d\xf7\xd8\x5a\xf5\x7c\xe6\x1d\x4f\x16\x97\x7a\xb4\xb0\x94\xda\x90\xe5\x33\x67\x1d\xf6\x61\x9d\x2d\x1b\xb3\x32\xc7\xea\x2f\x4d\x20\x1e\x11\x82\xcd\xde\x2b\xbd\x7c\xa5\xf6\x5e\x1d\xd5\x7d\x97\x3d\x03\x81\xfb\x6e\xbd\x7a\x73\xf6\x07\xfa\x23\x9c\x84\xdd\x73\xff\xd5\xfa\xf0\xa6\x5b\x07\x6e\x8f\xba\xd5\x5a\x38\x9c\x7f\x8a\xcb\x86\x6c\x24\x7f\xde\x31\x58\x4b\x83\x8f\x95\xa4\x42\x94\x39\x30\x48\x49\x4d\x9e\x0e\x9d\x64\xc4\xc8\xf6\x83\x38\xd6\x92\x1c\xd7\xa3\x8c\xe5\x44\x04\xde\x80\x63\x09\x1a\x47\xfa\xfb\xbe\xd1\x97\x66\xf8\xd1\xfc\x30\xed\x1c\x7e\xd4\x58\xa4\xf6\x29\x39\x55\xea\x96\x4d\xfc\x65\xc5\xe9\x93\x09\xb3\x68\xe5\xab\xc5\x9e\x6a\x3e\x48\xdb\x6f\x96\x3d\xfd\x1e\x30\x89\x1f\x95\x3d\xd9\x2a\xb7\xa3\xd0\xef\x47\xa0\x54\x23\x2d\xa7\xa0\xdb\xd1\x93\x0a\x1a\x24\x5b\x35\xe7\x48\x35\xbb\x48\x6e\xb8\x56\x3a\x02\xc6\x50\x75\x9c\xda\xca\x72\x30\x9b\x33\x65\x95\x39\x01\xce\x67\x66\x13\x76\xf5\x1b\x60\x47\x75\x21\x7f\x5b\x04\x92\x9a\x1d\xd9\xcc\x2d\x78\x1a\x07\x84\x5d\xab\xcd\x06\x49\x9f\x90\x33\x77\x1e\xa2\x29\x0f\x11\x0a\xe3\x3c\x8f\xa8\xd1\x50\x86\x8c\x19\x7d\xf9\xf2\xe5\x4b\xfb\xc3\x87\xf6\xdb\xb7\xe8\xb7\xdf\x4e\x3c\xef\x44\x14\x98\x96\x8f\xa5\x04\x4e\xab\xdb\x4a\x8f\xf1\x23\x8e\x03\x74\xf1\x22\x5f\xda\xad\x32\x61\x49\x04\xca\x78\x6c\x97\x25\x10\xca\x32\x70\xbf\xdd\xe7\x85\xb4\xc5\x96\x02\x69\x8c\x38\x60\x61\x88\xc6\x15\xe7\x29\x9d\xb2\xde\x72\xe2\xba\xc8\x61\x57\xd4\x2a\x5d\xf6\x07\x77\xcb\x69\x62\x9a\x08\x55\x8a\x2d\xfa\xa9\x98\x4e\x58\xcd\x14\x73\x22\xa6\xd1\x91\x7e\xb9\xba\x80\x12\x8d\x10\xdc\x4e\xfa\x9f\xe2\xc3\x21\x37\x53\x01\xa7\xab\xa3\x00\xf4\x8f\xcd\x54\xc1\x9b\x66\x55\x10\x43\x90\xfa\x79\x3b\x45\xbc\x27\x1e\xd9\xd4\x71\xf0\xf6\xe9\xc7\x41\x24\xfe\x4d\x1d\x05\xbf\xae\xc2\x28\x38\x63\xce\xea\x49\x3f\xcf\xa0\xef\x24\xfc\x5d\x67\xf7\xfa\x1a\xed\x7c\x4c\xe2\x53\xe8\xe6\xa6\x54\xd0\xde\x13\x36\x76\xa1\x7d\x11\x0c\x81\x53\x90\x20\xda\x36\xf3\xfc\x40\x42\x9b\x43\x34\xc7\x11\x6d\x9f\x39\xff\x75\x89\x79\x3b\x0b\x7b\x65\x41\xaf\x7f\x86\x15\x3e\x73\x5e\xfd\x3c\x18\xd8\x50\xdc\x42\xa0\x69\xdc\x2f\x4a\xf9\xb1\x47\xdb\x0a\xe9\x58\x13\xcb\xee\xce\x2f\xbb\xb7\x97\x8b\x90\x9c\xd0\xf1\x72\x72\x89\xff\xd2\x02\x6a\xcf\x39\x64\x8a\x87\x2e\x14\x83\xa5\x42\x62\x35\xb1\x2d\x8d\xa9\x5b\xc6\x51\x6b\x97\x96\x73\xe5\xf7\x48\x63\xcd\xaf\xcf\x27\x03\x30\x39\x45\x5e\x28\x29\xdb\x8c\xe7\xd2\xff\x9e\x9d\x38\x4f\x9b\x10\xe7\x8a\x9a\x2d\xda\x45\x46\xd9\xf9\xd4\xd8\x07\x1e\x3b\xae\xe2\x57\x9b\x21\xcc\xb7\x9b\x3d\x72\x36\x49\xd5\xbf\x3e\xce\x82\xdc\xe2\x75\x37\x15\xd0\x7f\x92\xb4\x25\x8e\xa9\x08\x95\x50\x56\x41\x4a\x93\x0a\xc5\x66\x4d\x6e\x53\xd6\xe4\x5e\xa0\x27\x5a\x46\x3b\xae\x39\x47\x21\xdb\x64\x7f\xef\x65\xb4\x0f\x6a\x33\x88\xc9\x43\x5a\xa0\x88\x9a\x0d\xa4\x59\x4a\xf0\x06\x2f\x8d\xad\x7a\xc2\xd1\x4a\x26\x0f\x2d\xb9\x1d\xeb\xee\x54\xa3\x85\xd2\x47\xfc\xc7\xab\x2d\x93\x20\xd4\x5c\x82\x90\xee\x32\x4d\x8e\xd0\xfd\xf8\x88\xb2\x77\xc3\x47\x36\x31\x47\xa8\xdb\xa9\xd9\x14\x75\xd8\x38\xbb\x31\x79\x42\x4d\x92\xa1\x6e\xa7\x5f\xad\xb8\x3d\xc3\x86\xd6\x37\x25\xe8\xa9\xd2\x7b\x2a\x3f\x64\x66\xb2\x7b\xd2\x6e\x55\xb2\x8d\x44\xa4\x4f\xbe\xb0\xb8\x86\x09\x3e\x0e\xd8\x11\xeb\xb8\xb5\x0e\x4c\x9a\xcf\x4a\xa9\x61\x53\xd3\x1c\x56\x22\xd9\x27\xd6\x85\xc9\xf7\x69\x4c\x0f\x77\x1f\x10\x26\xeb\xa7\x41\x35\x98\xac\x1f\x93\xf5\x63\xb2\x7e\x1a\xcd\xfa\x59\xc5\x58\xe7\xb3\xce\xec\x89\xc5\xdc\x9c\x80\x57\x54\xa2\x8d\x24\xf7\x3c\xa1\x75\xde\x26\x81\x67\x53\x74\xfa\x58\x39\x3c\x9b\x22\xcf\x46\xd2\x78\x56\x7b\x8c\x6c\x98\x46\x57\x22\x5b\x47\x8f\xac\x9b\x84\x1d\x93\xb0\xb3\x52\x0b\x64\x2f\xd0\x53\xad\x69\x75\x7b\x25\x55\x47\x7b\xa8\x1b\xdc\xf9\xfe\x11\xe4\x15\xe3\x17\x26\x67\x67\x91\x2e\xea\x8e\xcf\xcf\x16\x18\xcd\x3a\xd5\xca\x7d\x2a\x71\x25\xb3\x75\x08\xc7\x12\x34\x16\x44\xa3\x21\x38\xe0\x60\x03\xb9\x8c\x89\x50\xe9\x93\x87\xf7\x60\x1e\x8d\x7f\xf3\xf0\xce\x29\x3b\x2b\xf7\xcd\xc3\x4f\x91\xcc\xd1\x29\xa6\x4e\x64\xe9\xf7\xa2\x1f\x9b\x9a\x83\xa3\x05\x96\x4a\xe8\x9a\x19\xd8\xa9\xff\xa0\xd9\x39\x6b\xfe\x01\x44\xf3\xa9\xe4\x07\x80\xed\x5e\xcd\x97\x17\xb3\x33\xca\x0c\x6c\x1b\xd8\xbe\x17\x6c\xab\xc9\xa3\x47\xa4\xc1\xed\x47\xc4\xed\xf3\x58\xe8\x06\xb8\x9b\x8a\x0d\x18\x70\x5e\x0d\x70\x7e\x11\x37\x1b\x8e\xb9\x70\x38\x45\xc9\x97\x91\x6e\x2c\x61\x4f\xc0\xc3\xd9\x71\xcb\x11\x8c\x45\x69\x69\x6a\xc2\x8f\xf9\x45\x74\xa5\xc4\xe3\x4c\xed\x56\xb4\xa8\x1a\x0b\xce\xfa\x31\xa3\xb6\x95\x3e\x47\x82\xe7\xbb\x58\x12\x3a\x4e\xfb\x6f\xb9\x44\x48\xcd\x68\x74\x18\x8e\x0e\xeb\xd4\x81\x96\x50\xdb\x0d\x1c\x78\x5d\x7d\x16\x62\xa5\x7a\x2c\x2f\x70\x25\xa9\xb8\x3c\x39\x31\xb3\x82\x27\xe4\x00\x49\x5f\x86\xb4\xbe\x07\xc0\x67\x6a\x49\x97\x33\x0f\xe4\x04\x02\xdd\x94\x35\x41\x76\x73\xa5\x63\x98\x16\x02\xa3\x96\xb8\x20\xfe\x1f\xdc\xfd\x1c\x0a\xa8\xdc\xb9\x64\xb8\x6b\x9d\x2b\x8e\xb7\x9c\xfe\xdd\xe4\xdc\xd6\xc2\xcb\x67\x3c\x22\x67\xe6\x89\xca\x0a\x2b\xac\xf1\x79\x7d\xc5\x85\xf2\xfc\x28\x48\x8f\xef\x2b\x5d\x56\x35\xba\xee\xac\x45\x2b\x85\x32\xeb\x16\xca\xac\xbc\x49\xd3\xa5\xf6\x22\xba\x40\x14\x11\x70\xa3\xb3\x4c\xcb\xf1\xef\x65\x45\xb3\x9c\x70\xb2\x31\xad\x8d\x6a\xdd\xb4\xe6\x3c\x63\x49\xab\xb1\x03\x21\x99\xb7\xa4\xc5\xe4\xac\x72\x31\x87\x56\x53\xb0\x11\xa1\x24\xf9\xd4\x9e\x52\xd8\x20\x02\x86\x6c\xb9\x83\xd0\x11\x9b\x7f\x8a\x42\xe8\x1a\xda\x3b\xbf\x6c\xdd\xb4\x50\x81\x72\x2c\xb4\x99\x02\x28\xa5\x26\x53\x1b\x84\x5c\xc6\x0d\xcc\xb9\x77\x91\x33\x78\x00\x11\x2c\xf0\x24\xbb\xdb\x3b\xbf\xbc\xac\xa2\x72\xbb\xcb\x5b\x4b\xcc\x47\xf4\xf6\x25\x1e\x2b\xb3\x10\xbf\x27\xaf\x66\xe5\x6b\x4b\x32\x08\xcb\xaa\x2f\x8e\x6d\x31\x12\x92\x56\x11\x08\x38\x8f\x1a\xca\xcd\x33\xd4\xbf\x21\xa9\xb8\x89\xa0\x82\x28\xb5\xc4\x20\x91\x1e\xcf\xcb\xae\xda\xdd\x84\xfe\x24\x27\xf0\x46\x31\xe2\xec\x36\x9f\xd8\x17\x8a\xea\xc7\x37\xc7\xa2\x1c\x24\x1c\x55\x77\x04\x56\x5f\x3b\x56\xb5\xab\x9f\xb1\x9a\x3b\x70\xb5\xeb\x65\x7f\xf7\xb5\xbf\xbb\xfa\x8f\xbd\x8e\x5e\xa3\x91\xb4\x9e\xf6\x77\xd7\x89\x86\xe5\xb7\xe4\x1d\x42\x46\x5d\x76\x50\xf5\x4f\xd1\x1b\x3e\xd0\x1b\xd6\x9f\xd2\xdb\xd7\x7f\x68\xe7\x33\x1f\x3a\x7a\x7f\x93\xbe\xe4\xc4\xf7\x83\xd1\xcc\x2d\x64\x34\xb8\xe8\x95\xd0\x2e\x8a\xa0\x1e\xed\xa2\xbf\x42\x03\x53\x97\x5f\x66\x7c\xe1\xc5\xcd\xff\x07\x00\x00\xff\xff\xce\xa4\x08\x9f\xd8\x14\x01\x00")
 
 func monitoringZyncGrafanaDashboard1JsonTplBytes() ([]byte, error) {
@@ -272,6 +293,7 @@ var _bindata = map[string]func() (*asset, error){
 	"monitoring/kubernetes-resources-by-namespace-grafana-dashboard-1.json.tpl": monitoringKubernetesResourcesByNamespaceGrafanaDashboard1JsonTpl,
 	"monitoring/kubernetes-resources-by-pod-grafana-dashboard-1.json.tpl": monitoringKubernetesResourcesByPodGrafanaDashboard1JsonTpl,
 	"monitoring/system-grafana-dashboard-1.json.tpl": monitoringSystemGrafanaDashboard1JsonTpl,
+	"monitoring/threescale-operator-grafana-dashboard-1.json.tpl": monitoringThreescaleOperatorGrafanaDashboard1JsonTpl,
 	"monitoring/zync-grafana-dashboard-1.json.tpl": monitoringZyncGrafanaDashboard1JsonTpl,
 }
 
@@ -322,6 +344,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"kubernetes-resources-by-namespace-grafana-dashboard-1.json.tpl": &bintree{monitoringKubernetesResourcesByNamespaceGrafanaDashboard1JsonTpl, map[string]*bintree{}},
 		"kubernetes-resources-by-pod-grafana-dashboard-1.json.tpl": &bintree{monitoringKubernetesResourcesByPodGrafanaDashboard1JsonTpl, map[string]*bintree{}},
 		"system-grafana-dashboard-1.json.tpl": &bintree{monitoringSystemGrafanaDashboard1JsonTpl, map[string]*bintree{}},
+		"threescale-operator-grafana-dashboard-1.json.tpl": &bintree{monitoringThreescaleOperatorGrafanaDashboard1JsonTpl, map[string]*bintree{}},
 		"zync-grafana-dashboard-1.json.tpl": &bintree{monitoringZyncGrafanaDashboard1JsonTpl, map[string]*bintree{}},
 	}},
 }}
@@ -0,0 +1,162 @@
+package accountplan
+
+import (
+	"fmt"
+	"strconv"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+type ThreescaleReconciler struct {
+	*reconcilers.BaseReconciler
+	resource            *capabilitiesv1beta1.AccountPlan
+	threescaleAPIClient *controllerhelper.ThreescaleAPIClient
+	logger              logr.Logger
+}
+
+func NewThreescaleReconciler(b *reconcilers.BaseReconciler, resource *capabilitiesv1beta1.AccountPlan, threescaleAPIClient *controllerhelper.ThreescaleAPIClient) *ThreescaleReconciler {
+	return &ThreescaleReconciler{
+		BaseReconciler:      b,
+		resource:            resource,
+		threescaleAPIClient: threescaleAPIClient,
+		logger:              b.Logger().WithValues("3scale Reconciler", resource.Name),
+	}
+}
+
+func (t *ThreescaleReconciler) Reconcile() (*controllerhelper.AccountPlanItem, error) {
+	remotePlan, err := findAccountPlan(t.threescaleAPIClient, t.resource.Spec.SystemName)
+	if err != nil {
+		return nil, fmt.Errorf("Error sync account plan [%s]: %w", t.resource.Spec.SystemName, err)
+	}
+
+	if remotePlan == nil {
+		// The account plan is claimed before its creation.
+		// When the response or the status update are lost, the next reconciliation still owns it
+		err = controllerhelper.ClaimSystemName(t.Context(), t.Client(), t.resource, t.resource.Spec.SystemName)
+		if err != nil {
+			return nil, fmt.Errorf("Error sync account plan [%s]: claim: %w", t.resource.Spec.SystemName, err)
+		}
+
+		// Create Account Plan using system_name.
+		// it cannot be modified later
+		params := threescaleapi.Params{"system_name": t.resource.Spec.SystemName, "name": t.resource.Spec.SystemName}
+		obj, err := t.threescaleAPIClient.CreateAccountPlan(params)
+		if err != nil {
+			if controllerhelper.IsCreationRejected(err) {
+				releaseErr := controllerhelper.ReleaseSystemNameClaim(t.Context(), t.Client(), t.resource)
+				if releaseErr != nil {
+					t.logger.Error(releaseErr, "failed to release account plan system name claim")
+				}
+			}
+			return nil, fmt.Errorf("Error sync account plan [%s]: %w", t.resource.Spec.SystemName, err)
+		}
+		remotePlan = &obj.Element
+
+		// The ID is recorded right away. On failure, the claim keeps the ownership
+		err = controllerhelper.PatchStatus(t.Context(), t.Client(), t.resource, func() {
+			planID := remotePlan.ID
+			t.resource.Status.ID = &planID
+		})
+		if err != nil {
+			t.logger.Error(err, "failed to record account plan ID", "ID", remotePlan.ID)
+		}
+	} else if !t.resource.Spec.Adopt && !t.resource.Owns(remotePlan.ID) {
+		// Existing account plans not created by this resource are only taken over on explicit adoption
+		return nil, &helper.SpecFieldError{
+			ErrorType: helper.InvalidError,
+			FieldErrorList: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("systemName"), t.resource.Spec.SystemName,
+					"3scale account plan already exists and it is not managed by this resource. Set spec.adopt to take it over"),
+			},
+		}
+	}
+
+	// The plan exists and it is managed by the resource, returned on error as well
+	remotePlan, err = t.syncPlan(remotePlan)
+	if err != nil {
+		return remotePlan, fmt.Errorf("Error sync account plan [%s]: %w", t.resource.Spec.SystemName, err)
+	}
+
+	return remotePlan, nil
+}
+
+// syncPlan ensures account plan attrs are reconciled
+func (t *ThreescaleReconciler) syncPlan(remotePlan *controllerhelper.AccountPlanItem) (*controllerhelper.AccountPlanItem, error) {
+	params := threescaleapi.Params{}
+
+	if t.resource.Spec.Name != nil {
+		if remotePlan.Name != *t.resource.Spec.Name {
+			params["name"] = *t.resource.Spec.Name
+		}
+	}
+
+	if t.resource.Spec.AccountsRequireApproval != nil {
+		if remotePlan.ApprovalRequired != *t.resource.Spec.AccountsRequireApproval {
+			params["approval_required"] = strconv.FormatBool(*t.resource.Spec.AccountsRequireApproval)
+		}
+	}
+
+	if t.resource.Spec.TrialPeriod != nil {
+		if remotePlan.TrialPeriodDays != *t.resource.Spec.TrialPeriod {
+			params["trial_period_days"] = strconv.Itoa(*t.resource.Spec.TrialPeriod)
+		}
+	}
+
+	if t.resource.Spec.SetupFee != nil {
+		// Field CRD openapiV3 validation should ensure no error parsing
+		desiredValue, _ := strconv.ParseFloat(*t.resource.Spec.SetupFee, 10)
+		if remotePlan.SetupFee != desiredValue {
+			params["setup_fee"] = *t.resource.Spec.SetupFee
+		}
+	}
+
+	if t.resource.Spec.CostMonth != nil {
+		// Field CRD openapiV3 validation should ensure no error parsing
+		desiredValue, _ := strconv.ParseFloat(*t.resource.Spec.CostMonth, 10)
+		if remotePlan.CostPerMonth != desiredValue {
+			params["cost_per_month"] = *t.resource.Spec.CostMonth
+		}
+	}
+
+	if t.resource.Spec.Published != nil {
+		if (remotePlan.State == controllerhelper.PlanPublishedState) != *t.resource.Spec.Published {
+			params["state_event"] = controllerhelper.PlanStateEvent(*t.resource.Spec.Published)
+		}
+	}
+
+	if len(params) == 0 {
+		return remotePlan, nil
+	}
+
+	t.logger.V(1).Info("update account plan", "params", params)
+	updated, err := t.threescaleAPIClient.UpdateAccountPlan(remotePlan.ID, params)
+	if err != nil {
+		return remotePlan, err
+	}
+
+	return &updated.Element, nil
+}
+
+// findAccountPlan returns the 3scale account plan with the given system name.
+// Nil when not found.
+func findAccountPlan(threescaleAPIClient *controllerhelper.ThreescaleAPIClient, systemName string) (*controllerhelper.AccountPlanItem, error) {
+	accountPlanList, err := threescaleAPIClient.ListAccountPlans()
+	if err != nil {
+		return nil, err
+	}
+
+	for idx := range accountPlanList.Plans {
+		if accountPlanList.Plans[idx].Element.SystemName == systemName {
+			return &accountPlanList.Plans[idx].Element, nil
+		}
+	}
+
+	return nil, nil
+}
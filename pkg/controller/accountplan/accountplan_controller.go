@@ -0,0 +1,285 @@
+package accountplan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	"github.com/3scale/3scale-operator/pkg/common"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+	"github.com/3scale/3scale-operator/version"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+var (
+	// controllerName is the name of this controller
+	controllerName = "controller_accountplan"
+
+	// package level logger
+	log = logf.Log.WithName(controllerName)
+)
+
+// Add creates a new AccountPlan Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	reconciler, err := newReconciler(mgr)
+	if err != nil {
+		return err
+	}
+
+	return add(mgr, reconciler)
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager) (reconcile.Reconciler, error) {
+	apiClientReader, err := common.NewAPIClientReader(mgr)
+	if err != nil {
+		return nil, err
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	client := mgr.GetClient()
+	scheme := mgr.GetScheme()
+	ctx := context.TODO()
+	recorder := mgr.GetEventRecorderFor(controllerName)
+	return &ReconcileAccountPlan{
+		BaseReconciler: reconcilers.NewBaseReconciler(client, scheme, apiClientReader, ctx, log, discoveryClient, recorder),
+	}, nil
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New("accountplan-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to primary resource AccountPlan
+	err = c.Watch(&source.Kind{Type: &capabilitiesv1beta1.AccountPlan{}}, &handler.EnqueueRequestForObject{})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// blank assignment to verify that ReconcileAccountPlan implements reconcile.Reconciler
+var _ reconcile.Reconciler = &ReconcileAccountPlan{}
+
+// ReconcileAccountPlan reconciles an AccountPlan object
+type ReconcileAccountPlan struct {
+	*reconcilers.BaseReconciler
+}
+
+// Reconcile reads that state of the cluster for an AccountPlan object and makes changes based on the state read
+// and what is in the AccountPlan.Spec
+func (r *ReconcileAccountPlan) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := r.Logger().WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconcile AccountPlan", "Operator version", version.Version)
+
+	// Fetch the AccountPlan instance
+	accountPlan := &capabilitiesv1beta1.AccountPlan{}
+	err := r.Client().Get(r.Context(), request.NamespacedName, accountPlan)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Return and don't requeue
+			controllerhelper.DeleteResourceSynced(capabilitiesv1beta1.AccountPlanKind, request.NamespacedName)
+			reqLogger.Info("resource not found. Ignoring since object must have been deleted")
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	if reqLogger.V(1).Enabled() {
+		jsonData, err := json.MarshalIndent(accountPlan, "", "  ")
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		reqLogger.V(1).Info(string(jsonData))
+	}
+
+	if accountPlan.GetDeletionTimestamp() != nil && helper.ArrayContains(accountPlan.GetFinalizers(), capabilitiesv1beta1.AccountPlanFinalizer) {
+		return r.reconcileDeletion(accountPlan, reqLogger)
+	}
+
+	// Ignore deleted AccountPlans, this can happen when foregroundDeletion is enabled
+	// https://kubernetes.io/docs/concepts/workloads/controllers/garbage-collection/#foreground-cascading-deletion
+	if accountPlan.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	if !helper.ArrayContains(accountPlan.GetFinalizers(), capabilitiesv1beta1.AccountPlanFinalizer) {
+		controllerutil.AddFinalizer(accountPlan, capabilitiesv1beta1.AccountPlanFinalizer)
+		err := r.Client().Update(r.Context(), accountPlan)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("Failed adding account plan finalizer: %w", err)
+		}
+
+		reqLogger.Info("resource finalizer added. Requeueing.")
+		return reconcile.Result{Requeue: true}, nil
+	}
+
+	statusReconciler, reconcileErr := r.reconcile(accountPlan)
+	statusResult, statusUpdateErr := statusReconciler.Reconcile()
+	if statusUpdateErr != nil {
+		if reconcileErr != nil {
+			return reconcile.Result{}, fmt.Errorf("Failed to sync account plan: %v. Failed to update account plan status: %w", reconcileErr, statusUpdateErr)
+		}
+
+		return reconcile.Result{}, fmt.Errorf("Failed to update account plan status: %w", statusUpdateErr)
+	}
+
+	if statusResult.Requeue {
+		return statusResult, nil
+	}
+
+	if reconcileErr != nil {
+		if helper.IsInvalidSpecError(reconcileErr) {
+			// On Validation error, no need to retry as spec is not valid and needs to be changed
+			reqLogger.Info("ERROR", "spec validation error", reconcileErr)
+			r.EventRecorder().Eventf(accountPlan, corev1.EventTypeWarning, "Invalid AccountPlan Spec", "%v", reconcileErr)
+			return reconcile.Result{}, nil
+		}
+
+		if controllerhelper.IsThreescaleUnavailable(reconcileErr) {
+			// 3scale is expected to recover, retry once the circuit breaker lets requests through again
+			reqLogger.Info("ERROR", "3scale unavailable", reconcileErr)
+			r.EventRecorder().Eventf(accountPlan, corev1.EventTypeWarning, "Unavailable", "%v", reconcileErr)
+			return reconcile.Result{RequeueAfter: controllerhelper.ThreescaleUnavailableRequeueDelay}, nil
+		}
+
+		reqLogger.Error(reconcileErr, "Failed to reconcile")
+		r.EventRecorder().Eventf(accountPlan, corev1.EventTypeWarning, "ReconcileError", "%v", reconcileErr)
+	}
+
+	reqLogger.Info("END", "error", reconcileErr)
+	return reconcile.Result{}, reconcileErr
+}
+
+// reconcileDeletion removes the 3scale account plan, unless orphan on delete is requested,
+// and then removes the finalizer to let the resource be deleted
+func (r *ReconcileAccountPlan) reconcileDeletion(accountPlan *capabilitiesv1beta1.AccountPlan, reqLogger logr.Logger) (reconcile.Result, error) {
+	if accountPlan.OrphanOnDelete() {
+		reqLogger.Info("orphan on delete annotation found. 3scale account plan will not be deleted")
+	} else {
+		err := r.delete3scaleAccountPlan(accountPlan)
+		if err != nil {
+			reqLogger.Error(err, "Failed to delete 3scale account plan")
+			r.EventRecorder().Eventf(accountPlan, corev1.EventTypeWarning, "DeleteError", "%v", err)
+			statusReconciler := NewStatusReconciler(r.BaseReconciler, accountPlan, nil, accountPlan.Status.ProviderAccountHost, err)
+			_, statusUpdateErr := statusReconciler.Reconcile()
+			if statusUpdateErr != nil {
+				return reconcile.Result{}, fmt.Errorf("Failed to delete 3scale account plan: %v. Failed to update account plan status: %w", err, statusUpdateErr)
+			}
+			return reconcile.Result{}, err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(accountPlan, capabilitiesv1beta1.AccountPlanFinalizer)
+	err := r.Client().Update(r.Context(), accountPlan)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("Failed removing account plan finalizer: %w", err)
+	}
+
+	reqLogger.Info("resource finalizer removed")
+	return reconcile.Result{}, nil
+}
+
+func (r *ReconcileAccountPlan) delete3scaleAccountPlan(accountPlan *capabilitiesv1beta1.AccountPlan) error {
+	logger := r.Logger().WithValues("accountplan", accountPlan.Name)
+
+	// Only the 3scale account plan managed by the resource is deleted
+	if accountPlan.Status.ID == nil {
+		logger.Info("3scale account plan not managed by the resource. Nothing to delete")
+		return nil
+	}
+
+	providerAccount, err := controllerhelper.LookupProviderAccount(r.Client(), accountPlan.Namespace, accountPlan.Spec.ProviderAccountRef, logger)
+	if controllerhelper.IsProviderAccountNotFound(err) {
+		// The credentials are gone, i.e. the namespace is being deleted.
+		// The 3scale account plan is orphaned instead of blocking the resource deletion forever
+		logger.Info("provider account not found. 3scale account plan will not be deleted", "error", err.Error())
+		r.EventRecorder().Eventf(accountPlan, corev1.EventTypeWarning, "Orphaned", "3scale account plan [%s] not deleted: %v", accountPlan.Spec.SystemName, err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	threescaleAPIClient, err := controllerhelper.PortaClient(providerAccount)
+	if err != nil {
+		return err
+	}
+
+	err = threescaleAPIClient.DeleteAccountPlan(*accountPlan.Status.ID)
+	if err != nil && !controllerhelper.IsThreescaleNotFound(err) {
+		return fmt.Errorf("delete3scaleAccountPlan account plan [%s]: %w", accountPlan.Spec.SystemName, err)
+	}
+
+	return nil
+}
+
+func (r *ReconcileAccountPlan) reconcile(accountPlanResource *capabilitiesv1beta1.AccountPlan) (*StatusReconciler, error) {
+	logger := r.Logger().WithValues("accountplan", accountPlanResource.Name)
+
+	err := r.validateSpec(accountPlanResource)
+	if err != nil {
+		statusReconciler := NewStatusReconciler(r.BaseReconciler, accountPlanResource, nil, "", err)
+		return statusReconciler, err
+	}
+
+	providerAccount, err := controllerhelper.LookupProviderAccount(r.Client(), accountPlanResource.Namespace, accountPlanResource.Spec.ProviderAccountRef, logger)
+	if err != nil {
+		statusReconciler := NewStatusReconciler(r.BaseReconciler, accountPlanResource, nil, "", err)
+		return statusReconciler, err
+	}
+
+	threescaleAPIClient, err := controllerhelper.PortaClient(providerAccount)
+	if err != nil {
+		statusReconciler := NewStatusReconciler(r.BaseReconciler, accountPlanResource, nil, providerAccount.AdminURLStr, err)
+		return statusReconciler, err
+	}
+
+	reconciler := NewThreescaleReconciler(r.BaseReconciler, accountPlanResource, threescaleAPIClient)
+	remotePlan, err := reconciler.Reconcile()
+	statusReconciler := NewStatusReconciler(r.BaseReconciler, accountPlanResource, remotePlan, providerAccount.AdminURLStr, err)
+	return statusReconciler, err
+}
+
+func (r *ReconcileAccountPlan) validateSpec(accountPlanResource *capabilitiesv1beta1.AccountPlan) error {
+	errors := field.ErrorList{}
+	// internal validation
+	errors = append(errors, accountPlanResource.Validate()...)
+
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return &helper.SpecFieldError{
+		ErrorType:      helper.InvalidError,
+		FieldErrorList: errors,
+	}
+}
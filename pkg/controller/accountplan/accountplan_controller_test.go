@@ -0,0 +1,50 @@
+package accountplan
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestDelete3scaleAccountPlanWithoutProviderAccount(t *testing.T) {
+	s := scheme.Scheme
+	if err := capabilitiesv1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	// The provider account secret has already been deleted, i.e. the namespace is being deleted
+	accountPlanID := int64(1)
+	accountPlan := &capabilitiesv1beta1.AccountPlan{
+		ObjectMeta: metav1.ObjectMeta{Name: "accountplan1", Namespace: "test"},
+		Spec: capabilitiesv1beta1.AccountPlanSpec{
+			SystemName:         "accountplan1",
+			ProviderAccountRef: &capabilitiesv1beta1.ProviderAccountReference{Name: "tenant-secret"},
+		},
+		Status: capabilitiesv1beta1.AccountPlanStatus{ID: &accountPlanID},
+	}
+
+	k8sClient := fake.NewFakeClientWithScheme(s, accountPlan)
+	recorder := record.NewFakeRecorder(10)
+	r := &ReconcileAccountPlan{
+		BaseReconciler: reconcilers.NewBaseReconciler(k8sClient, s, k8sClient, context.TODO(), logf.Log.WithName("test"), nil, recorder),
+	}
+
+	err := r.delete3scaleAccountPlan(accountPlan)
+	if err != nil {
+		t.Fatalf("expected 3scale account plan to be orphaned, got %v", err)
+	}
+
+	event := <-recorder.Events
+	if !strings.Contains(event, "Warning Orphaned") {
+		t.Fatalf("expected Orphaned warning event, got %s", event)
+	}
+}
@@ -0,0 +1,197 @@
+package activedoc
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+type ThreescaleReconciler struct {
+	*reconcilers.BaseReconciler
+	resource            *capabilitiesv1beta1.ActiveDoc
+	threescaleAPIClient *controllerhelper.ThreescaleAPIClient
+	// OpenAPI document in JSON format
+	openAPIDoc []byte
+	// Nil when the activedoc is not bound to a product
+	productID *int64
+	logger    logr.Logger
+}
+
+func NewThreescaleReconciler(b *reconcilers.BaseReconciler, resource *capabilitiesv1beta1.ActiveDoc, threescaleAPIClient *controllerhelper.ThreescaleAPIClient, openAPIDoc []byte, productID *int64) *ThreescaleReconciler {
+	return &ThreescaleReconciler{
+		BaseReconciler:      b,
+		resource:            resource,
+		threescaleAPIClient: threescaleAPIClient,
+		openAPIDoc:          openAPIDoc,
+		productID:           productID,
+		logger:              b.Logger().WithValues("3scale Reconciler", resource.Name),
+	}
+}
+
+func (t *ThreescaleReconciler) Reconcile() (*controllerhelper.ActiveDoc, error) {
+	remoteActiveDoc, err := findActiveDocBySystemName(t.threescaleAPIClient, t.resource.Spec.SystemName)
+	if err != nil {
+		return nil, fmt.Errorf("Error sync activedoc [%s]: %w", t.resource.Spec.SystemName, err)
+	}
+
+	if remoteActiveDoc == nil {
+		// The activedoc is claimed before its creation.
+		// When the response or the status update are lost, the next reconciliation still owns it
+		err = controllerhelper.ClaimSystemName(t.Context(), t.Client(), t.resource, t.resource.Spec.SystemName)
+		if err != nil {
+			return nil, fmt.Errorf("Error sync activedoc [%s]: claim: %w", t.resource.Spec.SystemName, err)
+		}
+
+		// Create activedoc using system_name.
+		// it cannot be modified later
+		params := t.desiredParams()
+		params["system_name"] = t.resource.Spec.SystemName
+		params["name"] = t.resource.Spec.Name
+		params["body"] = string(t.openAPIDoc)
+		remoteActiveDoc, err = t.threescaleAPIClient.CreateActiveDoc(params)
+		if err != nil {
+			if controllerhelper.IsCreationRejected(err) {
+				releaseErr := controllerhelper.ReleaseSystemNameClaim(t.Context(), t.Client(), t.resource)
+				if releaseErr != nil {
+					t.logger.Error(releaseErr, "failed to release activedoc system name claim")
+				}
+			}
+			return nil, fmt.Errorf("Error sync activedoc [%s]: %w", t.resource.Spec.SystemName, err)
+		}
+
+		// The ID is recorded right away. On failure, the claim keeps the ownership
+		err = controllerhelper.PatchStatus(t.Context(), t.Client(), t.resource, func() {
+			activeDocID := remoteActiveDoc.Element.ID
+			t.resource.Status.ID = &activeDocID
+		})
+		if err != nil {
+			t.logger.Error(err, "failed to record activedoc ID", "ID", remoteActiveDoc.Element.ID)
+		}
+
+		return remoteActiveDoc, nil
+	}
+
+	if !t.resource.Spec.Adopt && !t.resource.Owns(remoteActiveDoc.Element.ID) {
+		// Existing activedocs not created by this resource are only taken over on explicit adoption
+		return nil, &helper.SpecFieldError{
+			ErrorType: helper.InvalidError,
+			FieldErrorList: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("systemName"), t.resource.Spec.SystemName,
+					"3scale activedoc already exists and it is not managed by this resource. Set spec.adopt to take it over"),
+			},
+		}
+	}
+
+	params := threescaleapi.Params{}
+
+	if remoteActiveDoc.Element.Name != t.resource.Spec.Name {
+		params["name"] = t.resource.Spec.Name
+	}
+
+	if !equalJSONDocuments(remoteActiveDoc.Element.Body, t.openAPIDoc) {
+		params["body"] = string(t.openAPIDoc)
+	}
+
+	if t.resource.Spec.Description != nil && remoteActiveDoc.Element.Description != *t.resource.Spec.Description {
+		params["description"] = *t.resource.Spec.Description
+	}
+
+	if t.resource.Spec.Published != nil && remoteActiveDoc.Element.Published != *t.resource.Spec.Published {
+		params["published"] = strconv.FormatBool(*t.resource.Spec.Published)
+	}
+
+	if t.resource.Spec.SkipSwaggerValidations != nil && remoteActiveDoc.Element.SkipSwaggerValidations != *t.resource.Spec.SkipSwaggerValidations {
+		params["skip_swagger_validations"] = strconv.FormatBool(*t.resource.Spec.SkipSwaggerValidations)
+	}
+
+	if t.productID != nil && !reflect.DeepEqual(remoteActiveDoc.Element.ServiceID, t.productID) {
+		params["service_id"] = strconv.FormatInt(*t.productID, 10)
+	}
+
+	if len(params) == 0 {
+		return remoteActiveDoc, nil
+	}
+
+	t.logger.V(1).Info("update activedoc", "params", paramsWithoutBody(params))
+	updatedActiveDoc, err := t.threescaleAPIClient.UpdateActiveDoc(remoteActiveDoc.Element.ID, params)
+	if err != nil {
+		return nil, fmt.Errorf("Error sync activedoc [%s]: %w", t.resource.Spec.SystemName, err)
+	}
+
+	return updatedActiveDoc, nil
+}
+
+// desiredParams returns the optional attributes set in the spec.
+// Attributes not set in the spec are not reconciled, respecting 3scale values.
+func (t *ThreescaleReconciler) desiredParams() threescaleapi.Params {
+	params := threescaleapi.Params{}
+
+	if t.resource.Spec.Description != nil {
+		params["description"] = *t.resource.Spec.Description
+	}
+
+	if t.resource.Spec.Published != nil {
+		params["published"] = strconv.FormatBool(*t.resource.Spec.Published)
+	}
+
+	if t.resource.Spec.SkipSwaggerValidations != nil {
+		params["skip_swagger_validations"] = strconv.FormatBool(*t.resource.Spec.SkipSwaggerValidations)
+	}
+
+	if t.productID != nil {
+		params["service_id"] = strconv.FormatInt(*t.productID, 10)
+	}
+
+	return params
+}
+
+func findActiveDocBySystemName(threescaleAPIClient *controllerhelper.ThreescaleAPIClient, systemName string) (*controllerhelper.ActiveDoc, error) {
+	activeDocList, err := threescaleAPIClient.ListActiveDocs()
+	if err != nil {
+		return nil, err
+	}
+
+	for idx := range activeDocList.ActiveDocs {
+		if activeDocList.ActiveDocs[idx].Element.SystemName == systemName {
+			return &activeDocList.ActiveDocs[idx], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// equalJSONDocuments compares JSON documents ignoring formatting and key order
+func equalJSONDocuments(existing string, desired []byte) bool {
+	var existingObj, desiredObj interface{}
+	if err := json.Unmarshal([]byte(existing), &existingObj); err != nil {
+		return false
+	}
+
+	if err := json.Unmarshal(desired, &desiredObj); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(existingObj, desiredObj)
+}
+
+// paramsWithoutBody avoids logging the whole OpenAPI document
+func paramsWithoutBody(params threescaleapi.Params) threescaleapi.Params {
+	logParams := threescaleapi.Params{}
+	for k, v := range params {
+		if k == "body" {
+			v = "<OpenAPI document>"
+		}
+		logParams[k] = v
+	}
+	return logParams
+}
@@ -0,0 +1,174 @@
+package activedoc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const testOpenAPIDoc = `{"openapi":"3.0.2","info":{"title":"test","version":"1"},"paths":{}}`
+
+// fakeActiveDocsAPI serves the 3scale activedocs list, creation and update endpoints
+type fakeActiveDocsAPI struct {
+	mu         sync.Mutex
+	activeDocs []controllerhelper.ActiveDoc
+}
+
+func (f *fakeActiveDocsAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	r.ParseForm()
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/admin/api/active_docs.json":
+		json.NewEncoder(w).Encode(controllerhelper.ActiveDocList{ActiveDocs: f.activeDocs})
+	case r.Method == http.MethodPost && r.URL.Path == "/admin/api/active_docs.json":
+		activeDoc := controllerhelper.ActiveDoc{Element: controllerhelper.ActiveDocItem{
+			ID:         int64(len(f.activeDocs) + 1),
+			Name:       r.PostForm.Get("name"),
+			SystemName: r.PostForm.Get("system_name"),
+			Body:       r.PostForm.Get("body"),
+		}}
+		f.activeDocs = append(f.activeDocs, activeDoc)
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(activeDoc)
+	case r.Method == http.MethodPut:
+		for idx := range f.activeDocs {
+			if r.URL.Path == fmt.Sprintf("/admin/api/active_docs/%d.json", f.activeDocs[idx].Element.ID) {
+				if name := r.PostForm.Get("name"); name != "" {
+					f.activeDocs[idx].Element.Name = name
+				}
+				if body := r.PostForm.Get("body"); body != "" {
+					f.activeDocs[idx].Element.Body = body
+				}
+				json.NewEncoder(w).Encode(f.activeDocs[idx])
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newTestActiveDocClient(t *testing.T, adopt bool) (client.Client, types.NamespacedName) {
+	activeDoc := &capabilitiesv1beta1.ActiveDoc{
+		ObjectMeta: metav1.ObjectMeta{Name: "activedoc1", Namespace: "test"},
+		Spec:       capabilitiesv1beta1.ActiveDocSpec{Name: "ActiveDoc 1", SystemName: "activedoc1", Adopt: adopt},
+	}
+
+	s := runtime.NewScheme()
+	if err := capabilitiesv1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	return fake.NewFakeClientWithScheme(s, activeDoc), types.NamespacedName{Name: "activedoc1", Namespace: "test"}
+}
+
+func newTestActiveDocReconciler(t *testing.T, k8sClient client.Client, nn types.NamespacedName, srv *httptest.Server) *ThreescaleReconciler {
+	activeDoc := &capabilitiesv1beta1.ActiveDoc{}
+	if err := k8sClient.Get(context.TODO(), nn, activeDoc); err != nil {
+		t.Fatal(err)
+	}
+
+	threescaleAPIClient, err := controllerhelper.PortaClientFromURLString(srv.URL, "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	baseReconciler := reconcilers.NewBaseReconciler(k8sClient, nil, k8sClient, context.TODO(), logf.Log.WithName("test"), nil, record.NewFakeRecorder(10))
+	return NewThreescaleReconciler(baseReconciler, activeDoc, threescaleAPIClient, []byte(testOpenAPIDoc), nil)
+}
+
+func TestReconcile3scaleActiveDocNotOwned(t *testing.T) {
+	api := &fakeActiveDocsAPI{activeDocs: []controllerhelper.ActiveDoc{
+		{Element: controllerhelper.ActiveDocItem{ID: 1, Name: "Other", SystemName: "activedoc1", Body: "{}"}},
+	}}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+
+	k8sClient, nn := newTestActiveDocClient(t, false)
+
+	_, err := newTestActiveDocReconciler(t, k8sClient, nn, srv).Reconcile()
+	if !helper.IsInvalidSpecError(err) {
+		t.Fatalf("expected invalid spec error for an activedoc not created by the resource, got %v", err)
+	}
+
+	if api.activeDocs[0].Element.Name != "Other" {
+		t.Fatal("activedoc not created by the resource should not be updated")
+	}
+}
+
+func TestReconcile3scaleActiveDocAdopt(t *testing.T) {
+	api := &fakeActiveDocsAPI{activeDocs: []controllerhelper.ActiveDoc{
+		{Element: controllerhelper.ActiveDocItem{ID: 1, Name: "Other", SystemName: "activedoc1", Body: "{}"}},
+	}}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+
+	k8sClient, nn := newTestActiveDocClient(t, true)
+
+	remoteActiveDoc, err := newTestActiveDocReconciler(t, k8sClient, nn, srv).Reconcile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if remoteActiveDoc.Element.ID != 1 || len(api.activeDocs) != 1 || api.activeDocs[0].Element.Name != "ActiveDoc 1" {
+		t.Fatalf("expected activedoc 1 to be adopted and updated, got %v", api.activeDocs)
+	}
+}
+
+func TestReconcile3scaleActiveDocLostStatus(t *testing.T) {
+	api := &fakeActiveDocsAPI{}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+
+	k8sClient, nn := newTestActiveDocClient(t, false)
+
+	created, err := newTestActiveDocReconciler(t, k8sClient, nn, srv).Reconcile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	activeDoc := &capabilitiesv1beta1.ActiveDoc{}
+	if err := k8sClient.Get(context.TODO(), nn, activeDoc); err != nil {
+		t.Fatal(err)
+	}
+	if activeDoc.Status.ID == nil || *activeDoc.Status.ID != created.Element.ID {
+		t.Fatalf("expected activedoc ID %d to be recorded, got %v", created.Element.ID, activeDoc.Status.ID)
+	}
+
+	// A lost status update discards the recorded ID
+	activeDoc.Status.ID = nil
+	if err := k8sClient.Update(context.TODO(), activeDoc); err != nil {
+		t.Fatal(err)
+	}
+
+	same, err := newTestActiveDocReconciler(t, k8sClient, nn, srv).Reconcile()
+	if err != nil {
+		t.Fatalf("created activedoc should still be owned after losing the status, got %v", err)
+	}
+
+	if same.Element.ID != created.Element.ID || len(api.activeDocs) != 1 {
+		t.Fatalf("expected activedoc %d to be reused, got %d and %d activedocs", created.Element.ID, same.Element.ID, len(api.activeDocs))
+	}
+}
@@ -0,0 +1,354 @@
+package activedoc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	"github.com/3scale/3scale-operator/pkg/common"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+	"github.com/3scale/3scale-operator/version"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+var (
+	// controllerName is the name of this controller
+	controllerName = "controller_activedoc"
+
+	// package level logger
+	log = logf.Log.WithName(controllerName)
+)
+
+// Add creates a new ActiveDoc Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	reconciler, err := newReconciler(mgr)
+	if err != nil {
+		return err
+	}
+
+	return add(mgr, reconciler)
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager) (reconcile.Reconciler, error) {
+	apiClientReader, err := common.NewAPIClientReader(mgr)
+	if err != nil {
+		return nil, err
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	client := mgr.GetClient()
+	scheme := mgr.GetScheme()
+	ctx := context.TODO()
+	recorder := mgr.GetEventRecorderFor(controllerName)
+	return &ReconcileActiveDoc{
+		BaseReconciler: reconcilers.NewBaseReconciler(client, scheme, apiClientReader, ctx, log, discoveryClient, recorder),
+	}, nil
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New("activedoc-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to primary resource ActiveDoc
+	err = c.Watch(&source.Kind{Type: &capabilitiesv1beta1.ActiveDoc{}}, &handler.EnqueueRequestForObject{})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to the configmaps and secrets holding the OpenAPI documents
+	openAPISourceHandler := &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: &openAPISourceEventMapper{client: mgr.GetClient(), logger: log},
+	}
+	err = c.Watch(&source.Kind{Type: &corev1.ConfigMap{}}, openAPISourceHandler)
+	if err != nil {
+		return err
+	}
+
+	err = c.Watch(&source.Kind{Type: &corev1.Secret{}}, openAPISourceHandler)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// blank assignment to verify that ReconcileActiveDoc implements reconcile.Reconciler
+var _ reconcile.Reconciler = &ReconcileActiveDoc{}
+
+// ReconcileActiveDoc reconciles a ActiveDoc object
+type ReconcileActiveDoc struct {
+	*reconcilers.BaseReconciler
+}
+
+// Reconcile reads that state of the cluster for a ActiveDoc object and makes changes based on the state read
+// and what is in the ActiveDoc.Spec
+func (r *ReconcileActiveDoc) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := r.Logger().WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconcile ActiveDoc", "Operator version", version.Version)
+
+	// Fetch the ActiveDoc instance
+	activeDoc := &capabilitiesv1beta1.ActiveDoc{}
+	err := r.Client().Get(r.Context(), request.NamespacedName, activeDoc)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Return and don't requeue
+			controllerhelper.DeleteResourceSynced(capabilitiesv1beta1.ActiveDocKind, request.NamespacedName)
+			reqLogger.Info("resource not found. Ignoring since object must have been deleted")
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	if reqLogger.V(1).Enabled() {
+		jsonData, err := json.MarshalIndent(activeDoc, "", "  ")
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		reqLogger.V(1).Info(string(jsonData))
+	}
+
+	if activeDoc.GetDeletionTimestamp() != nil && helper.ArrayContains(activeDoc.GetFinalizers(), capabilitiesv1beta1.ActiveDocFinalizer) {
+		return r.reconcileDeletion(activeDoc, reqLogger)
+	}
+
+	// Ignore deleted ActiveDocs, this can happen when foregroundDeletion is enabled
+	// https://kubernetes.io/docs/concepts/workloads/controllers/garbage-collection/#foreground-cascading-deletion
+	if activeDoc.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	if !helper.ArrayContains(activeDoc.GetFinalizers(), capabilitiesv1beta1.ActiveDocFinalizer) {
+		controllerutil.AddFinalizer(activeDoc, capabilitiesv1beta1.ActiveDocFinalizer)
+		err := r.Client().Update(r.Context(), activeDoc)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("Failed adding activedoc finalizer: %w", err)
+		}
+
+		reqLogger.Info("resource finalizer added. Requeueing.")
+		return reconcile.Result{Requeue: true}, nil
+	}
+
+	if activeDoc.SetDefaults(reqLogger) {
+		err := r.Client().Update(r.Context(), activeDoc)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("Failed setting activedoc defaults: %w", err)
+		}
+
+		reqLogger.Info("resource defaults updated. Requeueing.")
+		return reconcile.Result{Requeue: true}, nil
+	}
+
+	statusReconciler, reconcileErr := r.reconcile(activeDoc)
+	statusResult, statusUpdateErr := statusReconciler.Reconcile()
+	if statusUpdateErr != nil {
+		if reconcileErr != nil {
+			return reconcile.Result{}, fmt.Errorf("Failed to sync activedoc: %v. Failed to update activedoc status: %w", reconcileErr, statusUpdateErr)
+		}
+
+		return reconcile.Result{}, fmt.Errorf("Failed to update activedoc status: %w", statusUpdateErr)
+	}
+
+	if statusResult.Requeue {
+		return statusResult, nil
+	}
+
+	if reconcileErr != nil {
+		if helper.IsInvalidSpecError(reconcileErr) {
+			// On Validation error, no need to retry as spec is not valid and needs to be changed
+			reqLogger.Info("ERROR", "spec validation error", reconcileErr)
+			r.EventRecorder().Eventf(activeDoc, corev1.EventTypeWarning, "Invalid ActiveDoc Spec", "%v", reconcileErr)
+			return reconcile.Result{}, nil
+		}
+
+		if controllerhelper.IsThreescaleUnavailable(reconcileErr) {
+			// 3scale is expected to recover, retry once the circuit breaker lets requests through again
+			reqLogger.Info("ERROR", "3scale unavailable", reconcileErr)
+			r.EventRecorder().Eventf(activeDoc, corev1.EventTypeWarning, "Unavailable", "%v", reconcileErr)
+			return reconcile.Result{RequeueAfter: controllerhelper.ThreescaleUnavailableRequeueDelay}, nil
+		}
+
+		if helper.IsOrphanSpecError(reconcileErr) {
+			// On Orphan spec error, retry
+			reqLogger.Info("ERROR", "spec orphan error", reconcileErr)
+			return reconcile.Result{Requeue: true}, nil
+		}
+
+		reqLogger.Error(reconcileErr, "Failed to reconcile")
+		r.EventRecorder().Eventf(activeDoc, corev1.EventTypeWarning, "ReconcileError", "%v", reconcileErr)
+	}
+
+	reqLogger.Info("END", "error", reconcileErr)
+	return reconcile.Result{}, reconcileErr
+}
+
+// reconcileDeletion removes the 3scale activedoc, unless orphan on delete is requested,
+// and then removes the finalizer to let the resource be deleted
+func (r *ReconcileActiveDoc) reconcileDeletion(activeDoc *capabilitiesv1beta1.ActiveDoc, reqLogger logr.Logger) (reconcile.Result, error) {
+	if activeDoc.OrphanOnDelete() {
+		reqLogger.Info("orphan on delete annotation found. 3scale activedoc will not be deleted")
+	} else {
+		err := r.delete3scaleActiveDoc(activeDoc)
+		if err != nil {
+			reqLogger.Error(err, "Failed to delete 3scale activedoc")
+			r.EventRecorder().Eventf(activeDoc, corev1.EventTypeWarning, "DeleteError", "%v", err)
+			statusReconciler := NewStatusReconciler(r.BaseReconciler, activeDoc, nil, activeDoc.Status.ProviderAccountHost, err)
+			_, statusUpdateErr := statusReconciler.Reconcile()
+			if statusUpdateErr != nil {
+				return reconcile.Result{}, fmt.Errorf("Failed to delete 3scale activedoc: %v. Failed to update activedoc status: %w", err, statusUpdateErr)
+			}
+			return reconcile.Result{}, err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(activeDoc, capabilitiesv1beta1.ActiveDocFinalizer)
+	err := r.Client().Update(r.Context(), activeDoc)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("Failed removing activedoc finalizer: %w", err)
+	}
+
+	reqLogger.Info("resource finalizer removed")
+	return reconcile.Result{}, nil
+}
+
+func (r *ReconcileActiveDoc) delete3scaleActiveDoc(activeDoc *capabilitiesv1beta1.ActiveDoc) error {
+	logger := r.Logger().WithValues("activedoc", activeDoc.Name)
+
+	// Only the 3scale activedoc managed by the resource is deleted
+	if activeDoc.Status.ID == nil {
+		logger.Info("3scale activedoc not managed by the resource. Nothing to delete")
+		return nil
+	}
+
+	providerAccount, err := controllerhelper.LookupProviderAccount(r.Client(), activeDoc.Namespace, activeDoc.Spec.ProviderAccountRef, logger)
+	if controllerhelper.IsProviderAccountNotFound(err) {
+		// The credentials are gone, i.e. the namespace is being deleted.
+		// The 3scale activedoc is orphaned instead of blocking the resource deletion forever
+		logger.Info("provider account not found. 3scale activedoc will not be deleted", "error", err.Error())
+		r.EventRecorder().Eventf(activeDoc, corev1.EventTypeWarning, "Orphaned", "3scale activedoc [%s] not deleted: %v", activeDoc.Spec.SystemName, err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	threescaleAPIClient, err := controllerhelper.PortaClient(providerAccount)
+	if err != nil {
+		return err
+	}
+
+	err = threescaleAPIClient.DeleteActiveDoc(*activeDoc.Status.ID)
+	if err != nil && !controllerhelper.IsThreescaleNotFound(err) {
+		return fmt.Errorf("delete3scaleActiveDoc activedoc [%s]: %w", activeDoc.Spec.SystemName, err)
+	}
+
+	return nil
+}
+
+func (r *ReconcileActiveDoc) reconcile(activeDocResource *capabilitiesv1beta1.ActiveDoc) (*StatusReconciler, error) {
+	logger := r.Logger().WithValues("activedoc", activeDocResource.Name)
+
+	err := r.validateSpec(activeDocResource)
+	if err != nil {
+		statusReconciler := NewStatusReconciler(r.BaseReconciler, activeDocResource, nil, "", err)
+		return statusReconciler, err
+	}
+
+	providerAccount, err := controllerhelper.LookupProviderAccount(r.Client(), activeDocResource.Namespace, activeDocResource.Spec.ProviderAccountRef, logger)
+	if err != nil {
+		statusReconciler := NewStatusReconciler(r.BaseReconciler, activeDocResource, nil, "", err)
+		return statusReconciler, err
+	}
+
+	threescaleAPIClient, err := controllerhelper.PortaClient(providerAccount)
+	if err != nil {
+		statusReconciler := NewStatusReconciler(r.BaseReconciler, activeDocResource, nil, providerAccount.AdminURLStr, err)
+		return statusReconciler, err
+	}
+
+	productID, err := r.checkExternalRefs(activeDocResource, threescaleAPIClient)
+	logger.Info("checkExternalRefs", "err", err)
+	if err != nil {
+		statusReconciler := NewStatusReconciler(r.BaseReconciler, activeDocResource, nil, providerAccount.AdminURLStr, err)
+		return statusReconciler, err
+	}
+
+	openAPIDoc, err := readOpenAPIDocument(r.Client(), activeDocResource.Namespace, &activeDocResource.Spec.ActiveDocOpenAPIRef)
+	if err != nil {
+		statusReconciler := NewStatusReconciler(r.BaseReconciler, activeDocResource, nil, providerAccount.AdminURLStr, err)
+		return statusReconciler, err
+	}
+
+	reconciler := NewThreescaleReconciler(r.BaseReconciler, activeDocResource, threescaleAPIClient, openAPIDoc, productID)
+	remoteActiveDoc, err := reconciler.Reconcile()
+	statusReconciler := NewStatusReconciler(r.BaseReconciler, activeDocResource, remoteActiveDoc, providerAccount.AdminURLStr, err)
+	return statusReconciler, err
+}
+
+func (r *ReconcileActiveDoc) validateSpec(activeDocResource *capabilitiesv1beta1.ActiveDoc) error {
+	errors := field.ErrorList{}
+	// internal validation
+	errors = append(errors, activeDocResource.Validate()...)
+
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return &helper.SpecFieldError{
+		ErrorType:      helper.InvalidError,
+		FieldErrorList: errors,
+	}
+}
+
+// checkExternalRefs checks the referenced product exists in 3scale and returns its ID.
+// Nil product ID when the activedoc is not bound to a product.
+func (r *ReconcileActiveDoc) checkExternalRefs(activeDocResource *capabilitiesv1beta1.ActiveDoc, threescaleAPIClient *controllerhelper.ThreescaleAPIClient) (*int64, error) {
+	if activeDocResource.Spec.ProductSystemName == nil {
+		return nil, nil
+	}
+
+	productList, err := threescaleAPIClient.ListProducts()
+	if err != nil {
+		return nil, fmt.Errorf("checking product reference: %w", err)
+	}
+
+	for idx := range productList.Products {
+		if productList.Products[idx].Element.SystemName == *activeDocResource.Spec.ProductSystemName {
+			productID := productList.Products[idx].Element.ID
+			return &productID, nil
+		}
+	}
+
+	productSystemNameFldPath := field.NewPath("spec").Child("productSystemName")
+	return nil, &helper.SpecFieldError{
+		ErrorType:      helper.OrphanError,
+		FieldErrorList: field.ErrorList{field.Invalid(productSystemNameFldPath, *activeDocResource.Spec.ProductSystemName, "product not found in 3scale")},
+	}
+}
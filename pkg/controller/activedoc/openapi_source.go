@@ -0,0 +1,64 @@
+package activedoc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+
+	"github.com/ghodss/yaml"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// readOpenAPIDocument reads the OpenAPI document from the source referenced in the spec.
+// The document can be in JSON or YAML format and it is returned in JSON format.
+func readOpenAPIDocument(k8sClient client.Client, namespace string, ref *capabilitiesv1beta1.ActiveDocOpenAPIRefSpec) ([]byte, error) {
+	var rawDoc []byte
+
+	switch {
+	case ref.Inline != nil:
+		rawDoc = []byte(*ref.Inline)
+	case ref.ConfigMapKeyRef != nil:
+		configMap := &corev1.ConfigMap{}
+		err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: ref.ConfigMapKeyRef.Name, Namespace: namespace}, configMap)
+		if err != nil {
+			return nil, fmt.Errorf("reading OpenAPI document configmap: %w", err)
+		}
+
+		data, ok := configMap.Data[ref.ConfigMapKeyRef.Key]
+		if !ok {
+			return nil, fmt.Errorf("key %s not found in OpenAPI document configmap %s", ref.ConfigMapKeyRef.Key, ref.ConfigMapKeyRef.Name)
+		}
+		rawDoc = []byte(data)
+	case ref.SecretKeyRef != nil:
+		secret := &corev1.Secret{}
+		err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: ref.SecretKeyRef.Name, Namespace: namespace}, secret)
+		if err != nil {
+			return nil, fmt.Errorf("reading OpenAPI document secret: %w", err)
+		}
+
+		data, ok := secret.Data[ref.SecretKeyRef.Key]
+		if !ok {
+			return nil, fmt.Errorf("key %s not found in OpenAPI document secret %s", ref.SecretKeyRef.Key, ref.SecretKeyRef.Name)
+		}
+		rawDoc = data
+	default:
+		return nil, fmt.Errorf("OpenAPI document source not found")
+	}
+
+	// JSON is a subset of YAML
+	jsonDoc, err := yaml.YAMLToJSON(rawDoc)
+	if err != nil {
+		return nil, fmt.Errorf("parsing OpenAPI document: %w", err)
+	}
+
+	var obj map[string]interface{}
+	if err := json.Unmarshal(jsonDoc, &obj); err != nil {
+		return nil, fmt.Errorf("parsing OpenAPI document: expected object: %w", err)
+	}
+
+	return jsonDoc, nil
+}
@@ -0,0 +1,54 @@
+package activedoc
+
+import (
+	"context"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// openAPISourceEventMapper maps ConfigMap and Secret events to reconcile requests of the activedocs
+// reading the OpenAPI document from them
+type openAPISourceEventMapper struct {
+	client client.Client
+	logger logr.Logger
+}
+
+func (o *openAPISourceEventMapper) Map(obj handler.MapObject) []reconcile.Request {
+	var uses func(*capabilitiesv1beta1.ActiveDoc) bool
+	switch source := obj.Object.(type) {
+	case *corev1.ConfigMap:
+		uses = func(activeDoc *capabilitiesv1beta1.ActiveDoc) bool { return activeDoc.UsesConfigMap(source) }
+	case *corev1.Secret:
+		uses = func(activeDoc *capabilitiesv1beta1.ActiveDoc) bool { return activeDoc.UsesSecret(source) }
+	default:
+		return nil
+	}
+
+	activeDocList := &capabilitiesv1beta1.ActiveDocList{}
+	err := o.client.List(context.TODO(), activeDocList, client.InNamespace(obj.Meta.GetNamespace()))
+	if err != nil {
+		o.logger.Error(err, "failed to list activedocs", "source", obj.Meta.GetName(), "namespace", obj.Meta.GetNamespace())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0)
+	for idx := range activeDocList.Items {
+		if uses(&activeDocList.Items[idx]) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      activeDocList.Items[idx].Name,
+					Namespace: activeDocList.Items[idx].Namespace,
+				},
+			})
+		}
+	}
+
+	return requests
+}
@@ -0,0 +1,66 @@
+package activedoc
+
+import (
+	"testing"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestOpenAPISourceEventMapper(t *testing.T) {
+	configMapDoc := &capabilitiesv1beta1.ActiveDoc{
+		ObjectMeta: metav1.ObjectMeta{Name: "configmap-doc", Namespace: "test"},
+		Spec: capabilitiesv1beta1.ActiveDocSpec{ActiveDocOpenAPIRef: capabilitiesv1beta1.ActiveDocOpenAPIRefSpec{
+			ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "openapi"}, Key: "openapi.yaml"},
+		}},
+	}
+	secretDoc := &capabilitiesv1beta1.ActiveDoc{
+		ObjectMeta: metav1.ObjectMeta{Name: "secret-doc", Namespace: "test"},
+		Spec: capabilitiesv1beta1.ActiveDocSpec{ActiveDocOpenAPIRef: capabilitiesv1beta1.ActiveDocOpenAPIRefSpec{
+			SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "openapi"}, Key: "openapi.yaml"},
+		}},
+	}
+	otherNamespaceDoc := configMapDoc.DeepCopy()
+	otherNamespaceDoc.Namespace = "other"
+
+	s := runtime.NewScheme()
+	if err := capabilitiesv1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	mapper := &openAPISourceEventMapper{
+		client: fake.NewFakeClientWithScheme(s, configMapDoc, secretDoc, otherNamespaceDoc),
+		logger: logf.Log.WithName("test"),
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "openapi", Namespace: "test"}}
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "openapi", Namespace: "test"}}
+
+	cases := []struct {
+		testName string
+		obj      handler.MapObject
+		expected string
+	}{
+		{"configmap", handler.MapObject{Meta: configMap, Object: configMap}, "configmap-doc"},
+		{"secret", handler.MapObject{Meta: secret, Object: secret}, "secret-doc"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			requests := mapper.Map(tc.obj)
+			if len(requests) != 1 || requests[0].Name != tc.expected || requests[0].Namespace != "test" {
+				subT.Errorf("expected request for activedoc test/%s, got %v", tc.expected, requests)
+			}
+		})
+	}
+
+	unused := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "unused", Namespace: "test"}}
+	if requests := mapper.Map(handler.MapObject{Meta: unused, Object: unused}); len(requests) != 0 {
+		t.Errorf("expected no requests for unused configmap, got %v", requests)
+	}
+}
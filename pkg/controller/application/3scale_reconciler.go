@@ -0,0 +1,274 @@
+package application
+
+import (
+	"fmt"
+	"strconv"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// externalRefs holds the 3scale IDs of the resources referenced by the application
+type externalRefs struct {
+	accountID int64
+	productID int64
+	planID    int64
+}
+
+type ThreescaleReconciler struct {
+	*reconcilers.BaseReconciler
+	resource            *capabilitiesv1beta1.Application
+	threescaleAPIClient *controllerhelper.ThreescaleAPIClient
+	refs                *externalRefs
+	logger              logr.Logger
+}
+
+func NewThreescaleReconciler(b *reconcilers.BaseReconciler, resource *capabilitiesv1beta1.Application, threescaleAPIClient *controllerhelper.ThreescaleAPIClient, refs *externalRefs) *ThreescaleReconciler {
+	return &ThreescaleReconciler{
+		BaseReconciler:      b,
+		resource:            resource,
+		threescaleAPIClient: threescaleAPIClient,
+		refs:                refs,
+		logger:              b.Logger().WithValues("3scale Reconciler", resource.Name),
+	}
+}
+
+func (t *ThreescaleReconciler) Reconcile() (*controllerhelper.Application, error) {
+	remoteApplication, err := findApplication(t.threescaleAPIClient, t.resource, t.refs.accountID)
+	if helper.IsInvalidSpecError(err) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error sync application [%s]: %w", t.resource.Spec.Name, err)
+	}
+
+	if remoteApplication == nil {
+		remoteApplication, err = t.findApplicationByName()
+		if helper.IsInvalidSpecError(err) {
+			return nil, err
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Error sync application [%s]: %w", t.resource.Spec.Name, err)
+		}
+	}
+
+	if remoteApplication == nil {
+		remoteApplication, err = t.createApplication()
+		if err != nil {
+			return nil, fmt.Errorf("Error sync application [%s]: %w", t.resource.Spec.Name, err)
+		}
+	} else {
+		remoteApplication, err = t.syncApplication(remoteApplication)
+		if err != nil {
+			return nil, fmt.Errorf("Error sync application [%s]: %w", t.resource.Spec.Name, err)
+		}
+	}
+
+	remoteApplication, err = t.syncPlan(remoteApplication)
+	if err != nil {
+		return remoteApplication, fmt.Errorf("Error sync application [%s] plan: %w", t.resource.Spec.Name, err)
+	}
+
+	remoteApplication, err = t.syncState(remoteApplication)
+	if err != nil {
+		return remoteApplication, fmt.Errorf("Error sync application [%s] state: %w", t.resource.Spec.Name, err)
+	}
+
+	return remoteApplication, nil
+}
+
+// findApplicationByName returns the existing 3scale application with the same name and product
+// in the developer account when the resource owns it or it is adopted. Nil when not found.
+func (t *ThreescaleReconciler) findApplicationByName() (*controllerhelper.Application, error) {
+	applicationList, err := t.threescaleAPIClient.ListDeveloperAccountApplications(t.refs.accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var remoteApplication *controllerhelper.Application
+	for idx := range applicationList.Applications {
+		item := applicationList.Applications[idx].Element
+		if item.Name == t.resource.Spec.Name && item.ServiceID == t.refs.productID {
+			remoteApplication = &applicationList.Applications[idx]
+			break
+		}
+	}
+
+	if remoteApplication == nil {
+		return nil, nil
+	}
+
+	if !t.resource.Spec.Adopt && !t.resource.Owns(remoteApplication.Element.ID) {
+		// Existing applications not created by this resource are only taken over on explicit adoption
+		return nil, &helper.SpecFieldError{
+			ErrorType: helper.InvalidError,
+			FieldErrorList: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("name"), t.resource.Spec.Name,
+					"3scale application already exists and it is not managed by this resource. Set spec.adopt to take it over"),
+			},
+		}
+	}
+
+	// The ID is recorded right away, the application is deleted with the resource from now on
+	err = t.recordApplication(remoteApplication)
+	if err != nil {
+		return nil, err
+	}
+
+	return remoteApplication, nil
+}
+
+func (t *ThreescaleReconciler) createApplication() (*controllerhelper.Application, error) {
+	// The application is claimed before its creation.
+	// When the response or the status update are lost, the next reconciliation still owns it
+	err := controllerhelper.Claim(t.Context(), t.Client(), t.resource, capabilitiesv1beta1.ClaimedApplicationNameAnnotation, t.resource.Spec.Name)
+	if err != nil {
+		return nil, fmt.Errorf("claim: %w", err)
+	}
+
+	params := threescaleapi.Params{
+		"name":    t.resource.Spec.Name,
+		"plan_id": strconv.FormatInt(t.refs.planID, 10),
+	}
+	if t.resource.Spec.Description != nil {
+		params["description"] = *t.resource.Spec.Description
+	}
+
+	remoteApplication, err := t.threescaleAPIClient.CreateDeveloperAccountApplication(t.refs.accountID, params)
+	if err != nil {
+		if controllerhelper.IsCreationRejected(err) {
+			releaseErr := controllerhelper.ReleaseClaim(t.Context(), t.Client(), t.resource, capabilitiesv1beta1.ClaimedApplicationNameAnnotation)
+			if releaseErr != nil {
+				t.logger.Error(releaseErr, "failed to release application name claim")
+			}
+		}
+		return nil, err
+	}
+
+	// The ID is recorded right away. On failure, the claim keeps the ownership
+	err = t.recordApplication(remoteApplication)
+	if err != nil {
+		t.logger.Error(err, "failed to record application ID", "ID", remoteApplication.Element.ID)
+	}
+
+	return remoteApplication, nil
+}
+
+// recordApplication records in the status the IDs of the managed application and its developer account
+func (t *ThreescaleReconciler) recordApplication(remoteApplication *controllerhelper.Application) error {
+	return controllerhelper.PatchStatus(t.Context(), t.Client(), t.resource, func() {
+		applicationID := remoteApplication.Element.ID
+		accountID := t.refs.accountID
+		t.resource.Status.ID = &applicationID
+		t.resource.Status.DeveloperAccountID = &accountID
+	})
+}
+
+func (t *ThreescaleReconciler) syncApplication(remoteApplication *controllerhelper.Application) (*controllerhelper.Application, error) {
+	params := threescaleapi.Params{}
+
+	if remoteApplication.Element.Name != t.resource.Spec.Name {
+		params["name"] = t.resource.Spec.Name
+	}
+
+	if t.resource.Spec.Description != nil && remoteApplication.Element.Description != *t.resource.Spec.Description {
+		params["description"] = *t.resource.Spec.Description
+	}
+
+	if len(params) == 0 {
+		return remoteApplication, nil
+	}
+
+	t.logger.V(1).Info("update application", "params", params)
+	return t.threescaleAPIClient.UpdateDeveloperAccountApplication(t.refs.accountID, remoteApplication.Element.ID, params)
+}
+
+func (t *ThreescaleReconciler) syncPlan(remoteApplication *controllerhelper.Application) (*controllerhelper.Application, error) {
+	if remoteApplication.Element.PlanID == t.refs.planID {
+		return remoteApplication, nil
+	}
+
+	t.logger.V(1).Info("change application plan", "from", remoteApplication.Element.PlanID, "to", t.refs.planID)
+	return t.threescaleAPIClient.ChangeDeveloperAccountApplicationPlan(t.refs.accountID, remoteApplication.Element.ID, t.refs.planID)
+}
+
+func (t *ThreescaleReconciler) syncState(remoteApplication *controllerhelper.Application) (*controllerhelper.Application, error) {
+	if t.resource.Spec.Suspend == nil {
+		return remoteApplication, nil
+	}
+
+	suspended := remoteApplication.Element.State == capabilitiesv1beta1.ApplicationStateSuspended
+	if *t.resource.Spec.Suspend == suspended {
+		return remoteApplication, nil
+	}
+
+	if *t.resource.Spec.Suspend {
+		t.logger.V(1).Info("suspend application")
+		return t.threescaleAPIClient.SuspendDeveloperAccountApplication(t.refs.accountID, remoteApplication.Element.ID)
+	}
+
+	t.logger.V(1).Info("resume application")
+	return t.threescaleAPIClient.ResumeDeveloperAccountApplication(t.refs.accountID, remoteApplication.Element.ID)
+}
+
+// Credentials returns the application credentials to be written to the credentials secret.
+// user_key for API key authentication mode. app_id and app_key for App ID and App Key authentication mode.
+func (t *ThreescaleReconciler) Credentials(remoteApplication *controllerhelper.Application) (map[string]string, error) {
+	credentials := map[string]string{}
+
+	if remoteApplication.Element.UserKey != "" {
+		credentials[capabilitiesv1beta1.ApplicationUserKeySecretField] = remoteApplication.Element.UserKey
+	}
+
+	if remoteApplication.Element.ApplicationID != "" {
+		credentials[capabilitiesv1beta1.ApplicationAppIDSecretField] = remoteApplication.Element.ApplicationID
+
+		keyList, err := t.threescaleAPIClient.ListDeveloperAccountApplicationKeys(t.refs.accountID, remoteApplication.Element.ID)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading application [%s] keys: %w", t.resource.Spec.Name, err)
+		}
+
+		if len(keyList.Keys) > 0 {
+			credentials[capabilitiesv1beta1.ApplicationAppKeySecretField] = keyList.Keys[0].Element.Value
+		}
+	}
+
+	return credentials, nil
+}
+
+// findApplication returns the 3scale application managed by the resource,
+// looked up by the ID recorded in the status. Nil when not found.
+// Applications with the same name are never returned, they may not be managed by the resource
+func findApplication(threescaleAPIClient *controllerhelper.ThreescaleAPIClient, resource *capabilitiesv1beta1.Application, accountID int64) (*controllerhelper.Application, error) {
+	if resource.Status.ID == nil {
+		return nil, nil
+	}
+
+	if resource.Status.DeveloperAccountID != nil && *resource.Status.DeveloperAccountID != accountID {
+		// 3scale applications cannot be moved between developer accounts.
+		// Looking it up in the new developer account would leak the managed application
+		return nil, &helper.SpecFieldError{
+			ErrorType: helper.InvalidError,
+			FieldErrorList: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("developerAccountRef"), resource.Spec.DeveloperAccountRef.Name,
+					fmt.Sprintf("3scale application [%d] belongs to developer account [%d]. The developer account of an application cannot be changed", *resource.Status.ID, *resource.Status.DeveloperAccountID)),
+			},
+		}
+	}
+
+	remoteApplication, err := threescaleAPIClient.DeveloperAccountApplication(accountID, *resource.Status.ID)
+	if err != nil {
+		if controllerhelper.IsThreescaleNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return remoteApplication, nil
+}
@@ -0,0 +1,218 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// fakeApplicationsAPI serves the 3scale developer account applications list, creation and read endpoints,
+// applications indexed by developer account ID
+type fakeApplicationsAPI struct {
+	mu           sync.Mutex
+	nextID       int64
+	applications map[int64][]controllerhelper.Application
+}
+
+func (f *fakeApplicationsAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	r.ParseForm()
+
+	for accountID, applications := range f.applications {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == fmt.Sprintf("/admin/api/accounts/%d/applications.json", accountID):
+			json.NewEncoder(w).Encode(controllerhelper.ApplicationList{Applications: applications})
+			return
+		case r.Method == http.MethodPost && r.URL.Path == fmt.Sprintf("/admin/api/accounts/%d/applications.json", accountID):
+			f.nextID++
+			planID, _ := strconv.ParseInt(r.PostForm.Get("plan_id"), 10, 64)
+			application := controllerhelper.Application{Element: controllerhelper.ApplicationItem{
+				ID:        f.nextID,
+				Name:      r.PostForm.Get("name"),
+				ServiceID: 1,
+				PlanID:    planID,
+			}}
+			f.applications[accountID] = append(applications, application)
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(application)
+			return
+		case r.Method == http.MethodGet:
+			for _, application := range applications {
+				if r.URL.Path == fmt.Sprintf("/admin/api/accounts/%d/applications/%d.json", accountID, application.Element.ID) {
+					json.NewEncoder(w).Encode(application)
+					return
+				}
+			}
+		}
+	}
+
+	w.WriteHeader(http.StatusNotFound)
+}
+
+func newTestApplicationsAPI(applications ...controllerhelper.Application) *fakeApplicationsAPI {
+	return &fakeApplicationsAPI{
+		nextID:       10,
+		applications: map[int64][]controllerhelper.Application{1: applications, 2: nil},
+	}
+}
+
+func newTestApplicationClient(t *testing.T, adopt bool) (client.Client, types.NamespacedName) {
+	application := &capabilitiesv1beta1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "application1", Namespace: "test"},
+		Spec:       capabilitiesv1beta1.ApplicationSpec{Name: "Application 1", Adopt: adopt},
+	}
+
+	s := runtime.NewScheme()
+	if err := capabilitiesv1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	return fake.NewFakeClientWithScheme(s, application), types.NamespacedName{Name: "application1", Namespace: "test"}
+}
+
+func newTestApplicationReconciler(t *testing.T, k8sClient client.Client, nn types.NamespacedName, srv *httptest.Server, accountID int64) *ThreescaleReconciler {
+	application := &capabilitiesv1beta1.Application{}
+	if err := k8sClient.Get(context.TODO(), nn, application); err != nil {
+		t.Fatal(err)
+	}
+
+	threescaleAPIClient, err := controllerhelper.PortaClientFromURLString(srv.URL, "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	baseReconciler := reconcilers.NewBaseReconciler(k8sClient, nil, k8sClient, context.TODO(), logf.Log.WithName("test"), nil, record.NewFakeRecorder(10))
+	refs := &externalRefs{accountID: accountID, productID: 1, planID: 1}
+	return NewThreescaleReconciler(baseReconciler, application, threescaleAPIClient, refs)
+}
+
+func TestReconcile3scaleApplicationNotOwned(t *testing.T) {
+	api := newTestApplicationsAPI(controllerhelper.Application{Element: controllerhelper.ApplicationItem{ID: 1, Name: "Application 1", ServiceID: 1, PlanID: 1}})
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+
+	k8sClient, nn := newTestApplicationClient(t, false)
+
+	_, err := newTestApplicationReconciler(t, k8sClient, nn, srv, 1).Reconcile()
+	if !helper.IsInvalidSpecError(err) {
+		t.Fatalf("expected invalid spec error for an application not created by the resource, got %v", err)
+	}
+
+	if len(api.applications[1]) != 1 {
+		t.Fatalf("expected no application to be created, got %v", api.applications[1])
+	}
+}
+
+func TestReconcile3scaleApplicationAdopt(t *testing.T) {
+	api := newTestApplicationsAPI(controllerhelper.Application{Element: controllerhelper.ApplicationItem{ID: 1, Name: "Application 1", ServiceID: 1, PlanID: 1}})
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+
+	k8sClient, nn := newTestApplicationClient(t, true)
+
+	remoteApplication, err := newTestApplicationReconciler(t, k8sClient, nn, srv, 1).Reconcile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if remoteApplication.Element.ID != 1 || len(api.applications[1]) != 1 {
+		t.Fatalf("expected application 1 to be adopted, got %v", api.applications[1])
+	}
+
+	application := &capabilitiesv1beta1.Application{}
+	if err := k8sClient.Get(context.TODO(), nn, application); err != nil {
+		t.Fatal(err)
+	}
+	if application.Status.ID == nil || *application.Status.ID != 1 || application.Status.DeveloperAccountID == nil || *application.Status.DeveloperAccountID != 1 {
+		t.Fatalf("expected adopted application IDs to be recorded, got %v", application.Status)
+	}
+}
+
+func TestReconcile3scaleApplicationLostStatus(t *testing.T) {
+	api := newTestApplicationsAPI()
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+
+	k8sClient, nn := newTestApplicationClient(t, false)
+
+	created, err := newTestApplicationReconciler(t, k8sClient, nn, srv, 1).Reconcile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	application := &capabilitiesv1beta1.Application{}
+	if err := k8sClient.Get(context.TODO(), nn, application); err != nil {
+		t.Fatal(err)
+	}
+	if application.Status.ID == nil || *application.Status.ID != created.Element.ID {
+		t.Fatalf("expected application ID %d to be recorded, got %v", created.Element.ID, application.Status.ID)
+	}
+
+	// A lost status update discards the recorded IDs
+	application.Status.ID = nil
+	application.Status.DeveloperAccountID = nil
+	if err := k8sClient.Update(context.TODO(), application); err != nil {
+		t.Fatal(err)
+	}
+
+	same, err := newTestApplicationReconciler(t, k8sClient, nn, srv, 1).Reconcile()
+	if err != nil {
+		t.Fatalf("created application should still be owned after losing the status, got %v", err)
+	}
+
+	if same.Element.ID != created.Element.ID || len(api.applications[1]) != 1 {
+		t.Fatalf("expected application %d to be reused, got %d and %d applications", created.Element.ID, same.Element.ID, len(api.applications[1]))
+	}
+}
+
+func TestReconcile3scaleApplicationDeveloperAccountChanged(t *testing.T) {
+	api := newTestApplicationsAPI()
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+
+	k8sClient, nn := newTestApplicationClient(t, false)
+
+	created, err := newTestApplicationReconciler(t, k8sClient, nn, srv, 1).Reconcile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// developerAccountRef now references developer account 2
+	_, err = newTestApplicationReconciler(t, k8sClient, nn, srv, 2).Reconcile()
+	if !helper.IsInvalidSpecError(err) {
+		t.Fatalf("expected invalid spec error when the developer account changes, got %v", err)
+	}
+
+	if len(api.applications[2]) != 0 {
+		t.Fatalf("expected no application to be created in developer account 2, got %v", api.applications[2])
+	}
+
+	application := &capabilitiesv1beta1.Application{}
+	if err := k8sClient.Get(context.TODO(), nn, application); err != nil {
+		t.Fatal(err)
+	}
+	if application.Status.ID == nil || *application.Status.ID != created.Element.ID || *application.Status.DeveloperAccountID != 1 {
+		t.Fatalf("expected application %d of developer account 1 to be kept in the status, got %v", created.Element.ID, application.Status)
+	}
+}
@@ -0,0 +1,498 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	"github.com/3scale/3scale-operator/pkg/common"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+	"github.com/3scale/3scale-operator/version"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+var (
+	// controllerName is the name of this controller
+	controllerName = "controller_application"
+
+	// package level logger
+	log = logf.Log.WithName(controllerName)
+)
+
+// Add creates a new Application Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	reconciler, err := newReconciler(mgr)
+	if err != nil {
+		return err
+	}
+
+	return add(mgr, reconciler)
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager) (reconcile.Reconciler, error) {
+	apiClientReader, err := common.NewAPIClientReader(mgr)
+	if err != nil {
+		return nil, err
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	client := mgr.GetClient()
+	scheme := mgr.GetScheme()
+	ctx := context.TODO()
+	recorder := mgr.GetEventRecorderFor(controllerName)
+	return &ReconcileApplication{
+		BaseReconciler: reconcilers.NewBaseReconciler(client, scheme, apiClientReader, ctx, log, discoveryClient, recorder),
+	}, nil
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New("application-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to primary resource Application
+	err = c.Watch(&source.Kind{Type: &capabilitiesv1beta1.Application{}}, &handler.EnqueueRequestForObject{})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to secondary resource Secret and requeue the owner Application
+	err = c.Watch(&source.Kind{Type: &corev1.Secret{}}, &handler.EnqueueRequestForOwner{
+		IsController: true,
+		OwnerType:    &capabilitiesv1beta1.Application{},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// blank assignment to verify that ReconcileApplication implements reconcile.Reconciler
+var _ reconcile.Reconciler = &ReconcileApplication{}
+
+// ReconcileApplication reconciles a Application object
+type ReconcileApplication struct {
+	*reconcilers.BaseReconciler
+}
+
+// Reconcile reads that state of the cluster for a Application object and makes changes based on the state read
+// and what is in the Application.Spec
+func (r *ReconcileApplication) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := r.Logger().WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconcile Application", "Operator version", version.Version)
+
+	// Fetch the Application instance
+	application := &capabilitiesv1beta1.Application{}
+	err := r.Client().Get(r.Context(), request.NamespacedName, application)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Return and don't requeue
+			controllerhelper.DeleteResourceSynced(capabilitiesv1beta1.ApplicationKind, request.NamespacedName)
+			reqLogger.Info("resource not found. Ignoring since object must have been deleted")
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	if reqLogger.V(1).Enabled() {
+		jsonData, err := json.MarshalIndent(application, "", "  ")
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		reqLogger.V(1).Info(string(jsonData))
+	}
+
+	if application.GetDeletionTimestamp() != nil && helper.ArrayContains(application.GetFinalizers(), capabilitiesv1beta1.ApplicationFinalizer) {
+		return r.reconcileDeletion(application, reqLogger)
+	}
+
+	// Ignore deleted Applications, this can happen when foregroundDeletion is enabled
+	// https://kubernetes.io/docs/concepts/workloads/controllers/garbage-collection/#foreground-cascading-deletion
+	if application.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	if !helper.ArrayContains(application.GetFinalizers(), capabilitiesv1beta1.ApplicationFinalizer) {
+		controllerutil.AddFinalizer(application, capabilitiesv1beta1.ApplicationFinalizer)
+		err := r.Client().Update(r.Context(), application)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("Failed adding application finalizer: %w", err)
+		}
+
+		reqLogger.Info("resource finalizer added. Requeueing.")
+		return reconcile.Result{Requeue: true}, nil
+	}
+
+	if application.SetDefaults(reqLogger) {
+		err := r.Client().Update(r.Context(), application)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("Failed setting application defaults: %w", err)
+		}
+
+		reqLogger.Info("resource defaults updated. Requeueing.")
+		return reconcile.Result{Requeue: true}, nil
+	}
+
+	statusReconciler, reconcileErr := r.reconcile(application)
+	statusResult, statusUpdateErr := statusReconciler.Reconcile()
+	if statusUpdateErr != nil {
+		if reconcileErr != nil {
+			return reconcile.Result{}, fmt.Errorf("Failed to sync application: %v. Failed to update application status: %w", reconcileErr, statusUpdateErr)
+		}
+
+		return reconcile.Result{}, fmt.Errorf("Failed to update application status: %w", statusUpdateErr)
+	}
+
+	if statusResult.Requeue {
+		return statusResult, nil
+	}
+
+	if reconcileErr != nil {
+		if helper.IsInvalidSpecError(reconcileErr) {
+			// On Validation error, no need to retry as spec is not valid and needs to be changed
+			reqLogger.Info("ERROR", "spec validation error", reconcileErr)
+			r.EventRecorder().Eventf(application, corev1.EventTypeWarning, "Invalid Application Spec", "%v", reconcileErr)
+			return reconcile.Result{}, nil
+		}
+
+		if controllerhelper.IsThreescaleUnavailable(reconcileErr) {
+			// 3scale is expected to recover, retry once the circuit breaker lets requests through again
+			reqLogger.Info("ERROR", "3scale unavailable", reconcileErr)
+			r.EventRecorder().Eventf(application, corev1.EventTypeWarning, "Unavailable", "%v", reconcileErr)
+			return reconcile.Result{RequeueAfter: controllerhelper.ThreescaleUnavailableRequeueDelay}, nil
+		}
+
+		if helper.IsOrphanSpecError(reconcileErr) {
+			// On Orphan spec error, retry
+			reqLogger.Info("ERROR", "spec orphan error", reconcileErr)
+			return reconcile.Result{Requeue: true}, nil
+		}
+
+		reqLogger.Error(reconcileErr, "Failed to reconcile")
+		r.EventRecorder().Eventf(application, corev1.EventTypeWarning, "ReconcileError", "%v", reconcileErr)
+	}
+
+	reqLogger.Info("END", "error", reconcileErr)
+	return reconcile.Result{}, reconcileErr
+}
+
+// reconcileDeletion removes the 3scale application, unless orphan on delete is requested,
+// and then removes the finalizer to let the resource be deleted
+func (r *ReconcileApplication) reconcileDeletion(application *capabilitiesv1beta1.Application, reqLogger logr.Logger) (reconcile.Result, error) {
+	if application.OrphanOnDelete() {
+		reqLogger.Info("orphan on delete annotation found. 3scale application will not be deleted")
+	} else {
+		err := r.delete3scaleApplication(application)
+		if err != nil {
+			reqLogger.Error(err, "Failed to delete 3scale application")
+			r.EventRecorder().Eventf(application, corev1.EventTypeWarning, "DeleteError", "%v", err)
+			statusReconciler := NewStatusReconciler(r.BaseReconciler, application, nil, nil, application.Status.ProviderAccountHost, err)
+			_, statusUpdateErr := statusReconciler.Reconcile()
+			if statusUpdateErr != nil {
+				return reconcile.Result{}, fmt.Errorf("Failed to delete 3scale application: %v. Failed to update application status: %w", err, statusUpdateErr)
+			}
+			return reconcile.Result{}, err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(application, capabilitiesv1beta1.ApplicationFinalizer)
+	err := r.Client().Update(r.Context(), application)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("Failed removing application finalizer: %w", err)
+	}
+
+	reqLogger.Info("resource finalizer removed")
+	return reconcile.Result{}, nil
+}
+
+func (r *ReconcileApplication) delete3scaleApplication(application *capabilitiesv1beta1.Application) error {
+	logger := r.Logger().WithValues("application", application.Name)
+
+	if application.Status.ID == nil || application.Status.DeveloperAccountID == nil {
+		// application was never created in 3scale, nothing to delete
+		logger.Info("3scale application ID not found. Nothing to delete")
+		return nil
+	}
+
+	providerAccount, err := controllerhelper.LookupProviderAccount(r.Client(), application.Namespace, application.Spec.ProviderAccountRef, logger)
+	if controllerhelper.IsProviderAccountNotFound(err) {
+		// The credentials are gone, i.e. the namespace is being deleted.
+		// The 3scale application is orphaned instead of blocking the resource deletion forever
+		logger.Info("provider account not found. 3scale application will not be deleted", "error", err.Error())
+		r.EventRecorder().Eventf(application, corev1.EventTypeWarning, "Orphaned", "3scale application [%s] not deleted: %v", application.Spec.Name, err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	threescaleAPIClient, err := controllerhelper.PortaClient(providerAccount)
+	if err != nil {
+		return err
+	}
+
+	err = threescaleAPIClient.DeleteDeveloperAccountApplication(*application.Status.DeveloperAccountID, *application.Status.ID)
+	if err != nil && !controllerhelper.IsThreescaleNotFound(err) {
+		return fmt.Errorf("delete3scaleApplication application [%s]: %w", application.Spec.Name, err)
+	}
+
+	return nil
+}
+
+func (r *ReconcileApplication) reconcile(applicationResource *capabilitiesv1beta1.Application) (*StatusReconciler, error) {
+	logger := r.Logger().WithValues("application", applicationResource.Name)
+
+	err := r.validateSpec(applicationResource)
+	if err != nil {
+		statusReconciler := NewStatusReconciler(r.BaseReconciler, applicationResource, nil, nil, "", err)
+		return statusReconciler, err
+	}
+
+	providerAccount, err := controllerhelper.LookupProviderAccount(r.Client(), applicationResource.Namespace, applicationResource.Spec.ProviderAccountRef, logger)
+	if err != nil {
+		statusReconciler := NewStatusReconciler(r.BaseReconciler, applicationResource, nil, nil, "", err)
+		return statusReconciler, err
+	}
+
+	threescaleAPIClient, err := controllerhelper.PortaClient(providerAccount)
+	if err != nil {
+		statusReconciler := NewStatusReconciler(r.BaseReconciler, applicationResource, nil, nil, providerAccount.AdminURLStr, err)
+		return statusReconciler, err
+	}
+
+	refs, err := r.checkExternalRefs(applicationResource, providerAccount, threescaleAPIClient)
+	logger.Info("checkExternalRefs", "err", err)
+	if err != nil {
+		statusReconciler := NewStatusReconciler(r.BaseReconciler, applicationResource, nil, nil, providerAccount.AdminURLStr, err)
+		return statusReconciler, err
+	}
+
+	reconciler := NewThreescaleReconciler(r.BaseReconciler, applicationResource, threescaleAPIClient, refs)
+	remoteApplication, err := reconciler.Reconcile()
+	if err != nil {
+		statusReconciler := NewStatusReconciler(r.BaseReconciler, applicationResource, remoteApplication, &refs.accountID, providerAccount.AdminURLStr, err)
+		return statusReconciler, err
+	}
+
+	credentials, err := reconciler.Credentials(remoteApplication)
+	if err == nil {
+		err = r.reconcileCredentialsSecret(applicationResource, credentials)
+	}
+
+	statusReconciler := NewStatusReconciler(r.BaseReconciler, applicationResource, remoteApplication, &refs.accountID, providerAccount.AdminURLStr, err)
+	return statusReconciler, err
+}
+
+// credentialsSecretFields are the secret fields written by the operator.
+// Other fields of the credentials secret are left untouched
+var credentialsSecretFields = []string{
+	capabilitiesv1beta1.ApplicationUserKeySecretField,
+	capabilitiesv1beta1.ApplicationAppIDSecretField,
+	capabilitiesv1beta1.ApplicationAppKeySecretField,
+}
+
+// reconcileCredentialsSecret writes the application credentials to the credentials secret owned by the application.
+// Existing secrets not controlled by the application, i.e. created by users or by another application, are never written
+func (r *ReconcileApplication) reconcileCredentialsSecret(applicationResource *capabilitiesv1beta1.Application, credentials map[string]string) error {
+	existing := &corev1.Secret{}
+	err := r.Client().Get(r.Context(), types.NamespacedName{Name: applicationResource.Spec.CredentialsSecretName, Namespace: applicationResource.Namespace}, existing)
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("Error reading application credentials secret: %w", err)
+	}
+
+	if err == nil && !metav1.IsControlledBy(existing, applicationResource) {
+		return &helper.SpecFieldError{
+			ErrorType: helper.InvalidError,
+			FieldErrorList: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("credentialsSecretName"), applicationResource.Spec.CredentialsSecretName,
+					"secret already exists and it is not managed by this application"),
+			},
+		}
+	}
+
+	desired := &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      applicationResource.Spec.CredentialsSecretName,
+			Namespace: applicationResource.Namespace,
+			Labels: map[string]string{
+				"app":                    "3scale-api-management",
+				"threescale_component":   "application",
+				"threescale_application": applicationResource.Name,
+			},
+		},
+		StringData: credentials,
+		Type:       corev1.SecretTypeOpaque,
+	}
+
+	err = r.SetOwnerReference(applicationResource, desired)
+	if err != nil {
+		return err
+	}
+
+	err = r.ReconcileResource(&corev1.Secret{}, desired, credentialsSecretMutator)
+	if err != nil {
+		return fmt.Errorf("Error reconciling application credentials secret: %w", err)
+	}
+
+	return nil
+}
+
+// credentialsSecretMutator replaces the secret data with the desired credentials
+func credentialsSecretMutator(existingObj, desiredObj common.KubernetesObject) (bool, error) {
+	existing, ok := existingObj.(*corev1.Secret)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *v1.Secret", existingObj)
+	}
+	desired, ok := desiredObj.(*corev1.Secret)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *v1.Secret", desiredObj)
+	}
+
+	updated := false
+	for fieldName := range desired.StringData {
+		if reconcilers.SecretReconcileField(desired, existing, fieldName) {
+			updated = true
+		}
+	}
+
+	// Remove credentials of previous authentication modes
+	for _, fieldName := range credentialsSecretFields {
+		if _, ok := desired.StringData[fieldName]; ok {
+			continue
+		}
+		if _, ok := existing.Data[fieldName]; ok {
+			delete(existing.Data, fieldName)
+			updated = true
+		}
+	}
+
+	return updated, nil
+}
+
+func (r *ReconcileApplication) validateSpec(applicationResource *capabilitiesv1beta1.Application) error {
+	errors := field.ErrorList{}
+	// internal validation
+	errors = append(errors, applicationResource.Validate()...)
+
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return &helper.SpecFieldError{
+		ErrorType:      helper.InvalidError,
+		FieldErrorList: errors,
+	}
+}
+
+// checkExternalRefs resolves the 3scale IDs of the referenced developer account, product and application plan.
+// Orphan error while any of them is not found or not yet synchronized.
+func (r *ReconcileApplication) checkExternalRefs(applicationResource *capabilitiesv1beta1.Application, providerAccount *controllerhelper.ProviderAccount, threescaleAPIClient *controllerhelper.ThreescaleAPIClient) (*externalRefs, error) {
+	specFldPath := field.NewPath("spec")
+	orphanErr := func(fldPath *field.Path, value interface{}, detail string) error {
+		return &helper.SpecFieldError{
+			ErrorType:      helper.OrphanError,
+			FieldErrorList: field.ErrorList{field.Invalid(fldPath, value, detail)},
+		}
+	}
+
+	// Developer account
+	accountFldPath := specFldPath.Child("developerAccountRef").Child("name")
+	developerAccount := &capabilitiesv1beta1.DeveloperAccount{}
+	err := r.Client().Get(r.Context(), types.NamespacedName{Name: applicationResource.Spec.DeveloperAccountRef.Name, Namespace: applicationResource.Namespace}, developerAccount)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, orphanErr(accountFldPath, applicationResource.Spec.DeveloperAccountRef.Name, "developer account resource not found")
+		}
+		return nil, err
+	}
+
+	if developerAccount.Status.ID == nil {
+		return nil, orphanErr(accountFldPath, applicationResource.Spec.DeveloperAccountRef.Name, "developer account not synchronized")
+	}
+
+	if developerAccount.Status.ProviderAccountHost != providerAccount.AdminURLStr {
+		return nil, &helper.SpecFieldError{
+			ErrorType:      helper.InvalidError,
+			FieldErrorList: field.ErrorList{field.Invalid(accountFldPath, applicationResource.Spec.DeveloperAccountRef.Name, "developer account belongs to a different provider account")},
+		}
+	}
+
+	// Product
+	productFldPath := specFldPath.Child("productRef").Child("name")
+	product := &capabilitiesv1beta1.Product{}
+	err = r.Client().Get(r.Context(), types.NamespacedName{Name: applicationResource.Spec.ProductRef.Name, Namespace: applicationResource.Namespace}, product)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, orphanErr(productFldPath, applicationResource.Spec.ProductRef.Name, "product resource not found")
+		}
+		return nil, err
+	}
+
+	if product.Status.ID == nil {
+		return nil, orphanErr(productFldPath, applicationResource.Spec.ProductRef.Name, "product not synchronized")
+	}
+
+	if product.Status.ProviderAccountHost != providerAccount.AdminURLStr {
+		return nil, &helper.SpecFieldError{
+			ErrorType:      helper.InvalidError,
+			FieldErrorList: field.ErrorList{field.Invalid(productFldPath, applicationResource.Spec.ProductRef.Name, "product belongs to a different provider account")},
+		}
+	}
+
+	// Application plan
+	planFldPath := specFldPath.Child("applicationPlanName")
+	if _, ok := product.Spec.ApplicationPlans[applicationResource.Spec.ApplicationPlanName]; !ok {
+		return nil, orphanErr(planFldPath, applicationResource.Spec.ApplicationPlanName, "application plan not found in product applicationPlans")
+	}
+
+	planList, err := threescaleAPIClient.ListApplicationPlansByProduct(*product.Status.ID)
+	if err != nil {
+		return nil, fmt.Errorf("checking application plan reference: %w", err)
+	}
+
+	for idx := range planList.Plans {
+		if planList.Plans[idx].Element.SystemName == applicationResource.Spec.ApplicationPlanName {
+			return &externalRefs{
+				accountID: *developerAccount.Status.ID,
+				productID: *product.Status.ID,
+				planID:    planList.Plans[idx].Element.ID,
+			}, nil
+		}
+	}
+
+	return nil, orphanErr(planFldPath, applicationResource.Spec.ApplicationPlanName, "application plan not found in 3scale")
+}
@@ -0,0 +1,143 @@
+package application
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func newTestReconciler(t *testing.T, objs ...runtime.Object) (*ReconcileApplication, client.Client, *record.FakeRecorder) {
+	s := scheme.Scheme
+	if err := capabilitiesv1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	k8sClient := fake.NewFakeClientWithScheme(s, objs...)
+	recorder := record.NewFakeRecorder(10)
+	return &ReconcileApplication{
+		BaseReconciler: reconcilers.NewBaseReconciler(k8sClient, s, k8sClient, context.TODO(), logf.Log.WithName("test"), nil, recorder),
+	}, k8sClient, recorder
+}
+
+func newTestApplication(name string) *capabilitiesv1beta1.Application {
+	return &capabilitiesv1beta1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test", UID: types.UID(name + "-uid")},
+		Spec:       capabilitiesv1beta1.ApplicationSpec{Name: name, CredentialsSecretName: "credentials"},
+	}
+}
+
+func TestReconcileCredentialsSecretNotControlled(t *testing.T) {
+	application := newTestApplication("application1")
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "test"},
+		Data:       map[string][]byte{"password": []byte("secret")},
+	}
+
+	r, k8sClient, _ := newTestReconciler(t, application, secret)
+
+	err := r.reconcileCredentialsSecret(application, map[string]string{capabilitiesv1beta1.ApplicationUserKeySecretField: "key"})
+	if !helper.IsInvalidSpecError(err) {
+		t.Fatalf("expected invalid spec error for a secret not controlled by the application, got %v", err)
+	}
+
+	existing := &corev1.Secret{}
+	if err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: "credentials", Namespace: "test"}, existing); err != nil {
+		t.Fatal(err)
+	}
+	if len(existing.Data) != 1 || string(existing.Data["password"]) != "secret" || len(existing.OwnerReferences) != 0 {
+		t.Fatalf("secret not controlled by the application should not be modified, got %v", existing)
+	}
+}
+
+func TestReconcileCredentialsSecretOtherApplication(t *testing.T) {
+	application := newTestApplication("application1")
+	otherApplication := newTestApplication("application2")
+
+	r, _, _ := newTestReconciler(t, application, otherApplication)
+
+	err := r.reconcileCredentialsSecret(application, map[string]string{capabilitiesv1beta1.ApplicationUserKeySecretField: "key1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = r.reconcileCredentialsSecret(otherApplication, map[string]string{capabilitiesv1beta1.ApplicationUserKeySecretField: "key2"})
+	if !helper.IsInvalidSpecError(err) {
+		t.Fatalf("expected invalid spec error for a secret controlled by another application, got %v", err)
+	}
+}
+
+func TestReconcileCredentialsSecretKeepsOtherFields(t *testing.T) {
+	application := newTestApplication("application1")
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "test"},
+		Data: map[string][]byte{
+			capabilitiesv1beta1.ApplicationUserKeySecretField: []byte("key"),
+			"extra": []byte("value"),
+		},
+	}
+
+	r, k8sClient, _ := newTestReconciler(t, application)
+	if err := controllerutil.SetControllerReference(application, secret, r.Scheme()); err != nil {
+		t.Fatal(err)
+	}
+	if err := k8sClient.Create(context.TODO(), secret); err != nil {
+		t.Fatal(err)
+	}
+
+	// Authentication mode changed to app_id and app_key
+	err := r.reconcileCredentialsSecret(application, map[string]string{
+		capabilitiesv1beta1.ApplicationAppIDSecretField:  "id",
+		capabilitiesv1beta1.ApplicationAppKeySecretField: "key",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	existing := &corev1.Secret{}
+	if err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: "credentials", Namespace: "test"}, existing); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := existing.Data[capabilitiesv1beta1.ApplicationUserKeySecretField]; ok {
+		t.Fatal("credentials of the previous authentication mode should be removed")
+	}
+	// The fake client does not merge stringData into data
+	if string(existing.Data["extra"]) != "value" || existing.StringData[capabilitiesv1beta1.ApplicationAppIDSecretField] != "id" {
+		t.Fatalf("expected credentials to be written and other fields kept, got %v and %v", existing.Data, existing.StringData)
+	}
+}
+
+func TestDelete3scaleApplicationWithoutProviderAccount(t *testing.T) {
+	// The provider account secret has already been deleted, i.e. the namespace is being deleted
+	applicationID := int64(1)
+	accountID := int64(2)
+	application := newTestApplication("application1")
+	application.Spec.ProviderAccountRef = &capabilitiesv1beta1.ProviderAccountReference{Name: "tenant-secret"}
+	application.Status = capabilitiesv1beta1.ApplicationStatus{ID: &applicationID, DeveloperAccountID: &accountID}
+
+	r, _, recorder := newTestReconciler(t, application)
+
+	err := r.delete3scaleApplication(application)
+	if err != nil {
+		t.Fatalf("expected 3scale application to be orphaned, got %v", err)
+	}
+
+	event := <-recorder.Events
+	if !strings.Contains(event, "Warning Orphaned") {
+		t.Fatalf("expected Orphaned warning event, got %s", event)
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 type methodData struct {
@@ -29,17 +30,20 @@ type ThreescaleReconciler struct {
 	backendResource     *capabilitiesv1beta1.Backend
 	backendAPIEntity    *controllerhelper.BackendAPIEntity
 	backendRemoteIndex  *controllerhelper.BackendAPIRemoteIndex
-	threescaleAPIClient *threescaleapi.ThreeScaleClient
+	threescaleAPIClient *controllerhelper.ThreescaleAPIClient
 	providerAccount     *controllerhelper.ProviderAccount
+	drift               *controllerhelper.DriftRecorder
 	logger              logr.Logger
 }
 
 func NewThreescaleReconciler(b *reconcilers.BaseReconciler,
 	backendResource *capabilitiesv1beta1.Backend,
-	threescaleAPIClient *threescaleapi.ThreeScaleClient,
+	threescaleAPIClient *controllerhelper.ThreescaleAPIClient,
 	backendRemoteIndex *controllerhelper.BackendAPIRemoteIndex,
 	providerAccount *controllerhelper.ProviderAccount,
 ) *ThreescaleReconciler {
+	// Once the spec generation has been synchronized, any difference is 3scale drift
+	detectDrift := backendResource.IsSynced() && backendResource.Status.ObservedGeneration == backendResource.Generation
 
 	return &ThreescaleReconciler{
 		BaseReconciler:      b,
@@ -47,12 +51,19 @@ func NewThreescaleReconciler(b *reconcilers.BaseReconciler,
 		backendRemoteIndex:  backendRemoteIndex,
 		threescaleAPIClient: threescaleAPIClient,
 		providerAccount:     providerAccount,
+		drift:               controllerhelper.NewDriftRecorder(detectDrift, backendResource.Spec.ReportDriftOnly()),
 		logger:              b.Logger().WithValues("3scale Reconciler", backendResource.Name),
 	}
 }
 
 func (t *ThreescaleReconciler) Reconcile() (*controllerhelper.BackendAPIEntity, error) {
+	err := t.checkOwnership()
+	if err != nil {
+		return nil, err
+	}
+
 	taskRunner := helper.NewTaskRunner(nil, t.logger)
+	taskRunner.ObserveDurations("backend-controller")
 	taskRunner.AddTask("SyncBackend", t.syncBackend)
 	// First methods and metrics, then mapping rules.
 	// Mapping rules reference methods and metrics.
@@ -62,14 +73,37 @@ func (t *ThreescaleReconciler) Reconcile() (*controllerhelper.BackendAPIEntity,
 	taskRunner.AddTask("SyncMetrics", t.syncMetrics)
 	taskRunner.AddTask("SyncMappingRules", t.syncMappingRules)
 
-	err := taskRunner.Run()
+	// The backend entity, when known, is returned on error as well
+	err = taskRunner.Run()
 	if err != nil {
-		return nil, err
+		return t.backendAPIEntity, err
 	}
 
 	return t.backendAPIEntity, nil
 }
 
+// DriftedSections returns the sections where 3scale backend differed from the already synchronized spec
+func (t *ThreescaleReconciler) DriftedSections() []string {
+	return t.drift.Sections()
+}
+
+// checkOwnership returns an invalid spec error when an existing 3scale backend with the same system name
+// was not created by this resource. Existing backends are only taken over on explicit adoption
+func (t *ThreescaleReconciler) checkOwnership() error {
+	backendAPIEntity, exists := t.backendRemoteIndex.FindBySystemName(t.backendResource.Spec.SystemName)
+	if !exists || t.backendResource.Spec.Adopt || t.backendResource.Owns(backendAPIEntity.ID()) {
+		return nil
+	}
+
+	return &helper.SpecFieldError{
+		ErrorType: helper.InvalidError,
+		FieldErrorList: field.ErrorList{
+			field.Invalid(field.NewPath("spec").Child("systemName"), t.backendResource.Spec.SystemName,
+				"3scale backend already exists and it is not managed by this resource. Set spec.adopt to take it over"),
+		},
+	}
+}
+
 func (t *ThreescaleReconciler) syncBackend(_ interface{}) error {
 	var (
 		err              error
@@ -79,6 +113,13 @@ func (t *ThreescaleReconciler) syncBackend(_ interface{}) error {
 	backendAPIEntity, exists := t.backendRemoteIndex.FindBySystemName(t.backendResource.Spec.SystemName)
 
 	if !exists {
+		// The backend is claimed before its creation.
+		// When the response or the status update are lost, the next reconciliation still owns it
+		err = controllerhelper.ClaimSystemName(t.Context(), t.Client(), t.backendResource, t.backendResource.Spec.SystemName)
+		if err != nil {
+			return fmt.Errorf("Error sync backend [%s]: claim: %w", t.backendResource.Spec.SystemName, err)
+		}
+
 		// Create backend using system_name.
 		// it cannot be modified later
 		params := threescaleapi.Params{
@@ -88,8 +129,23 @@ func (t *ThreescaleReconciler) syncBackend(_ interface{}) error {
 		}
 		backendAPIEntity, err = t.backendRemoteIndex.CreateBackendAPI(params)
 		if err != nil {
+			if controllerhelper.IsCreationRejected(err) {
+				releaseErr := controllerhelper.ReleaseSystemNameClaim(t.Context(), t.Client(), t.backendResource)
+				if releaseErr != nil {
+					t.logger.Error(releaseErr, "failed to release backend system name claim")
+				}
+			}
 			return fmt.Errorf("Error sync backend [%s]: %w", t.backendResource.Spec.SystemName, err)
 		}
+
+		// The ID is recorded before any other task runs. On failure, the claim keeps the ownership
+		err = controllerhelper.PatchStatus(t.Context(), t.Client(), t.backendResource, func() {
+			backendID := backendAPIEntity.ID()
+			t.backendResource.Status.ID = &backendID
+		})
+		if err != nil {
+			t.logger.Error(err, "failed to record backend ID", "ID", backendAPIEntity.ID())
+		}
 	}
 
 	// Will be used by coming steps
@@ -110,6 +166,10 @@ func (t *ThreescaleReconciler) syncBackend(_ interface{}) error {
 	}
 
 	if len(updatedParams) > 0 {
+		if t.drift.SkipCorrection(controllerhelper.DriftSectionBackend) {
+			return nil
+		}
+
 		err = t.backendAPIEntity.Update(updatedParams)
 		if err != nil {
 			return fmt.Errorf("Error sync backend [%s]: %w", t.backendResource.Spec.SystemName, err)
@@ -195,6 +255,10 @@ func (t *ThreescaleReconciler) syncMethods(_ interface{}) error {
 
 func (t *ThreescaleReconciler) createNewMethods(desiredNewMap map[string]capabilitiesv1beta1.MethodSpec) error {
 	for systemName, method := range desiredNewMap {
+		if t.drift.SkipCorrection(controllerhelper.DriftSectionMethods) {
+			continue
+		}
+
 		params := threescaleapi.Params{
 			"friendly_name": method.Name,
 			"system_name":   systemName,
@@ -212,6 +276,10 @@ func (t *ThreescaleReconciler) createNewMethods(desiredNewMap map[string]capabil
 
 func (t *ThreescaleReconciler) deleteNotDesiredMethodsFrom3scale(notDesiredMap map[string]threescaleapi.MethodItem) error {
 	for _, notDesiredMethod := range notDesiredMap {
+		if t.drift.SkipCorrection(controllerhelper.DriftSectionMethods) {
+			continue
+		}
+
 		err := t.backendAPIEntity.DeleteMethod(notDesiredMethod.ID)
 		if err != nil {
 			return err
@@ -222,16 +290,17 @@ func (t *ThreescaleReconciler) deleteNotDesiredMethodsFrom3scale(notDesiredMap m
 
 // valid for metrics and methods as long as 3scale ensures system_names are unique among methods and metrics
 func (t *ThreescaleReconciler) deleteExternalMetricReferences(notDesiredMetrics []string) error {
-	productList, err := controllerhelper.ProductList(t.backendResource.Namespace, t.Client(), t.providerAccount, t.logger)
+	// Products from other namespaces can reference current backend resource
+	productList, err := controllerhelper.ProductList("", t.Client(), t.providerAccount, t.logger)
 	if err != nil {
 		return fmt.Errorf("deleteExternalMetricReferences: %w", err)
 	}
 
 	// filter products referencing current backend resource
 	linkedProductList := make([]capabilitiesv1beta1.Product, 0)
-	for _, product := range productList {
-		if _, ok := product.Spec.BackendUsages[t.backendResource.Spec.SystemName]; ok {
-			linkedProductList = append(linkedProductList, product)
+	for idx := range productList {
+		if productList[idx].UsesBackend(t.backendResource) {
+			linkedProductList = append(linkedProductList, productList[idx])
 		}
 	}
 
@@ -316,6 +385,10 @@ func (t *ThreescaleReconciler) reconcileMatchedMethods(matchedMap map[string]met
 		}
 
 		if len(params) > 0 {
+			if t.drift.SkipCorrection(controllerhelper.DriftSectionMethods) {
+				continue
+			}
+
 			err := t.backendAPIEntity.UpdateMethod(data.item.ID, params)
 			if err != nil {
 				return fmt.Errorf("Error reconcile backendAPI methods: %w", err)
@@ -405,6 +478,10 @@ func (t *ThreescaleReconciler) syncMetrics(_ interface{}) error {
 
 func (t *ThreescaleReconciler) createNewMetrics(desiredNewMap map[string]capabilitiesv1beta1.MetricSpec) error {
 	for systemName, metric := range desiredNewMap {
+		if t.drift.SkipCorrection(controllerhelper.DriftSectionMetrics) {
+			continue
+		}
+
 		params := threescaleapi.Params{
 			"friendly_name": metric.Name,
 			"unit":          metric.Unit,
@@ -423,6 +500,10 @@ func (t *ThreescaleReconciler) createNewMetrics(desiredNewMap map[string]capabil
 
 func (t *ThreescaleReconciler) deleteNotDesiredMetricsFrom3scale(notDesiredMap map[string]threescaleapi.MetricItem) error {
 	for _, metric := range notDesiredMap {
+		if t.drift.SkipCorrection(controllerhelper.DriftSectionMetrics) {
+			continue
+		}
+
 		err := t.backendAPIEntity.DeleteMetric(metric.ID)
 		if err != nil {
 			return err
@@ -447,6 +528,10 @@ func (t *ThreescaleReconciler) reconcileMatchedMetrics(matchedMap map[string]met
 		}
 
 		if len(params) > 0 {
+			if t.drift.SkipCorrection(controllerhelper.DriftSectionMetrics) {
+				continue
+			}
+
 			err := t.backendAPIEntity.UpdateMetric(data.item.ID, params)
 			if err != nil {
 				return fmt.Errorf("Error updating backendAPI metric: %w", err)
@@ -550,6 +635,10 @@ func (t *ThreescaleReconciler) syncMappingRules(_ interface{}) error {
 
 func (t *ThreescaleReconciler) processNotDesiredMappingRules(notDesiredList []threescaleapi.MappingRuleItem) error {
 	for _, mappingRule := range notDesiredList {
+		if t.drift.SkipCorrection(controllerhelper.DriftSectionMappingRules) {
+			continue
+		}
+
 		err := t.backendAPIEntity.DeleteMappingRule(mappingRule.ID)
 		if err != nil {
 			return err
@@ -619,6 +708,10 @@ func (t *ThreescaleReconciler) reconcileMappingRuleWithPosition(desired capabili
 	}
 
 	if len(params) > 0 {
+		if t.drift.SkipCorrection(controllerhelper.DriftSectionMappingRules) {
+			return nil
+		}
+
 		err := t.backendAPIEntity.UpdateMappingRule(existing.ID, params)
 		if err != nil {
 			return fmt.Errorf("Error reconcile backend mapping rule: %w", err)
@@ -629,6 +722,10 @@ func (t *ThreescaleReconciler) reconcileMappingRuleWithPosition(desired capabili
 }
 
 func (t *ThreescaleReconciler) createNewMappingRuleWithPosition(desired capabilitiesv1beta1.MappingRuleSpec, desiredPosition int) error {
+	if t.drift.SkipCorrection(controllerhelper.DriftSectionMappingRules) {
+		return nil
+	}
+
 	metricID, err := t.backendAPIEntity.FindMethodMetricIDBySystemName(desired.MetricMethodRef)
 	if err != nil {
 		return fmt.Errorf("Error creating backend [%s] mappingrule: %w", t.backendResource.Spec.SystemName, err)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
 	"github.com/3scale/3scale-operator/pkg/common"
@@ -12,11 +13,13 @@ import (
 	"github.com/3scale/3scale-operator/pkg/reconcilers"
 	"github.com/3scale/3scale-operator/version"
 
+	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/discovery"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -66,6 +69,7 @@ func newReconciler(mgr manager.Manager) (reconcile.Reconciler, error) {
 	recorder := mgr.GetEventRecorderFor(controllerName)
 	return &ReconcileBackend{
 		BaseReconciler: reconcilers.NewBaseReconciler(client, scheme, apiClientReader, ctx, log, discoveryClient, recorder),
+		resyncPeriod:   controllerhelper.ResyncPeriod(controllerhelper.BACKEND_RESYNC_PERIOD_ENVVAR, log),
 	}, nil
 }
 
@@ -92,6 +96,9 @@ var _ reconcile.Reconciler = &ReconcileBackend{}
 // ReconcileBackend reconciles a Backend object
 type ReconcileBackend struct {
 	*reconcilers.BaseReconciler
+	// resyncPeriod is the period synchronized backends are reconciled again to detect 3scale drift.
+	// Zero disables periodic resync
+	resyncPeriod time.Duration
 }
 
 // Reconcile reads that state of the cluster for a Backend object and makes changes based on the state read
@@ -108,6 +115,7 @@ func (r *ReconcileBackend) Reconcile(request reconcile.Request) (reconcile.Resul
 			// Request object not found, could have been deleted after reconcile request.
 			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
 			// Return and don't requeue
+			controllerhelper.DeleteResourceSynced(capabilitiesv1beta1.BackendKind, request.NamespacedName)
 			reqLogger.Info("resource not found. Ignoring since object must have been deleted")
 			return reconcile.Result{}, nil
 		}
@@ -123,12 +131,37 @@ func (r *ReconcileBackend) Reconcile(request reconcile.Request) (reconcile.Resul
 		reqLogger.V(1).Info(string(jsonData))
 	}
 
+	if backend.GetDeletionTimestamp() != nil && helper.ArrayContains(backend.GetFinalizers(), capabilitiesv1beta1.BackendFinalizer) {
+		return r.reconcileDeletion(backend, reqLogger)
+	}
+
 	// Ignore deleted Backends, this can happen when foregroundDeletion is enabled
 	// https://kubernetes.io/docs/concepts/workloads/controllers/garbage-collection/#foreground-cascading-deletion
-	if backend.DeletionTimestamp != nil {
+	if backend.GetDeletionTimestamp() != nil {
 		return reconcile.Result{}, nil
 	}
 
+	if !helper.ArrayContains(backend.GetFinalizers(), capabilitiesv1beta1.BackendFinalizer) {
+		controllerutil.AddFinalizer(backend, capabilitiesv1beta1.BackendFinalizer)
+		// Resources reconciled before the finalizer was introduced, whose ID was never recorded,
+		// keep managing the 3scale backend with their system name, as those operator versions did
+		if backend.Status.ID == nil && len(backend.Status.Conditions) > 0 {
+			annotations := backend.GetAnnotations()
+			if annotations == nil {
+				annotations = map[string]string{}
+			}
+			annotations[capabilitiesv1beta1.ClaimedSystemNameAnnotation] = backend.Spec.SystemName
+			backend.SetAnnotations(annotations)
+		}
+		err := r.Client().Update(r.Context(), backend)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("Failed adding backend finalizer: %w", err)
+		}
+
+		reqLogger.Info("resource finalizer added. Requeueing.")
+		return reconcile.Result{Requeue: true}, nil
+	}
+
 	if backend.SetDefaults(reqLogger) {
 		err := r.Client().Update(r.Context(), backend)
 		if err != nil {
@@ -161,14 +194,142 @@ func (r *ReconcileBackend) Reconcile(request reconcile.Request) (reconcile.Resul
 			return reconcile.Result{}, nil
 		}
 
+		if controllerhelper.IsThreescaleUnavailable(reconcileErr) {
+			// 3scale is expected to recover, retry once the circuit breaker lets requests through again
+			reqLogger.Info("ERROR", "3scale unavailable", reconcileErr)
+			r.EventRecorder().Eventf(backend, corev1.EventTypeWarning, "Unavailable", "%v", reconcileErr)
+			return reconcile.Result{RequeueAfter: controllerhelper.ThreescaleUnavailableRequeueDelay}, nil
+		}
+
 		reqLogger.Error(reconcileErr, "Failed to reconcile")
 		r.EventRecorder().Eventf(backend, corev1.EventTypeWarning, "ReconcileError", "%v", reconcileErr)
 	}
 
 	reqLogger.Info("END", "error", reconcileErr)
+	if reconcileErr == nil && r.resyncPeriod > 0 {
+		return reconcile.Result{RequeueAfter: r.resyncPeriod}, nil
+	}
 	return reconcile.Result{}, reconcileErr
 }
 
+// reconcileDeletion removes the 3scale backend, unless orphan on delete is requested,
+// and then removes the finalizer to let the resource be deleted.
+// Deletion is blocked while the backend is used by any product.
+func (r *ReconcileBackend) reconcileDeletion(backend *capabilitiesv1beta1.Backend, reqLogger logr.Logger) (reconcile.Result, error) {
+	if backend.OrphanOnDelete() {
+		reqLogger.Info("orphan on delete annotation found. 3scale backend will not be deleted")
+	} else {
+		err := r.delete3scaleBackend(backend)
+		if err != nil {
+			reqLogger.Error(err, "Failed to delete 3scale backend")
+			r.EventRecorder().Eventf(backend, corev1.EventTypeWarning, "DeleteError", "%v", err)
+			statusReconciler := NewStatusReconciler(r.BaseReconciler, backend, nil, backend.Status.ProviderAccountHost, err)
+			_, statusUpdateErr := statusReconciler.Reconcile()
+			if statusUpdateErr != nil {
+				return reconcile.Result{}, fmt.Errorf("Failed to delete 3scale backend: %v. Failed to update backend status: %w", err, statusUpdateErr)
+			}
+			return reconcile.Result{}, err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(backend, capabilitiesv1beta1.BackendFinalizer)
+	err := r.Client().Update(r.Context(), backend)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("Failed removing backend finalizer: %w", err)
+	}
+
+	reqLogger.Info("resource finalizer removed")
+	return reconcile.Result{}, nil
+}
+
+func (r *ReconcileBackend) delete3scaleBackend(backend *capabilitiesv1beta1.Backend) error {
+	logger := r.Logger().WithValues("backend", backend.Name)
+
+	providerAccount, err := controllerhelper.LookupProviderAccount(r.Client(), backend.Namespace, backend.Spec.ProviderAccountRef, logger)
+	if controllerhelper.IsProviderAccountNotFound(err) {
+		// The credentials are gone, i.e. the namespace is being deleted.
+		// The 3scale backend is orphaned instead of blocking the resource deletion forever
+		logger.Info("provider account not found. 3scale backend will not be deleted", "error", err.Error())
+		r.EventRecorder().Eventf(backend, corev1.EventTypeWarning, "Orphaned", "3scale backend [%s] not deleted: %v", backend.Spec.SystemName, err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	threescaleAPIClient, err := controllerhelper.PortaClient(providerAccount)
+	if err != nil {
+		return err
+	}
+
+	backendRemoteIndex, err := controllerhelper.NewBackendAPIRemoteIndex(threescaleAPIClient, logger)
+	if err != nil {
+		return err
+	}
+
+	backendAPIEntity, exists := backendRemoteIndex.FindBySystemName(backend.Spec.SystemName)
+	if !exists {
+		// backend not found in 3scale, nothing to delete
+		logger.Info("3scale backend not found. Nothing to delete")
+		return nil
+	}
+
+	// 3scale backends not created nor adopted by the resource are never deleted
+	if !backend.Owns(backendAPIEntity.ID()) {
+		logger.Info("3scale backend not managed by the resource. Nothing to delete")
+		return nil
+	}
+
+	linkedProducts, err := r.linkedProducts(backend, providerAccount)
+	if err != nil {
+		return err
+	}
+
+	if len(linkedProducts) > 0 {
+		return fmt.Errorf("backend [%s] cannot be deleted: used by products %v", backend.Spec.SystemName, linkedProducts)
+	}
+
+	return backendAPIEntity.Delete()
+}
+
+// linkedProducts returns the namespace/name of the product resources from the same provider account
+// listing the backend in the backend usages, including products from other namespaces.
+// Not synchronized products are included as well.
+// Products whose provider account cannot be resolved are skipped, they do not block the backend deletion.
+func (r *ReconcileBackend) linkedProducts(backend *capabilitiesv1beta1.Backend, providerAccount *controllerhelper.ProviderAccount) ([]string, error) {
+	logger := r.Logger().WithValues("backend", backend.Name)
+
+	// Products of all the watched namespaces
+	productList := &capabilitiesv1beta1.ProductList{}
+	err := r.Client().List(r.Context(), productList)
+	if err != nil {
+		return nil, fmt.Errorf("linkedProducts: %w", err)
+	}
+
+	linkedProducts := make([]string, 0)
+	for idx := range productList.Items {
+		if !productList.Items[idx].UsesBackend(backend) {
+			continue
+		}
+
+		productProviderAccount, err := controllerhelper.LookupProviderAccount(r.Client(), productList.Items[idx].Namespace, productList.Items[idx].Spec.ProviderAccountRef, logger)
+		if err != nil {
+			// i.e. the product namespace is being deleted
+			logger.Info("product provider account not found. Product skipped", "product", productList.Items[idx].Namespace+"/"+productList.Items[idx].Name, "error", err.Error())
+			continue
+		}
+
+		// Filter by provider account
+		if providerAccount.AdminURLStr != productProviderAccount.AdminURLStr {
+			continue
+		}
+
+		linkedProducts = append(linkedProducts, productList.Items[idx].Namespace+"/"+productList.Items[idx].Name)
+	}
+
+	return linkedProducts, nil
+}
+
 func (r *ReconcileBackend) reconcile(backendResource *capabilitiesv1beta1.Backend) (*StatusReconciler, error) {
 	logger := r.Logger().WithValues("backend", backendResource.Name)
 
@@ -199,6 +360,7 @@ func (r *ReconcileBackend) reconcile(backendResource *capabilitiesv1beta1.Backen
 	reconciler := NewThreescaleReconciler(r.BaseReconciler, backendResource, threescaleAPIClient, backendRemoteIndex, providerAccount)
 	backendAPIEntity, err := reconciler.Reconcile()
 	statusReconciler := NewStatusReconciler(r.BaseReconciler, backendResource, backendAPIEntity, providerAccount.AdminURLStr, err)
+	statusReconciler.driftedSections = reconciler.DriftedSections()
 	return statusReconciler, err
 }
 
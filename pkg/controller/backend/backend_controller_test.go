@@ -0,0 +1,100 @@
+package backend
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestDelete3scaleBackendWithoutProviderAccount(t *testing.T) {
+	s := scheme.Scheme
+	if err := capabilitiesv1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	// The provider account secret has already been deleted, i.e. the namespace is being deleted
+	backend := &capabilitiesv1beta1.Backend{
+		ObjectMeta: metav1.ObjectMeta{Name: "backend1", Namespace: "test"},
+		Spec: capabilitiesv1beta1.BackendSpec{
+			Name:               "Backend 1",
+			SystemName:         "backend1",
+			PrivateBaseURL:     "https://api.example.com",
+			ProviderAccountRef: &capabilitiesv1beta1.ProviderAccountReference{Name: "tenant-secret"},
+		},
+	}
+
+	k8sClient := fake.NewFakeClientWithScheme(s, backend)
+	recorder := record.NewFakeRecorder(10)
+	r := &ReconcileBackend{
+		BaseReconciler: reconcilers.NewBaseReconciler(k8sClient, s, k8sClient, context.TODO(), logf.Log.WithName("test"), nil, recorder),
+	}
+
+	err := r.delete3scaleBackend(backend)
+	if err != nil {
+		t.Fatalf("expected 3scale backend to be orphaned, got %v", err)
+	}
+
+	event := <-recorder.Events
+	if !strings.Contains(event, "Warning Orphaned") {
+		t.Fatalf("expected Orphaned warning event, got %s", event)
+	}
+}
+
+func TestLinkedProducts(t *testing.T) {
+	s := scheme.Scheme
+	if err := capabilitiesv1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	backend := &capabilitiesv1beta1.Backend{
+		ObjectMeta: metav1.ObjectMeta{Name: "backend1", Namespace: "test"},
+		Spec:       capabilitiesv1beta1.BackendSpec{SystemName: "backend1"},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant-secret", Namespace: "test"},
+		Data:       map[string][]byte{"adminURL": []byte("https://3scale-admin.example.com"), "token": []byte("token")},
+	}
+	product := &capabilitiesv1beta1.Product{
+		ObjectMeta: metav1.ObjectMeta{Name: "product1", Namespace: "test"},
+		Spec: capabilitiesv1beta1.ProductSpec{
+			SystemName:         "product1",
+			BackendUsages:      map[string]capabilitiesv1beta1.BackendUsageSpec{"backend1": {Path: "/"}},
+			ProviderAccountRef: &capabilitiesv1beta1.ProviderAccountReference{Name: "tenant-secret"},
+		},
+	}
+	// The provider account secret of the other namespace has already been deleted
+	deletedProduct := &capabilitiesv1beta1.Product{
+		ObjectMeta: metav1.ObjectMeta{Name: "product2", Namespace: "other"},
+		Spec: capabilitiesv1beta1.ProductSpec{
+			SystemName:         "product2",
+			BackendUsages:      map[string]capabilitiesv1beta1.BackendUsageSpec{"backend1": {Path: "/", Namespace: "test"}},
+			ProviderAccountRef: &capabilitiesv1beta1.ProviderAccountReference{Name: "tenant-secret"},
+		},
+	}
+
+	k8sClient := fake.NewFakeClientWithScheme(s, backend, secret, product, deletedProduct)
+	r := &ReconcileBackend{
+		BaseReconciler: reconcilers.NewBaseReconciler(k8sClient, s, k8sClient, context.TODO(), logf.Log.WithName("test"), nil, record.NewFakeRecorder(10)),
+	}
+
+	providerAccount := &controllerhelper.ProviderAccount{AdminURLStr: "https://3scale-admin.example.com", Token: "token"}
+	linkedProducts, err := r.linkedProducts(backend, providerAccount)
+	if err != nil {
+		t.Fatalf("products without provider account should be skipped, got %v", err)
+	}
+
+	if len(linkedProducts) != 1 || linkedProducts[0] != "test/product1" {
+		t.Fatalf("expected [test/product1] linked products, got %v", linkedProducts)
+	}
+}
@@ -2,6 +2,7 @@ package backend
 
 import (
 	"fmt"
+	"strings"
 
 	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
 	"github.com/3scale/3scale-operator/pkg/common"
@@ -21,6 +22,7 @@ type StatusReconciler struct {
 	backendAPIEntity    *controllerhelper.BackendAPIEntity
 	providerAccountHost string
 	syncError           error
+	driftedSections     []string
 	logger              logr.Logger
 }
 
@@ -39,6 +41,7 @@ func (s *StatusReconciler) Reconcile() (reconcile.Result, error) {
 	s.logger.V(1).Info("START")
 
 	newStatus := s.calculateStatus()
+	controllerhelper.SetResourceSynced(capabilitiesv1beta1.BackendKind, s.backendResource, newStatus.Conditions.IsTrueFor(capabilitiesv1beta1.BackendSyncedConditionType))
 
 	equalStatus := s.backendResource.Status.Equals(newStatus, s.logger)
 	s.logger.V(1).Info("Status", "status is different", !equalStatus)
@@ -73,6 +76,8 @@ func (s *StatusReconciler) Reconcile() (reconcile.Result, error) {
 
 func (s *StatusReconciler) calculateStatus() *capabilitiesv1beta1.BackendStatus {
 	newStatus := &capabilitiesv1beta1.BackendStatus{}
+	// The ID of the managed 3scale backend is kept when unknown. It is the ownership marker of the resource
+	newStatus.ID = s.backendResource.Status.ID
 	if s.backendAPIEntity != nil {
 		tmp := s.backendAPIEntity.ID()
 		newStatus.ID = &tmp
@@ -86,6 +91,11 @@ func (s *StatusReconciler) calculateStatus() *capabilitiesv1beta1.BackendStatus
 	newStatus.Conditions.SetCondition(s.syncCondition())
 	newStatus.Conditions.SetCondition(s.invalidCondition())
 	newStatus.Conditions.SetCondition(s.failedCondition())
+	newStatus.Conditions.SetCondition(s.unavailableCondition())
+	if s.syncError == nil {
+		// drift is only known when the sync process completed
+		newStatus.Conditions.SetCondition(s.outOfSyncCondition())
+	}
 
 	return newStatus
 }
@@ -124,10 +134,45 @@ func (s *StatusReconciler) failedCondition() common.Condition {
 	}
 
 	// This condition could be activated together with other conditions
-	if s.syncError != nil {
+	if s.syncError != nil && !controllerhelper.IsThreescaleUnavailable(s.syncError) {
+		condition.Status = corev1.ConditionTrue
+		condition.Message = s.syncError.Error()
+	}
+
+	return condition
+}
+
+func (s *StatusReconciler) unavailableCondition() common.Condition {
+	condition := common.Condition{
+		Type:   capabilitiesv1beta1.BackendUnavailableConditionType,
+		Status: corev1.ConditionFalse,
+	}
+
+	if controllerhelper.IsThreescaleUnavailable(s.syncError) {
 		condition.Status = corev1.ConditionTrue
 		condition.Message = s.syncError.Error()
 	}
 
 	return condition
 }
+
+func (s *StatusReconciler) outOfSyncCondition() common.Condition {
+	condition := common.Condition{
+		Type:   capabilitiesv1beta1.BackendOutOfSyncConditionType,
+		Status: corev1.ConditionFalse,
+	}
+
+	if len(s.driftedSections) == 0 {
+		return condition
+	}
+
+	sections := strings.Join(s.driftedSections, ", ")
+	if s.backendResource.Spec.ReportDriftOnly() {
+		condition.Status = corev1.ConditionTrue
+		condition.Message = fmt.Sprintf("3scale backend differs from the spec in sections: %s", sections)
+	} else {
+		condition.Message = fmt.Sprintf("3scale backend differed from the spec and it has been corrected in sections: %s", sections)
+	}
+
+	return condition
+}
@@ -0,0 +1,133 @@
+package custompolicydefinition
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+type ThreescaleReconciler struct {
+	*reconcilers.BaseReconciler
+	resource            *capabilitiesv1beta1.CustomPolicyDefinition
+	threescaleAPIClient *controllerhelper.ThreescaleAPIClient
+	logger              logr.Logger
+}
+
+func NewThreescaleReconciler(b *reconcilers.BaseReconciler, resource *capabilitiesv1beta1.CustomPolicyDefinition, threescaleAPIClient *controllerhelper.ThreescaleAPIClient) *ThreescaleReconciler {
+	return &ThreescaleReconciler{
+		BaseReconciler:      b,
+		resource:            resource,
+		threescaleAPIClient: threescaleAPIClient,
+		logger:              b.Logger().WithValues("3scale Reconciler", resource.Name),
+	}
+}
+
+func (t *ThreescaleReconciler) Reconcile() (*controllerhelper.RegistryPolicy, error) {
+	remotePolicy, err := findRegistryPolicy(t.threescaleAPIClient, t.resource.Spec.Name, t.resource.Spec.Version)
+	if err != nil {
+		return nil, fmt.Errorf("Error sync custom policy [%s:%s]: %w", t.resource.Spec.Name, t.resource.Spec.Version, err)
+	}
+
+	if remotePolicy == nil {
+		// The custom policy is claimed before its registration.
+		// When the response or the status update are lost, the next reconciliation still owns it
+		err = controllerhelper.Claim(t.Context(), t.Client(), t.resource, capabilitiesv1beta1.ClaimedPolicyAnnotation, t.resource.NameVersion())
+		if err != nil {
+			return nil, fmt.Errorf("Error sync custom policy [%s:%s]: claim: %w", t.resource.Spec.Name, t.resource.Spec.Version, err)
+		}
+
+		// Name and version identify the policy,
+		// they cannot be modified later
+		params := threescaleapi.Params{
+			"name":    t.resource.Spec.Name,
+			"version": t.resource.Spec.Version,
+			"schema":  string(t.resource.Spec.Schema.Raw),
+		}
+		remotePolicy, err = t.threescaleAPIClient.CreateRegistryPolicy(params)
+		if err != nil {
+			if controllerhelper.IsCreationRejected(err) {
+				releaseErr := controllerhelper.ReleaseClaim(t.Context(), t.Client(), t.resource, capabilitiesv1beta1.ClaimedPolicyAnnotation)
+				if releaseErr != nil {
+					t.logger.Error(releaseErr, "failed to release custom policy claim")
+				}
+			}
+			return nil, fmt.Errorf("Error sync custom policy [%s:%s]: %w", t.resource.Spec.Name, t.resource.Spec.Version, err)
+		}
+
+		// The ID is recorded right away. On failure, the claim keeps the ownership
+		err = controllerhelper.PatchStatus(t.Context(), t.Client(), t.resource, func() {
+			policyID := remotePolicy.Element.ID
+			t.resource.Status.ID = &policyID
+		})
+		if err != nil {
+			t.logger.Error(err, "failed to record custom policy ID", "ID", remotePolicy.Element.ID)
+		}
+
+		return remotePolicy, nil
+	}
+
+	if !t.resource.Spec.Adopt && !t.resource.Owns(remotePolicy.Element.ID) {
+		// Existing custom policies not registered by this resource are only taken over on explicit adoption
+		return nil, &helper.SpecFieldError{
+			ErrorType: helper.InvalidError,
+			FieldErrorList: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("name"), t.resource.NameVersion(),
+					"custom policy already exists in the 3scale policy registry and it is not managed by this resource. Set spec.adopt to take it over"),
+			},
+		}
+	}
+
+	if equalJSONDocuments(remotePolicy.Element.Schema, t.resource.Spec.Schema.Raw) {
+		return remotePolicy, nil
+	}
+
+	t.logger.V(1).Info("update custom policy schema")
+	params := threescaleapi.Params{
+		"schema": string(t.resource.Spec.Schema.Raw),
+	}
+	updatedPolicy, err := t.threescaleAPIClient.UpdateRegistryPolicy(remotePolicy.Element.ID, params)
+	if err != nil {
+		return nil, fmt.Errorf("Error sync custom policy [%s:%s]: %w", t.resource.Spec.Name, t.resource.Spec.Version, err)
+	}
+
+	return updatedPolicy, nil
+}
+
+func findRegistryPolicy(threescaleAPIClient *controllerhelper.ThreescaleAPIClient, name, version string) (*controllerhelper.RegistryPolicy, error) {
+	policyList, err := threescaleAPIClient.ListRegistryPolicies()
+	if err != nil {
+		return nil, err
+	}
+
+	for idx := range policyList.Policies {
+		item := policyList.Policies[idx].Element
+		if item.Name == name && item.Version == version {
+			return &policyList.Policies[idx], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// equalJSONDocuments compares JSON documents ignoring formatting and key order
+func equalJSONDocuments(existing, desired []byte) bool {
+	var existingObj, desiredObj interface{}
+	if err := json.Unmarshal(existing, &existingObj); err != nil {
+		return false
+	}
+
+	if err := json.Unmarshal(desired, &desiredObj); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(existingObj, desiredObj)
+}
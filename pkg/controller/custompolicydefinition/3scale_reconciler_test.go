@@ -0,0 +1,143 @@
+package custompolicydefinition
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const testPolicySchema = `{"name":"Example","summary":"Example","version":"0.1","configuration":{"type":"object"}}`
+
+// fakeRegistryAPI serves the 3scale policy registry list and creation endpoints
+type fakeRegistryAPI struct {
+	mu       sync.Mutex
+	policies []controllerhelper.RegistryPolicy
+}
+
+func (f *fakeRegistryAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if r.URL.Path != "/admin/api/registry/policies.json" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if r.Method == http.MethodGet {
+		json.NewEncoder(w).Encode(controllerhelper.RegistryPolicyList{Policies: f.policies})
+		return
+	}
+
+	r.ParseForm()
+	policy := controllerhelper.RegistryPolicy{Element: controllerhelper.RegistryPolicyItem{
+		ID:      int64(len(f.policies) + 1),
+		Name:    r.PostForm.Get("name"),
+		Version: r.PostForm.Get("version"),
+		Schema:  json.RawMessage(r.PostForm.Get("schema")),
+	}}
+	f.policies = append(f.policies, policy)
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(policy)
+}
+
+func newTestCustomPolicyClient(t *testing.T, adopt bool) (client.Client, types.NamespacedName) {
+	customPolicy := &capabilitiesv1beta1.CustomPolicyDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy1", Namespace: "test"},
+		Spec: capabilitiesv1beta1.CustomPolicyDefinitionSpec{
+			Name:    "example",
+			Version: "0.1",
+			Schema:  runtime.RawExtension{Raw: []byte(testPolicySchema)},
+			Adopt:   adopt,
+		},
+	}
+
+	s := runtime.NewScheme()
+	if err := capabilitiesv1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	return fake.NewFakeClientWithScheme(s, customPolicy), types.NamespacedName{Name: "policy1", Namespace: "test"}
+}
+
+func newTestCustomPolicyReconciler(t *testing.T, k8sClient client.Client, nn types.NamespacedName, srv *httptest.Server) *ThreescaleReconciler {
+	customPolicy := &capabilitiesv1beta1.CustomPolicyDefinition{}
+	if err := k8sClient.Get(context.TODO(), nn, customPolicy); err != nil {
+		t.Fatal(err)
+	}
+
+	threescaleAPIClient, err := controllerhelper.PortaClientFromURLString(srv.URL, "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	baseReconciler := reconcilers.NewBaseReconciler(k8sClient, nil, k8sClient, context.TODO(), logf.Log.WithName("test"), nil, record.NewFakeRecorder(10))
+	return NewThreescaleReconciler(baseReconciler, customPolicy, threescaleAPIClient)
+}
+
+func TestReconcile3scaleCustomPolicyNotOwned(t *testing.T) {
+	api := &fakeRegistryAPI{policies: []controllerhelper.RegistryPolicy{
+		{Element: controllerhelper.RegistryPolicyItem{ID: 1, Name: "example", Version: "0.1", Schema: json.RawMessage(`{}`)}},
+	}}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+
+	k8sClient, nn := newTestCustomPolicyClient(t, false)
+
+	_, err := newTestCustomPolicyReconciler(t, k8sClient, nn, srv).Reconcile()
+	if !helper.IsInvalidSpecError(err) {
+		t.Fatalf("expected invalid spec error for a custom policy not registered by the resource, got %v", err)
+	}
+}
+
+func TestReconcile3scaleCustomPolicyLostStatus(t *testing.T) {
+	api := &fakeRegistryAPI{}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+
+	k8sClient, nn := newTestCustomPolicyClient(t, false)
+
+	created, err := newTestCustomPolicyReconciler(t, k8sClient, nn, srv).Reconcile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	customPolicy := &capabilitiesv1beta1.CustomPolicyDefinition{}
+	if err := k8sClient.Get(context.TODO(), nn, customPolicy); err != nil {
+		t.Fatal(err)
+	}
+	if customPolicy.Status.ID == nil || *customPolicy.Status.ID != created.Element.ID {
+		t.Fatalf("expected custom policy ID %d to be recorded, got %v", created.Element.ID, customPolicy.Status.ID)
+	}
+
+	// A lost status update discards the recorded ID
+	customPolicy.Status.ID = nil
+	if err := k8sClient.Update(context.TODO(), customPolicy); err != nil {
+		t.Fatal(err)
+	}
+
+	same, err := newTestCustomPolicyReconciler(t, k8sClient, nn, srv).Reconcile()
+	if err != nil {
+		t.Fatalf("registered custom policy should still be owned after losing the status, got %v", err)
+	}
+
+	if same.Element.ID != created.Element.ID || len(api.policies) != 1 {
+		t.Fatalf("expected custom policy %d to be reused, got %d and %d policies", created.Element.ID, same.Element.ID, len(api.policies))
+	}
+}
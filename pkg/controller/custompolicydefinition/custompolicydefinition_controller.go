@@ -0,0 +1,323 @@
+package custompolicydefinition
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	"github.com/3scale/3scale-operator/pkg/common"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+	"github.com/3scale/3scale-operator/version"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+var (
+	// controllerName is the name of this controller
+	controllerName = "controller_custompolicydefinition"
+
+	// package level logger
+	log = logf.Log.WithName(controllerName)
+)
+
+// Add creates a new CustomPolicyDefinition Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	reconciler, err := newReconciler(mgr)
+	if err != nil {
+		return err
+	}
+
+	return add(mgr, reconciler)
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager) (reconcile.Reconciler, error) {
+	apiClientReader, err := common.NewAPIClientReader(mgr)
+	if err != nil {
+		return nil, err
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	client := mgr.GetClient()
+	scheme := mgr.GetScheme()
+	ctx := context.TODO()
+	recorder := mgr.GetEventRecorderFor(controllerName)
+	return &ReconcileCustomPolicyDefinition{
+		BaseReconciler: reconcilers.NewBaseReconciler(client, scheme, apiClientReader, ctx, log, discoveryClient, recorder),
+	}, nil
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New("custompolicydefinition-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to primary resource CustomPolicyDefinition
+	err = c.Watch(&source.Kind{Type: &capabilitiesv1beta1.CustomPolicyDefinition{}}, &handler.EnqueueRequestForObject{})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// blank assignment to verify that ReconcileCustomPolicyDefinition implements reconcile.Reconciler
+var _ reconcile.Reconciler = &ReconcileCustomPolicyDefinition{}
+
+// ReconcileCustomPolicyDefinition reconciles a CustomPolicyDefinition object
+type ReconcileCustomPolicyDefinition struct {
+	*reconcilers.BaseReconciler
+}
+
+// Reconcile reads that state of the cluster for a CustomPolicyDefinition object and makes changes based on the state read
+// and what is in the CustomPolicyDefinition.Spec
+func (r *ReconcileCustomPolicyDefinition) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := r.Logger().WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconcile CustomPolicyDefinition", "Operator version", version.Version)
+
+	// Fetch the CustomPolicyDefinition instance
+	customPolicy := &capabilitiesv1beta1.CustomPolicyDefinition{}
+	err := r.Client().Get(r.Context(), request.NamespacedName, customPolicy)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Return and don't requeue
+			controllerhelper.DeleteResourceSynced(capabilitiesv1beta1.CustomPolicyDefinitionKind, request.NamespacedName)
+			reqLogger.Info("resource not found. Ignoring since object must have been deleted")
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	if reqLogger.V(1).Enabled() {
+		jsonData, err := json.MarshalIndent(customPolicy, "", "  ")
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		reqLogger.V(1).Info(string(jsonData))
+	}
+
+	if customPolicy.GetDeletionTimestamp() != nil && helper.ArrayContains(customPolicy.GetFinalizers(), capabilitiesv1beta1.CustomPolicyDefinitionFinalizer) {
+		return r.reconcileDeletion(customPolicy, reqLogger)
+	}
+
+	// Ignore deleted CustomPolicyDefinitions, this can happen when foregroundDeletion is enabled
+	// https://kubernetes.io/docs/concepts/workloads/controllers/garbage-collection/#foreground-cascading-deletion
+	if customPolicy.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	if !helper.ArrayContains(customPolicy.GetFinalizers(), capabilitiesv1beta1.CustomPolicyDefinitionFinalizer) {
+		controllerutil.AddFinalizer(customPolicy, capabilitiesv1beta1.CustomPolicyDefinitionFinalizer)
+		err := r.Client().Update(r.Context(), customPolicy)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("Failed adding custom policy definition finalizer: %w", err)
+		}
+
+		reqLogger.Info("resource finalizer added. Requeueing.")
+		return reconcile.Result{Requeue: true}, nil
+	}
+
+	statusReconciler, reconcileErr := r.reconcile(customPolicy)
+	statusResult, statusUpdateErr := statusReconciler.Reconcile()
+	if statusUpdateErr != nil {
+		if reconcileErr != nil {
+			return reconcile.Result{}, fmt.Errorf("Failed to sync custom policy definition: %v. Failed to update custom policy definition status: %w", reconcileErr, statusUpdateErr)
+		}
+
+		return reconcile.Result{}, fmt.Errorf("Failed to update custom policy definition status: %w", statusUpdateErr)
+	}
+
+	if statusResult.Requeue {
+		return statusResult, nil
+	}
+
+	if reconcileErr != nil {
+		if helper.IsInvalidSpecError(reconcileErr) {
+			// On Validation error, no need to retry as spec is not valid and needs to be changed
+			reqLogger.Info("ERROR", "spec validation error", reconcileErr)
+			r.EventRecorder().Eventf(customPolicy, corev1.EventTypeWarning, "Invalid CustomPolicyDefinition Spec", "%v", reconcileErr)
+			return reconcile.Result{}, nil
+		}
+
+		if controllerhelper.IsThreescaleUnavailable(reconcileErr) {
+			// 3scale is expected to recover, retry once the circuit breaker lets requests through again
+			reqLogger.Info("ERROR", "3scale unavailable", reconcileErr)
+			r.EventRecorder().Eventf(customPolicy, corev1.EventTypeWarning, "Unavailable", "%v", reconcileErr)
+			return reconcile.Result{RequeueAfter: controllerhelper.ThreescaleUnavailableRequeueDelay}, nil
+		}
+
+		reqLogger.Error(reconcileErr, "Failed to reconcile")
+		r.EventRecorder().Eventf(customPolicy, corev1.EventTypeWarning, "ReconcileError", "%v", reconcileErr)
+	}
+
+	reqLogger.Info("END", "error", reconcileErr)
+	return reconcile.Result{}, reconcileErr
+}
+
+// reconcileDeletion removes the custom policy from the 3scale policy registry, unless orphan on delete is requested,
+// and then removes the finalizer to let the resource be deleted
+func (r *ReconcileCustomPolicyDefinition) reconcileDeletion(customPolicy *capabilitiesv1beta1.CustomPolicyDefinition, reqLogger logr.Logger) (reconcile.Result, error) {
+	if customPolicy.OrphanOnDelete() {
+		reqLogger.Info("orphan on delete annotation found. custom policy will not be removed from the 3scale policy registry")
+	} else {
+		err := r.delete3scaleCustomPolicy(customPolicy)
+		if err != nil {
+			reqLogger.Error(err, "Failed to delete 3scale custom policy")
+			r.EventRecorder().Eventf(customPolicy, corev1.EventTypeWarning, "DeleteError", "%v", err)
+			statusReconciler := NewStatusReconciler(r.BaseReconciler, customPolicy, nil, customPolicy.Status.ProviderAccountHost, err)
+			_, statusUpdateErr := statusReconciler.Reconcile()
+			if statusUpdateErr != nil {
+				return reconcile.Result{}, fmt.Errorf("Failed to delete 3scale custom policy: %v. Failed to update custom policy definition status: %w", err, statusUpdateErr)
+			}
+			return reconcile.Result{}, err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(customPolicy, capabilitiesv1beta1.CustomPolicyDefinitionFinalizer)
+	err := r.Client().Update(r.Context(), customPolicy)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("Failed removing custom policy definition finalizer: %w", err)
+	}
+
+	reqLogger.Info("resource finalizer removed")
+	return reconcile.Result{}, nil
+}
+
+func (r *ReconcileCustomPolicyDefinition) delete3scaleCustomPolicy(customPolicy *capabilitiesv1beta1.CustomPolicyDefinition) error {
+	logger := r.Logger().WithValues("custompolicydefinition", customPolicy.Name)
+
+	// Only the custom policy managed by the resource is removed
+	if customPolicy.Status.ID == nil {
+		logger.Info("3scale custom policy not managed by the resource. Nothing to delete")
+		return nil
+	}
+
+	providerAccount, err := controllerhelper.LookupProviderAccount(r.Client(), customPolicy.Namespace, customPolicy.Spec.ProviderAccountRef, logger)
+	if controllerhelper.IsProviderAccountNotFound(err) {
+		// The credentials are gone, i.e. the namespace is being deleted.
+		// The custom policy is orphaned instead of blocking the resource deletion forever
+		logger.Info("provider account not found. 3scale custom policy will not be deleted", "error", err.Error())
+		r.EventRecorder().Eventf(customPolicy, corev1.EventTypeWarning, "Orphaned", "3scale custom policy [%s] not deleted: %v", customPolicy.NameVersion(), err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	threescaleAPIClient, err := controllerhelper.PortaClient(providerAccount)
+	if err != nil {
+		return err
+	}
+
+	linkedProducts, err := productsUsingPolicy(threescaleAPIClient, customPolicy)
+	if err != nil {
+		return fmt.Errorf("delete3scaleCustomPolicy custom policy [%s:%s]: %w", customPolicy.Spec.Name, customPolicy.Spec.Version, err)
+	}
+
+	// Removing the policy would break the gateway configuration of the products using it.
+	// Deletion is retried until the products no longer use it
+	if len(linkedProducts) > 0 {
+		return fmt.Errorf("custom policy [%s:%s] cannot be deleted: used by products %v", customPolicy.Spec.Name, customPolicy.Spec.Version, linkedProducts)
+	}
+
+	err = threescaleAPIClient.DeleteRegistryPolicy(*customPolicy.Status.ID)
+	if err != nil && !controllerhelper.IsThreescaleNotFound(err) {
+		return fmt.Errorf("delete3scaleCustomPolicy custom policy [%s:%s]: %w", customPolicy.Spec.Name, customPolicy.Spec.Version, err)
+	}
+
+	return nil
+}
+
+// productsUsingPolicy returns the system name of the 3scale products with the policy in the policy chain.
+// All the products of the provider account are checked, managed by Product resources or not
+func productsUsingPolicy(threescaleAPIClient *controllerhelper.ThreescaleAPIClient, customPolicy *capabilitiesv1beta1.CustomPolicyDefinition) ([]string, error) {
+	productList, err := threescaleAPIClient.ListProducts()
+	if err != nil {
+		return nil, err
+	}
+
+	linkedProducts := make([]string, 0)
+	for idx := range productList.Products {
+		product := productList.Products[idx].Element
+		policies, err := threescaleAPIClient.ProductPolicies(product.ID)
+		if err != nil {
+			return nil, fmt.Errorf("product [%s] policies: %w", product.SystemName, err)
+		}
+
+		for _, policy := range policies.Policies {
+			if policy.Name == customPolicy.Spec.Name && policy.Version == customPolicy.Spec.Version {
+				linkedProducts = append(linkedProducts, product.SystemName)
+				break
+			}
+		}
+	}
+
+	return linkedProducts, nil
+}
+
+func (r *ReconcileCustomPolicyDefinition) reconcile(customPolicyResource *capabilitiesv1beta1.CustomPolicyDefinition) (*StatusReconciler, error) {
+	logger := r.Logger().WithValues("custompolicydefinition", customPolicyResource.Name)
+
+	err := r.validateSpec(customPolicyResource)
+	if err != nil {
+		statusReconciler := NewStatusReconciler(r.BaseReconciler, customPolicyResource, nil, "", err)
+		return statusReconciler, err
+	}
+
+	providerAccount, err := controllerhelper.LookupProviderAccount(r.Client(), customPolicyResource.Namespace, customPolicyResource.Spec.ProviderAccountRef, logger)
+	if err != nil {
+		statusReconciler := NewStatusReconciler(r.BaseReconciler, customPolicyResource, nil, "", err)
+		return statusReconciler, err
+	}
+
+	threescaleAPIClient, err := controllerhelper.PortaClient(providerAccount)
+	if err != nil {
+		statusReconciler := NewStatusReconciler(r.BaseReconciler, customPolicyResource, nil, providerAccount.AdminURLStr, err)
+		return statusReconciler, err
+	}
+
+	reconciler := NewThreescaleReconciler(r.BaseReconciler, customPolicyResource, threescaleAPIClient)
+	remotePolicy, err := reconciler.Reconcile()
+	statusReconciler := NewStatusReconciler(r.BaseReconciler, customPolicyResource, remotePolicy, providerAccount.AdminURLStr, err)
+	return statusReconciler, err
+}
+
+func (r *ReconcileCustomPolicyDefinition) validateSpec(customPolicyResource *capabilitiesv1beta1.CustomPolicyDefinition) error {
+	errors := field.ErrorList{}
+	// internal validation
+	errors = append(errors, customPolicyResource.Validate()...)
+
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return &helper.SpecFieldError{
+		ErrorType:      helper.InvalidError,
+		FieldErrorList: errors,
+	}
+}
@@ -0,0 +1,138 @@
+package custompolicydefinition
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// fakePolicyUsageAPI serves one 3scale product with the given policy chain and the policy registry deletion
+type fakePolicyUsageAPI struct {
+	policyChain []controllerhelper.PolicyConfigItem
+	deleted     bool
+}
+
+func (f *fakePolicyUsageAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/admin/api/services.json":
+		json.NewEncoder(w).Encode(threescaleapi.ProductList{Products: []threescaleapi.Product{
+			{Element: threescaleapi.ProductItem{ID: 3, SystemName: "product1"}},
+		}})
+	case r.Method == http.MethodGet && r.URL.Path == "/admin/api/services/3/proxy/policies.json":
+		json.NewEncoder(w).Encode(controllerhelper.PoliciesConfigList{Policies: f.policyChain})
+	case r.Method == http.MethodDelete && r.URL.Path == "/admin/api/registry/policies/1.json":
+		f.deleted = true
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newTestDeletionReconciler(t *testing.T, srv *httptest.Server, customPolicy *capabilitiesv1beta1.CustomPolicyDefinition) (*ReconcileCustomPolicyDefinition, *record.FakeRecorder) {
+	s := scheme.Scheme
+	if err := capabilitiesv1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant-secret", Namespace: "test"},
+		Data:       map[string][]byte{"adminURL": []byte(srv.URL), "token": []byte("token")},
+	}
+
+	k8sClient := fake.NewFakeClientWithScheme(s, customPolicy, secret)
+	recorder := record.NewFakeRecorder(10)
+	return &ReconcileCustomPolicyDefinition{
+		BaseReconciler: reconcilers.NewBaseReconciler(k8sClient, s, k8sClient, context.TODO(), logf.Log.WithName("test"), nil, recorder),
+	}, recorder
+}
+
+func newTestDeletedCustomPolicy() *capabilitiesv1beta1.CustomPolicyDefinition {
+	policyID := int64(1)
+	return &capabilitiesv1beta1.CustomPolicyDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy1", Namespace: "test"},
+		Spec: capabilitiesv1beta1.CustomPolicyDefinitionSpec{
+			Name:               "example",
+			Version:            "0.1",
+			ProviderAccountRef: &capabilitiesv1beta1.ProviderAccountReference{Name: "tenant-secret"},
+		},
+		Status: capabilitiesv1beta1.CustomPolicyDefinitionStatus{ID: &policyID},
+	}
+}
+
+func TestDelete3scaleCustomPolicyUsedByProduct(t *testing.T) {
+	api := &fakePolicyUsageAPI{policyChain: []controllerhelper.PolicyConfigItem{
+		{Name: "apicast", Version: "builtin", Enabled: true},
+		{Name: "example", Version: "0.1", Enabled: true},
+	}}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+
+	customPolicy := newTestDeletedCustomPolicy()
+	r, _ := newTestDeletionReconciler(t, srv, customPolicy)
+
+	err := r.delete3scaleCustomPolicy(customPolicy)
+	if err == nil || !strings.Contains(err.Error(), "product1") {
+		t.Fatalf("expected deletion to be blocked by product1, got %v", err)
+	}
+
+	if api.deleted {
+		t.Fatal("custom policy used by a product should not be removed")
+	}
+}
+
+func TestDelete3scaleCustomPolicyWithoutProviderAccount(t *testing.T) {
+	api := &fakePolicyUsageAPI{}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+
+	// The provider account secret has already been deleted, i.e. the namespace is being deleted
+	customPolicy := newTestDeletedCustomPolicy()
+	customPolicy.Spec.ProviderAccountRef.Name = "deleted-secret"
+	r, recorder := newTestDeletionReconciler(t, srv, customPolicy)
+
+	err := r.delete3scaleCustomPolicy(customPolicy)
+	if err != nil {
+		t.Fatalf("expected 3scale custom policy to be orphaned, got %v", err)
+	}
+
+	event := <-recorder.Events
+	if !strings.Contains(event, "Warning Orphaned") {
+		t.Fatalf("expected Orphaned warning event, got %s", event)
+	}
+}
+
+func TestDelete3scaleCustomPolicyNotUsed(t *testing.T) {
+	api := &fakePolicyUsageAPI{policyChain: []controllerhelper.PolicyConfigItem{
+		{Name: "apicast", Version: "builtin", Enabled: true},
+		{Name: "example", Version: "0.2", Enabled: true},
+	}}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+
+	customPolicy := newTestDeletedCustomPolicy()
+	r, _ := newTestDeletionReconciler(t, srv, customPolicy)
+
+	if err := r.delete3scaleCustomPolicy(customPolicy); err != nil {
+		t.Fatal(err)
+	}
+
+	if !api.deleted {
+		t.Fatal("custom policy not used by any product should be removed")
+	}
+}
@@ -0,0 +1,290 @@
+package developeraccount
+
+import (
+	"fmt"
+	"strconv"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+type ThreescaleReconciler struct {
+	*reconcilers.BaseReconciler
+	resource            *capabilitiesv1beta1.DeveloperAccount
+	threescaleAPIClient *controllerhelper.ThreescaleAPIClient
+	// Admin user password. Empty when not provided
+	adminPassword string
+	// Nil when the account plan is not reconciled
+	accountPlanID *int64
+	logger        logr.Logger
+}
+
+func NewThreescaleReconciler(b *reconcilers.BaseReconciler, resource *capabilitiesv1beta1.DeveloperAccount, threescaleAPIClient *controllerhelper.ThreescaleAPIClient, adminPassword string, accountPlanID *int64) *ThreescaleReconciler {
+	return &ThreescaleReconciler{
+		BaseReconciler:      b,
+		resource:            resource,
+		threescaleAPIClient: threescaleAPIClient,
+		adminPassword:       adminPassword,
+		accountPlanID:       accountPlanID,
+		logger:              b.Logger().WithValues("3scale Reconciler", resource.Name),
+	}
+}
+
+func (t *ThreescaleReconciler) Reconcile() (*controllerhelper.DeveloperAccount, error) {
+	remoteAccount, err := findDeveloperAccount(t.threescaleAPIClient, t.resource)
+	if err != nil {
+		return nil, fmt.Errorf("Error sync developer account [%s]: %w", t.resource.Spec.OrgName, err)
+	}
+
+	if remoteAccount == nil {
+		remoteAccount, err = t.findAccountByUsername()
+		if helper.IsInvalidSpecError(err) {
+			return nil, err
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Error sync developer account [%s]: %w", t.resource.Spec.OrgName, err)
+		}
+	}
+
+	if remoteAccount == nil {
+		remoteAccount, err = t.createAccount()
+		if err != nil {
+			return nil, fmt.Errorf("Error sync developer account [%s]: %w", t.resource.Spec.OrgName, err)
+		}
+	} else {
+		remoteAccount, err = t.syncAccount(remoteAccount)
+		if err != nil {
+			return nil, fmt.Errorf("Error sync developer account [%s]: %w", t.resource.Spec.OrgName, err)
+		}
+	}
+
+	err = t.syncAccountPlan(remoteAccount)
+	if err != nil {
+		return remoteAccount, fmt.Errorf("Error sync developer account [%s] plan: %w", t.resource.Spec.OrgName, err)
+	}
+
+	remoteAccount, err = t.syncState(remoteAccount)
+	if err != nil {
+		return nil, fmt.Errorf("Error sync developer account [%s] state: %w", t.resource.Spec.OrgName, err)
+	}
+
+	return remoteAccount, nil
+}
+
+// findAccountByUsername returns the existing 3scale developer account of the admin username
+// when the resource owns it or it is adopted. Nil when not found.
+func (t *ThreescaleReconciler) findAccountByUsername() (*controllerhelper.DeveloperAccount, error) {
+	remoteAccount, err := t.threescaleAPIClient.FindDeveloperAccountByUsername(t.resource.Spec.Admin.Username)
+	if err != nil {
+		if controllerhelper.IsThreescaleNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if !t.resource.Spec.Adopt && !t.resource.Owns(remoteAccount.Element.ID) {
+		// Existing developer accounts not created by this resource are only taken over on explicit adoption
+		return nil, &helper.SpecFieldError{
+			ErrorType: helper.InvalidError,
+			FieldErrorList: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("admin").Child("username"), t.resource.Spec.Admin.Username,
+					"3scale developer account already exists and it is not managed by this resource. Set spec.adopt to take it over"),
+			},
+		}
+	}
+
+	// The ID is recorded right away, the account is deleted with the resource from now on
+	err = controllerhelper.PatchStatus(t.Context(), t.Client(), t.resource, func() {
+		accountID := remoteAccount.Element.ID
+		t.resource.Status.ID = &accountID
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return remoteAccount, nil
+}
+
+func (t *ThreescaleReconciler) createAccount() (*controllerhelper.DeveloperAccount, error) {
+	// The developer account is claimed before its creation.
+	// When the response or the status update are lost, the next reconciliation still owns it
+	err := controllerhelper.Claim(t.Context(), t.Client(), t.resource, capabilitiesv1beta1.ClaimedAdminUsernameAnnotation, t.resource.Spec.Admin.Username)
+	if err != nil {
+		return nil, fmt.Errorf("claim: %w", err)
+	}
+
+	params := t.desiredParams()
+	params["org_name"] = t.resource.Spec.OrgName
+	params["username"] = t.resource.Spec.Admin.Username
+	params["email"] = t.resource.Spec.Admin.Email
+	if t.adminPassword != "" {
+		params["password"] = t.adminPassword
+	}
+	if t.accountPlanID != nil {
+		params["account_plan_id"] = strconv.FormatInt(*t.accountPlanID, 10)
+	}
+
+	remoteAccount, err := t.threescaleAPIClient.CreateDeveloperAccount(params)
+	if err != nil {
+		if controllerhelper.IsCreationRejected(err) {
+			releaseErr := controllerhelper.ReleaseClaim(t.Context(), t.Client(), t.resource, capabilitiesv1beta1.ClaimedAdminUsernameAnnotation)
+			if releaseErr != nil {
+				t.logger.Error(releaseErr, "failed to release developer account admin username claim")
+			}
+		}
+		return nil, err
+	}
+
+	// The ID is recorded right away. On failure, the claim keeps the ownership
+	err = controllerhelper.PatchStatus(t.Context(), t.Client(), t.resource, func() {
+		accountID := remoteAccount.Element.ID
+		t.resource.Status.ID = &accountID
+	})
+	if err != nil {
+		t.logger.Error(err, "failed to record developer account ID", "ID", remoteAccount.Element.ID)
+	}
+
+	return remoteAccount, nil
+}
+
+func (t *ThreescaleReconciler) syncAccount(remoteAccount *controllerhelper.DeveloperAccount) (*controllerhelper.DeveloperAccount, error) {
+	desired := t.desiredParams()
+	existing := accountParams(&remoteAccount.Element)
+
+	params := threescaleapi.Params{}
+	if remoteAccount.Element.OrgName != t.resource.Spec.OrgName {
+		params["org_name"] = t.resource.Spec.OrgName
+	}
+
+	for k, v := range desired {
+		if existing[k] != v {
+			params[k] = v
+		}
+	}
+
+	if len(params) == 0 {
+		return remoteAccount, nil
+	}
+
+	t.logger.V(1).Info("update developer account", "params", params)
+	return t.threescaleAPIClient.UpdateDeveloperAccount(remoteAccount.Element.ID, params)
+}
+
+func (t *ThreescaleReconciler) syncAccountPlan(remoteAccount *controllerhelper.DeveloperAccount) error {
+	if t.accountPlanID == nil {
+		return nil
+	}
+
+	currentPlan, err := t.threescaleAPIClient.DeveloperAccountPlan(remoteAccount.Element.ID)
+	if err != nil {
+		return err
+	}
+
+	if currentPlan.Element.ID == *t.accountPlanID {
+		return nil
+	}
+
+	t.logger.V(1).Info("change developer account plan", "from", currentPlan.Element.ID, "to", *t.accountPlanID)
+	_, err = t.threescaleAPIClient.ChangeDeveloperAccountPlan(remoteAccount.Element.ID, *t.accountPlanID)
+	return err
+}
+
+func (t *ThreescaleReconciler) syncState(remoteAccount *controllerhelper.DeveloperAccount) (*controllerhelper.DeveloperAccount, error) {
+	if t.resource.Spec.State == nil || *t.resource.Spec.State == remoteAccount.Element.State {
+		return remoteAccount, nil
+	}
+
+	t.logger.V(1).Info("change developer account state", "from", remoteAccount.Element.State, "to", *t.resource.Spec.State)
+	switch *t.resource.Spec.State {
+	case capabilitiesv1beta1.DeveloperAccountStateApproved:
+		return t.threescaleAPIClient.ApproveDeveloperAccount(remoteAccount.Element.ID)
+	case capabilitiesv1beta1.DeveloperAccountStateRejected:
+		return t.threescaleAPIClient.RejectDeveloperAccount(remoteAccount.Element.ID)
+	case capabilitiesv1beta1.DeveloperAccountStatePending:
+		return t.threescaleAPIClient.MakePendingDeveloperAccount(remoteAccount.Element.ID)
+	}
+
+	return nil, fmt.Errorf("unknown developer account state: %s", *t.resource.Spec.State)
+}
+
+// desiredParams returns the optional attributes set in the spec.
+// Attributes not set in the spec are not reconciled, respecting 3scale values.
+func (t *ThreescaleReconciler) desiredParams() threescaleapi.Params {
+	params := threescaleapi.Params{}
+
+	if t.resource.Spec.VatCode != nil {
+		params["vat_code"] = *t.resource.Spec.VatCode
+	}
+
+	if t.resource.Spec.MonthlyBillingEnabled != nil {
+		params["monthly_billing_enabled"] = strconv.FormatBool(*t.resource.Spec.MonthlyBillingEnabled)
+	}
+
+	if t.resource.Spec.MonthlyChargingEnabled != nil {
+		params["monthly_charging_enabled"] = strconv.FormatBool(*t.resource.Spec.MonthlyChargingEnabled)
+	}
+
+	if billing := t.resource.Spec.BillingAddress; billing != nil {
+		params["billing_address_name"] = billing.Company
+		params["billing_address_address1"] = billing.Address1
+		params["billing_address_address2"] = billing.Address2
+		params["billing_address_city"] = billing.City
+		params["billing_address_state"] = billing.State
+		params["billing_address_country"] = billing.Country
+		params["billing_address_zip"] = billing.Zip
+		params["billing_address_phone"] = billing.Phone
+	}
+
+	return params
+}
+
+// accountParams returns the reconciled attributes of the 3scale developer account
+// with the same format as desiredParams
+func accountParams(account *controllerhelper.DeveloperAccountItem) threescaleapi.Params {
+	params := threescaleapi.Params{
+		"vat_code":                 account.VatCode,
+		"monthly_billing_enabled":  strconv.FormatBool(account.MonthlyBillingEnabled),
+		"monthly_charging_enabled": strconv.FormatBool(account.MonthlyChargingEnabled),
+	}
+
+	billing := account.BillingAddress
+	if billing == nil {
+		billing = &controllerhelper.BillingAddress{}
+	}
+
+	params["billing_address_name"] = billing.Company
+	params["billing_address_address1"] = billing.Address1
+	params["billing_address_address2"] = billing.Address2
+	params["billing_address_city"] = billing.City
+	params["billing_address_state"] = billing.State
+	params["billing_address_country"] = billing.Country
+	params["billing_address_zip"] = billing.Zip
+	params["billing_address_phone"] = billing.Phone
+
+	return params
+}
+
+// findDeveloperAccount returns the 3scale developer account managed by the resource,
+// looked up by the ID recorded in the status. Nil when not found.
+// Accounts with the same admin username are never returned, they may not be managed by the resource
+func findDeveloperAccount(threescaleAPIClient *controllerhelper.ThreescaleAPIClient, resource *capabilitiesv1beta1.DeveloperAccount) (*controllerhelper.DeveloperAccount, error) {
+	if resource.Status.ID == nil {
+		return nil, nil
+	}
+
+	remoteAccount, err := threescaleAPIClient.DeveloperAccount(*resource.Status.ID)
+	if err != nil {
+		if controllerhelper.IsThreescaleNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return remoteAccount, nil
+}
@@ -0,0 +1,181 @@
+package developeraccount
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// fakeAccountsAPI serves the 3scale developer account endpoints, accounts indexed by admin username
+type fakeAccountsAPI struct {
+	mu       sync.Mutex
+	accounts map[string]controllerhelper.DeveloperAccount
+}
+
+func (f *fakeAccountsAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	r.ParseForm()
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/admin/api/accounts/find.json":
+		account, ok := f.accounts[r.Form.Get("username")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(account)
+	case r.Method == http.MethodPost && r.URL.Path == "/admin/api/signup.json":
+		account := controllerhelper.DeveloperAccount{Element: controllerhelper.DeveloperAccountItem{
+			ID:      int64(len(f.accounts) + 1),
+			OrgName: r.PostForm.Get("org_name"),
+		}}
+		f.accounts[r.PostForm.Get("username")] = account
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(account)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newTestAccountClient(t *testing.T, adopt bool) (client.Client, types.NamespacedName) {
+	account := &capabilitiesv1beta1.DeveloperAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: "acme", Namespace: "test"},
+		Spec: capabilitiesv1beta1.DeveloperAccountSpec{
+			OrgName: "acme",
+			Admin:   capabilitiesv1beta1.DeveloperAccountAdminSpec{Username: "john", Email: "john@example.com"},
+			Adopt:   adopt,
+		},
+	}
+
+	s := runtime.NewScheme()
+	if err := capabilitiesv1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	return fake.NewFakeClientWithScheme(s, account), types.NamespacedName{Name: "acme", Namespace: "test"}
+}
+
+func newTestAccountReconciler(t *testing.T, k8sClient client.Client, nn types.NamespacedName, srv *httptest.Server) *ThreescaleReconciler {
+	account := &capabilitiesv1beta1.DeveloperAccount{}
+	if err := k8sClient.Get(context.TODO(), nn, account); err != nil {
+		t.Fatal(err)
+	}
+
+	adminURL, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	threescaleAPIClient, err := controllerhelper.PortaClientFromURL(adminURL, "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := runtime.NewScheme()
+	if err := capabilitiesv1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	baseReconciler := reconcilers.NewBaseReconciler(k8sClient, s, k8sClient, context.TODO(), logf.Log.WithName("test"), nil, record.NewFakeRecorder(10))
+	return NewThreescaleReconciler(baseReconciler, account, threescaleAPIClient, "", nil)
+}
+
+func existingAccount(id int64) map[string]controllerhelper.DeveloperAccount {
+	return map[string]controllerhelper.DeveloperAccount{
+		"john": {Element: controllerhelper.DeveloperAccountItem{ID: id, OrgName: "acme"}},
+	}
+}
+
+func TestReconcileDeveloperAccountNotOwned(t *testing.T) {
+	srv := httptest.NewServer(&fakeAccountsAPI{accounts: existingAccount(3)})
+	defer srv.Close()
+
+	k8sClient, nn := newTestAccountClient(t, false)
+
+	_, err := newTestAccountReconciler(t, k8sClient, nn, srv).Reconcile()
+	if !helper.IsInvalidSpecError(err) {
+		t.Fatalf("expected invalid spec error for a developer account not created by the resource, got %v", err)
+	}
+
+	account := &capabilitiesv1beta1.DeveloperAccount{}
+	if err := k8sClient.Get(context.TODO(), nn, account); err != nil {
+		t.Fatal(err)
+	}
+	if account.Status.ID != nil {
+		t.Fatalf("conflicting developer account must not be recorded, got %d", *account.Status.ID)
+	}
+}
+
+func TestReconcileDeveloperAccountAdopt(t *testing.T) {
+	srv := httptest.NewServer(&fakeAccountsAPI{accounts: existingAccount(3)})
+	defer srv.Close()
+
+	k8sClient, nn := newTestAccountClient(t, true)
+
+	remoteAccount, err := newTestAccountReconciler(t, k8sClient, nn, srv).Reconcile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if remoteAccount.Element.ID != 3 {
+		t.Fatalf("expected developer account 3 to be adopted, got %d", remoteAccount.Element.ID)
+	}
+
+	account := &capabilitiesv1beta1.DeveloperAccount{}
+	if err := k8sClient.Get(context.TODO(), nn, account); err != nil {
+		t.Fatal(err)
+	}
+	if account.Status.ID == nil || *account.Status.ID != 3 {
+		t.Fatalf("adopted developer account ID must be recorded, got %v", account.Status.ID)
+	}
+}
+
+func TestReconcileDeveloperAccountLostStatus(t *testing.T) {
+	api := &fakeAccountsAPI{accounts: map[string]controllerhelper.DeveloperAccount{}}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+
+	k8sClient, nn := newTestAccountClient(t, false)
+
+	created, err := newTestAccountReconciler(t, k8sClient, nn, srv).Reconcile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	account := &capabilitiesv1beta1.DeveloperAccount{}
+	if err := k8sClient.Get(context.TODO(), nn, account); err != nil {
+		t.Fatal(err)
+	}
+	account.Status.ID = nil
+	if err := k8sClient.Update(context.TODO(), account); err != nil {
+		t.Fatal(err)
+	}
+
+	same, err := newTestAccountReconciler(t, k8sClient, nn, srv).Reconcile()
+	if err != nil {
+		t.Fatalf("created developer account should still be owned after losing the status, got %v", err)
+	}
+
+	if same.Element.ID != created.Element.ID || len(api.accounts) != 1 {
+		t.Fatalf("expected developer account %d to be reused, got %d and %d accounts", created.Element.ID, same.Element.ID, len(api.accounts))
+	}
+}
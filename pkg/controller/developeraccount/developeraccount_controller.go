@@ -0,0 +1,356 @@
+package developeraccount
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	"github.com/3scale/3scale-operator/pkg/common"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+	"github.com/3scale/3scale-operator/version"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+var (
+	// controllerName is the name of this controller
+	controllerName = "controller_developeraccount"
+
+	// package level logger
+	log = logf.Log.WithName(controllerName)
+)
+
+// Add creates a new DeveloperAccount Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	reconciler, err := newReconciler(mgr)
+	if err != nil {
+		return err
+	}
+
+	return add(mgr, reconciler)
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager) (reconcile.Reconciler, error) {
+	apiClientReader, err := common.NewAPIClientReader(mgr)
+	if err != nil {
+		return nil, err
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	client := mgr.GetClient()
+	scheme := mgr.GetScheme()
+	ctx := context.TODO()
+	recorder := mgr.GetEventRecorderFor(controllerName)
+	return &ReconcileDeveloperAccount{
+		BaseReconciler: reconcilers.NewBaseReconciler(client, scheme, apiClientReader, ctx, log, discoveryClient, recorder),
+	}, nil
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New("developeraccount-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to primary resource DeveloperAccount
+	err = c.Watch(&source.Kind{Type: &capabilitiesv1beta1.DeveloperAccount{}}, &handler.EnqueueRequestForObject{})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// blank assignment to verify that ReconcileDeveloperAccount implements reconcile.Reconciler
+var _ reconcile.Reconciler = &ReconcileDeveloperAccount{}
+
+// ReconcileDeveloperAccount reconciles a DeveloperAccount object
+type ReconcileDeveloperAccount struct {
+	*reconcilers.BaseReconciler
+}
+
+// Reconcile reads that state of the cluster for a DeveloperAccount object and makes changes based on the state read
+// and what is in the DeveloperAccount.Spec
+func (r *ReconcileDeveloperAccount) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := r.Logger().WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconcile DeveloperAccount", "Operator version", version.Version)
+
+	// Fetch the DeveloperAccount instance
+	developerAccount := &capabilitiesv1beta1.DeveloperAccount{}
+	err := r.Client().Get(r.Context(), request.NamespacedName, developerAccount)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Return and don't requeue
+			controllerhelper.DeleteResourceSynced(capabilitiesv1beta1.DeveloperAccountKind, request.NamespacedName)
+			reqLogger.Info("resource not found. Ignoring since object must have been deleted")
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	if reqLogger.V(1).Enabled() {
+		jsonData, err := json.MarshalIndent(developerAccount, "", "  ")
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		reqLogger.V(1).Info(string(jsonData))
+	}
+
+	if developerAccount.GetDeletionTimestamp() != nil && helper.ArrayContains(developerAccount.GetFinalizers(), capabilitiesv1beta1.DeveloperAccountFinalizer) {
+		return r.reconcileDeletion(developerAccount, reqLogger)
+	}
+
+	// Ignore deleted DeveloperAccounts, this can happen when foregroundDeletion is enabled
+	// https://kubernetes.io/docs/concepts/workloads/controllers/garbage-collection/#foreground-cascading-deletion
+	if developerAccount.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	if !helper.ArrayContains(developerAccount.GetFinalizers(), capabilitiesv1beta1.DeveloperAccountFinalizer) {
+		controllerutil.AddFinalizer(developerAccount, capabilitiesv1beta1.DeveloperAccountFinalizer)
+		err := r.Client().Update(r.Context(), developerAccount)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("Failed adding developer account finalizer: %w", err)
+		}
+
+		reqLogger.Info("resource finalizer added. Requeueing.")
+		return reconcile.Result{Requeue: true}, nil
+	}
+
+	statusReconciler, reconcileErr := r.reconcile(developerAccount)
+	statusResult, statusUpdateErr := statusReconciler.Reconcile()
+	if statusUpdateErr != nil {
+		if reconcileErr != nil {
+			return reconcile.Result{}, fmt.Errorf("Failed to sync developer account: %v. Failed to update developer account status: %w", reconcileErr, statusUpdateErr)
+		}
+
+		return reconcile.Result{}, fmt.Errorf("Failed to update developer account status: %w", statusUpdateErr)
+	}
+
+	if statusResult.Requeue {
+		return statusResult, nil
+	}
+
+	if reconcileErr != nil {
+		if helper.IsInvalidSpecError(reconcileErr) {
+			// On Validation error, no need to retry as spec is not valid and needs to be changed
+			reqLogger.Info("ERROR", "spec validation error", reconcileErr)
+			r.EventRecorder().Eventf(developerAccount, corev1.EventTypeWarning, "Invalid DeveloperAccount Spec", "%v", reconcileErr)
+			return reconcile.Result{}, nil
+		}
+
+		if controllerhelper.IsThreescaleUnavailable(reconcileErr) {
+			// 3scale is expected to recover, retry once the circuit breaker lets requests through again
+			reqLogger.Info("ERROR", "3scale unavailable", reconcileErr)
+			r.EventRecorder().Eventf(developerAccount, corev1.EventTypeWarning, "Unavailable", "%v", reconcileErr)
+			return reconcile.Result{RequeueAfter: controllerhelper.ThreescaleUnavailableRequeueDelay}, nil
+		}
+
+		if helper.IsOrphanSpecError(reconcileErr) {
+			// On Orphan spec error, retry
+			reqLogger.Info("ERROR", "spec orphan error", reconcileErr)
+			return reconcile.Result{Requeue: true}, nil
+		}
+
+		reqLogger.Error(reconcileErr, "Failed to reconcile")
+		r.EventRecorder().Eventf(developerAccount, corev1.EventTypeWarning, "ReconcileError", "%v", reconcileErr)
+	}
+
+	reqLogger.Info("END", "error", reconcileErr)
+	return reconcile.Result{}, reconcileErr
+}
+
+// reconcileDeletion removes the 3scale developer account, unless orphan on delete is requested,
+// and then removes the finalizer to let the resource be deleted
+func (r *ReconcileDeveloperAccount) reconcileDeletion(developerAccount *capabilitiesv1beta1.DeveloperAccount, reqLogger logr.Logger) (reconcile.Result, error) {
+	if developerAccount.OrphanOnDelete() {
+		reqLogger.Info("orphan on delete annotation found. 3scale developer account will not be deleted")
+	} else {
+		err := r.delete3scaleDeveloperAccount(developerAccount)
+		if err != nil {
+			reqLogger.Error(err, "Failed to delete 3scale developer account")
+			r.EventRecorder().Eventf(developerAccount, corev1.EventTypeWarning, "DeleteError", "%v", err)
+			statusReconciler := NewStatusReconciler(r.BaseReconciler, developerAccount, nil, developerAccount.Status.ProviderAccountHost, err)
+			_, statusUpdateErr := statusReconciler.Reconcile()
+			if statusUpdateErr != nil {
+				return reconcile.Result{}, fmt.Errorf("Failed to delete 3scale developer account: %v. Failed to update developer account status: %w", err, statusUpdateErr)
+			}
+			return reconcile.Result{}, err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(developerAccount, capabilitiesv1beta1.DeveloperAccountFinalizer)
+	err := r.Client().Update(r.Context(), developerAccount)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("Failed removing developer account finalizer: %w", err)
+	}
+
+	reqLogger.Info("resource finalizer removed")
+	return reconcile.Result{}, nil
+}
+
+func (r *ReconcileDeveloperAccount) delete3scaleDeveloperAccount(developerAccount *capabilitiesv1beta1.DeveloperAccount) error {
+	logger := r.Logger().WithValues("developeraccount", developerAccount.Name)
+
+	providerAccount, err := controllerhelper.LookupProviderAccount(r.Client(), developerAccount.Namespace, developerAccount.Spec.ProviderAccountRef, logger)
+	if controllerhelper.IsProviderAccountNotFound(err) {
+		// The credentials are gone, i.e. the namespace is being deleted.
+		// The 3scale developer account is orphaned instead of blocking the resource deletion forever
+		logger.Info("provider account not found. 3scale developer account will not be deleted", "error", err.Error())
+		r.EventRecorder().Eventf(developerAccount, corev1.EventTypeWarning, "Orphaned", "3scale developer account [%s] not deleted: %v", developerAccount.Spec.OrgName, err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	threescaleAPIClient, err := controllerhelper.PortaClient(providerAccount)
+	if err != nil {
+		return err
+	}
+
+	remoteAccount, err := findDeveloperAccount(threescaleAPIClient, developerAccount)
+	if err != nil {
+		return fmt.Errorf("delete3scaleDeveloperAccount developer account [%s]: %w", developerAccount.Spec.OrgName, err)
+	}
+
+	if remoteAccount == nil {
+		// developer account not found in 3scale, nothing to delete
+		logger.Info("3scale developer account not found. Nothing to delete")
+		return nil
+	}
+
+	err = threescaleAPIClient.DeleteDeveloperAccount(remoteAccount.Element.ID)
+	if err != nil && !controllerhelper.IsThreescaleNotFound(err) {
+		return fmt.Errorf("delete3scaleDeveloperAccount developer account [%s]: %w", developerAccount.Spec.OrgName, err)
+	}
+
+	return nil
+}
+
+func (r *ReconcileDeveloperAccount) reconcile(developerAccountResource *capabilitiesv1beta1.DeveloperAccount) (*StatusReconciler, error) {
+	logger := r.Logger().WithValues("developeraccount", developerAccountResource.Name)
+
+	err := r.validateSpec(developerAccountResource)
+	if err != nil {
+		statusReconciler := NewStatusReconciler(r.BaseReconciler, developerAccountResource, nil, "", err)
+		return statusReconciler, err
+	}
+
+	providerAccount, err := controllerhelper.LookupProviderAccount(r.Client(), developerAccountResource.Namespace, developerAccountResource.Spec.ProviderAccountRef, logger)
+	if err != nil {
+		statusReconciler := NewStatusReconciler(r.BaseReconciler, developerAccountResource, nil, "", err)
+		return statusReconciler, err
+	}
+
+	threescaleAPIClient, err := controllerhelper.PortaClient(providerAccount)
+	if err != nil {
+		statusReconciler := NewStatusReconciler(r.BaseReconciler, developerAccountResource, nil, providerAccount.AdminURLStr, err)
+		return statusReconciler, err
+	}
+
+	accountPlanID, err := r.checkExternalRefs(developerAccountResource, threescaleAPIClient)
+	logger.Info("checkExternalRefs", "err", err)
+	if err != nil {
+		statusReconciler := NewStatusReconciler(r.BaseReconciler, developerAccountResource, nil, providerAccount.AdminURLStr, err)
+		return statusReconciler, err
+	}
+
+	adminPassword, err := r.adminPassword(developerAccountResource)
+	if err != nil {
+		statusReconciler := NewStatusReconciler(r.BaseReconciler, developerAccountResource, nil, providerAccount.AdminURLStr, err)
+		return statusReconciler, err
+	}
+
+	reconciler := NewThreescaleReconciler(r.BaseReconciler, developerAccountResource, threescaleAPIClient, adminPassword, accountPlanID)
+	remoteAccount, err := reconciler.Reconcile()
+	statusReconciler := NewStatusReconciler(r.BaseReconciler, developerAccountResource, remoteAccount, providerAccount.AdminURLStr, err)
+	return statusReconciler, err
+}
+
+func (r *ReconcileDeveloperAccount) validateSpec(developerAccountResource *capabilitiesv1beta1.DeveloperAccount) error {
+	errors := field.ErrorList{}
+	// internal validation
+	errors = append(errors, developerAccountResource.Validate()...)
+
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return &helper.SpecFieldError{
+		ErrorType:      helper.InvalidError,
+		FieldErrorList: errors,
+	}
+}
+
+// checkExternalRefs checks the referenced account plan exists in 3scale and returns its ID.
+// Nil account plan ID when the account plan is not reconciled.
+func (r *ReconcileDeveloperAccount) checkExternalRefs(developerAccountResource *capabilitiesv1beta1.DeveloperAccount, threescaleAPIClient *controllerhelper.ThreescaleAPIClient) (*int64, error) {
+	if developerAccountResource.Spec.AccountPlan == nil {
+		return nil, nil
+	}
+
+	accountPlanList, err := threescaleAPIClient.ListAccountPlans()
+	if err != nil {
+		return nil, fmt.Errorf("checking account plan reference: %w", err)
+	}
+
+	for idx := range accountPlanList.Plans {
+		if accountPlanList.Plans[idx].Element.SystemName == *developerAccountResource.Spec.AccountPlan {
+			accountPlanID := accountPlanList.Plans[idx].Element.ID
+			return &accountPlanID, nil
+		}
+	}
+
+	accountPlanFldPath := field.NewPath("spec").Child("accountPlan")
+	return nil, &helper.SpecFieldError{
+		ErrorType:      helper.OrphanError,
+		FieldErrorList: field.ErrorList{field.Invalid(accountPlanFldPath, *developerAccountResource.Spec.AccountPlan, "account plan not found in 3scale")},
+	}
+}
+
+// adminPassword reads the admin user password from the referenced secret.
+// Empty when no secret is referenced.
+func (r *ReconcileDeveloperAccount) adminPassword(developerAccountResource *capabilitiesv1beta1.DeveloperAccount) (string, error) {
+	ref := developerAccountResource.Spec.Admin.PasswordCredentialsRef
+	if ref == nil {
+		return "", nil
+	}
+
+	secret, err := helper.GetSecret(ref.Name, developerAccountResource.Namespace, r.Client())
+	if err != nil {
+		return "", fmt.Errorf("reading admin password secret: %w", err)
+	}
+
+	password := helper.GetSecretDataValue(secret.Data, capabilitiesv1beta1.DeveloperAccountPasswordSecretField)
+	if password == nil {
+		return "", fmt.Errorf("field %s not found in admin password secret %s", capabilitiesv1beta1.DeveloperAccountPasswordSecretField, ref.Name)
+	}
+
+	return *password, nil
+}
@@ -10,14 +10,15 @@ import (
 
 type ApplicationPlanEntity struct {
 	productID    int64
-	client       *threescaleapi.ThreeScaleClient
+	client       *ThreescaleAPIClient
 	obj          threescaleapi.ApplicationPlanItem
 	limits       *threescaleapi.ApplicationPlanLimitList
 	pricingRules *threescaleapi.ApplicationPlanPricingRuleList
+	features     *FeatureJSONList
 	logger       logr.Logger
 }
 
-func NewApplicationPlanEntity(productID int64, obj threescaleapi.ApplicationPlanItem, cl *threescaleapi.ThreeScaleClient, logger logr.Logger) *ApplicationPlanEntity {
+func NewApplicationPlanEntity(productID int64, obj threescaleapi.ApplicationPlanItem, cl *ThreescaleAPIClient, logger logr.Logger) *ApplicationPlanEntity {
 	return &ApplicationPlanEntity{
 		productID: productID,
 		obj:       obj,
@@ -50,6 +51,16 @@ func (b *ApplicationPlanEntity) CostPerMonth() float64 {
 	return b.obj.CostPerMonth
 }
 
+// Published returns true when the plan is available for developers to sign up
+func (b *ApplicationPlanEntity) Published() bool {
+	return b.obj.State == PlanPublishedState
+}
+
+// Default returns true when the plan is the default plan of the product
+func (b *ApplicationPlanEntity) Default() bool {
+	return b.obj.Default
+}
+
 func (b *ApplicationPlanEntity) Update(params threescaleapi.Params) error {
 	b.logger.V(1).Info("Update", "params", params)
 	updated, err := b.client.UpdateApplicationPlan(b.productID, b.obj.ID, params)
@@ -62,6 +73,19 @@ func (b *ApplicationPlanEntity) Update(params threescaleapi.Params) error {
 	return nil
 }
 
+// SetDefault makes the plan the default plan of the product
+func (b *ApplicationPlanEntity) SetDefault() error {
+	b.logger.V(1).Info("SetDefault")
+	updated, err := b.client.SetDefaultApplicationPlan(b.productID, b.obj.ID)
+	if err != nil {
+		return fmt.Errorf("product [%d] plan [%s] set default: %w", b.productID, b.obj.SystemName, err)
+	}
+
+	b.obj = updated.Element
+
+	return nil
+}
+
 func (b *ApplicationPlanEntity) Limits() (*threescaleapi.ApplicationPlanLimitList, error) {
 	if b.limits == nil {
 		limits, err := b.getLimits()
@@ -151,3 +175,48 @@ func (b *ApplicationPlanEntity) CreatePricingRule(metricID int64, params threesc
 func (b *ApplicationPlanEntity) resetPricingRules() {
 	b.pricingRules = nil
 }
+
+func (b *ApplicationPlanEntity) Features() (*FeatureJSONList, error) {
+	if b.features == nil {
+		features, err := b.getFeatures()
+		if err != nil {
+			return nil, err
+		}
+		b.features = features
+	}
+	return b.features, nil
+}
+
+func (b *ApplicationPlanEntity) getFeatures() (*FeatureJSONList, error) {
+	b.logger.V(1).Info("getFeatures")
+	list, err := b.client.ListApplicationPlanFeatures(b.obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("application plan [%s] get features: %w", b.obj.SystemName, err)
+	}
+
+	return list, nil
+}
+
+func (b *ApplicationPlanEntity) EnableFeature(featureID int64) error {
+	b.logger.V(1).Info("EnableFeature", "featureID", featureID)
+	_, err := b.client.CreateApplicationPlanFeature(b.obj.ID, featureID)
+	if err != nil {
+		return fmt.Errorf("application plan [%s] enable feature: %w", b.obj.SystemName, err)
+	}
+	b.resetFeatures()
+	return nil
+}
+
+func (b *ApplicationPlanEntity) DisableFeature(featureID int64) error {
+	b.logger.V(1).Info("DisableFeature", "featureID", featureID)
+	err := b.client.DeleteApplicationPlanFeature(b.obj.ID, featureID)
+	if err != nil {
+		return fmt.Errorf("application plan [%s] disable feature: %w", b.obj.SystemName, err)
+	}
+	b.resetFeatures()
+	return nil
+}
+
+func (b *ApplicationPlanEntity) resetFeatures() {
+	b.features = nil
+}
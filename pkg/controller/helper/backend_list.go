@@ -33,7 +33,7 @@ func BackendList(ns string, cl client.Client, providerAccount *ProviderAccount,
 			continue
 		}
 
-		backendProviderAccount, err := LookupProviderAccount(cl, ns, backendList.Items[idx].Spec.ProviderAccountRef, logger)
+		backendProviderAccount, err := LookupProviderAccount(cl, backendList.Items[idx].Namespace, backendList.Items[idx].Spec.ProviderAccountRef, logger)
 		if err != nil {
 			return nil, fmt.Errorf("BackendList: %w", err)
 		}
@@ -11,7 +11,7 @@ import (
 )
 
 type BackendAPIEntity struct {
-	client            *threescaleapi.ThreeScaleClient
+	client            *ThreescaleAPIClient
 	backendAPIObj     *threescaleapi.BackendApi
 	metrics           *threescaleapi.MetricJSONList
 	metricsAndMethods *threescaleapi.MetricJSONList
@@ -20,7 +20,7 @@ type BackendAPIEntity struct {
 	logger            logr.Logger
 }
 
-func NewBackendAPIEntity(backendAPIObj *threescaleapi.BackendApi, client *threescaleapi.ThreeScaleClient, logger logr.Logger) *BackendAPIEntity {
+func NewBackendAPIEntity(backendAPIObj *threescaleapi.BackendApi, client *ThreescaleAPIClient, logger logr.Logger) *BackendAPIEntity {
 	return &BackendAPIEntity{
 		backendAPIObj: backendAPIObj,
 		client:        client,
@@ -60,6 +60,16 @@ func (b *BackendAPIEntity) Update(params threescaleapi.Params) error {
 	return nil
 }
 
+func (b *BackendAPIEntity) Delete() error {
+	b.logger.V(1).Info("Delete")
+	err := b.client.DeleteBackendApi(b.backendAPIObj.Element.ID)
+	if err != nil {
+		return fmt.Errorf("backend [%s] delete request: %w", b.backendAPIObj.Element.SystemName, err)
+	}
+
+	return nil
+}
+
 func (b *BackendAPIEntity) Methods() (*threescaleapi.MethodList, error) {
 	b.logger.V(1).Info("Methods")
 	if b.methods == nil {
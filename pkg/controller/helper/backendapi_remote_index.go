@@ -1,19 +1,21 @@
 package helper
 
 import (
+	"sort"
+
 	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
 
 	"github.com/go-logr/logr"
 )
 
 type BackendAPIRemoteIndex struct {
-	client                 *threescaleapi.ThreeScaleClient
+	client                 *ThreescaleAPIClient
 	logger                 logr.Logger
 	backendIDIndex         map[int64]*BackendAPIEntity
 	backendSystemNameIndex map[string]*BackendAPIEntity
 }
 
-func NewBackendAPIRemoteIndex(client *threescaleapi.ThreeScaleClient, logger logr.Logger) (*BackendAPIRemoteIndex, error) {
+func NewBackendAPIRemoteIndex(client *ThreescaleAPIClient, logger logr.Logger) (*BackendAPIRemoteIndex, error) {
 	backendAPIs, err := client.ListBackendApis()
 	if err != nil {
 		return nil, err
@@ -50,6 +52,16 @@ func (b *BackendAPIRemoteIndex) FindBySystemName(systemName string) (*BackendAPI
 	return item, ok
 }
 
+// BackendAPIs returns the remote backendAPI items sorted by ID
+func (b *BackendAPIRemoteIndex) BackendAPIs() []*BackendAPIEntity {
+	list := make([]*BackendAPIEntity, 0, len(b.backendIDIndex))
+	for _, item := range b.backendIDIndex {
+		list = append(list, item)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID() < list[j].ID() })
+	return list
+}
+
 // CreateBackendAPI create remote backendAPI
 func (b *BackendAPIRemoteIndex) CreateBackendAPI(params threescaleapi.Params) (*BackendAPIEntity, error) {
 	backendObj, err := b.client.CreateBackendApi(params)
@@ -0,0 +1,79 @@
+package helper
+
+import (
+	"sort"
+	"sync"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+)
+
+// ChangePlanner records the changes required to synchronize a 3scale object with the spec.
+// When planning is enabled, changes are recorded and must not be applied to 3scale.
+// Safe for concurrent use by the sync tasks.
+type ChangePlanner struct {
+	enabled bool
+	mu      sync.Mutex
+	changes []capabilitiesv1beta1.PlannedChange
+}
+
+// NewChangePlanner ChangePlanner constructor.
+// enabled is expected to be true in dry run mode.
+func NewChangePlanner(enabled bool) *ChangePlanner {
+	return &ChangePlanner{
+		enabled: enabled,
+		changes: []capabilitiesv1beta1.PlannedChange{},
+	}
+}
+
+// Skip records the change, when planning is enabled,
+// and returns true when the change must not be applied to 3scale.
+// The param names of updates are recorded as the changed fields.
+func (p *ChangePlanner) Skip(section, action, name string, params threescaleapi.Params) bool {
+	if !p.enabled {
+		return false
+	}
+
+	change := capabilitiesv1beta1.PlannedChange{
+		Section: section,
+		Action:  action,
+		Name:    name,
+	}
+
+	if action == capabilitiesv1beta1.PlannedChangeUpdate && len(params) > 0 {
+		change.Fields = make([]string, 0, len(params))
+		for field := range params {
+			change.Fields = append(change.Fields, field)
+		}
+		sort.Strings(change.Fields)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.changes = append(p.changes, change)
+	return true
+}
+
+// Enabled returns true in dry run mode
+func (p *ChangePlanner) Enabled() bool {
+	return p.enabled
+}
+
+// Changes returns the planned changes sorted by section, action and name
+func (p *ChangePlanner) Changes() []capabilitiesv1beta1.PlannedChange {
+	p.mu.Lock()
+	changes := make([]capabilitiesv1beta1.PlannedChange, len(p.changes))
+	copy(changes, p.changes)
+	p.mu.Unlock()
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Section != changes[j].Section {
+			return changes[i].Section < changes[j].Section
+		}
+		if changes[i].Action != changes[j].Action {
+			return changes[i].Action < changes[j].Action
+		}
+		return changes[i].Name < changes[j].Name
+	})
+	return changes
+}
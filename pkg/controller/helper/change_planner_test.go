@@ -0,0 +1,45 @@
+package helper
+
+import (
+	"testing"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestChangePlanner(t *testing.T) {
+	cases := []struct {
+		name            string
+		enabled         bool
+		expectedSkip    bool
+		expectedChanges []capabilitiesv1beta1.PlannedChange
+	}{
+		{"disabled", false, false, []capabilitiesv1beta1.PlannedChange{}},
+		{"enabled", true, true, []capabilitiesv1beta1.PlannedChange{
+			{Section: DriftSectionMappingRules, Action: capabilitiesv1beta1.PlannedChangeCreate, Name: "GET:/pets"},
+			{Section: DriftSectionMetrics, Action: capabilitiesv1beta1.PlannedChangeDelete, Name: "hits2"},
+			{Section: DriftSectionMetrics, Action: capabilitiesv1beta1.PlannedChangeUpdate, Name: "hits", Fields: []string{"friendly_name", "unit"}},
+		}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(subT *testing.T) {
+			planner := NewChangePlanner(tc.enabled)
+			skips := []bool{
+				planner.Skip(DriftSectionMetrics, capabilitiesv1beta1.PlannedChangeUpdate, "hits", threescaleapi.Params{"unit": "hit", "friendly_name": "Hits"}),
+				planner.Skip(DriftSectionMetrics, capabilitiesv1beta1.PlannedChangeDelete, "hits2", nil),
+				planner.Skip(DriftSectionMappingRules, capabilitiesv1beta1.PlannedChangeCreate, "GET:/pets", threescaleapi.Params{"pattern": "/pets"}),
+			}
+			for idx, skip := range skips {
+				if skip != tc.expectedSkip {
+					subT.Errorf("change %d: expected skip %t, got %t", idx, tc.expectedSkip, skip)
+				}
+			}
+			if !cmp.Equal(planner.Changes(), tc.expectedChanges) {
+				subT.Errorf("diff %s", cmp.Diff(planner.Changes(), tc.expectedChanges))
+			}
+		})
+	}
+}
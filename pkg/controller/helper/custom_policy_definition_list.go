@@ -0,0 +1,45 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	controllerclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CustomPolicyDefinitionList returns a list of custom policy definition custom resources where all elements:
+// - Same 3scale provider Account
+// Sync state is not filtered
+func CustomPolicyDefinitionList(ns string, cl client.Client, providerAccount *ProviderAccount, logger logr.Logger) ([]capabilitiesv1beta1.CustomPolicyDefinition, error) {
+	policyList := &capabilitiesv1beta1.CustomPolicyDefinitionList{}
+	opts := []controllerclient.ListOption{
+		controllerclient.InNamespace(ns),
+	}
+	err := cl.List(context.TODO(), policyList, opts...)
+	logger.V(1).Info("Get list of CustomPolicyDefinition resources.", "Err", err)
+	if err != nil {
+		return nil, fmt.Errorf("CustomPolicyDefinitionList: %w", err)
+	}
+	logger.V(1).Info("CustomPolicyDefinition resources", "total", len(policyList.Items))
+
+	validPolicies := make([]capabilitiesv1beta1.CustomPolicyDefinition, 0)
+	for idx := range policyList.Items {
+		policyProviderAccount, err := LookupProviderAccount(cl, policyList.Items[idx].Namespace, policyList.Items[idx].Spec.ProviderAccountRef, logger)
+		if err != nil {
+			return nil, fmt.Errorf("CustomPolicyDefinitionList: %w", err)
+		}
+
+		// Filter by provider account
+		if providerAccount.AdminURLStr != policyProviderAccount.AdminURLStr {
+			continue
+		}
+		validPolicies = append(validPolicies, policyList.Items[idx])
+	}
+
+	logger.V(1).Info("CustomPolicyDefinition valid resources", "total", len(validPolicies))
+	return validPolicies, nil
+}
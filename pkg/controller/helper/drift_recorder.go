@@ -0,0 +1,72 @@
+package helper
+
+import (
+	"sort"
+	"sync"
+)
+
+// Drifted sections of products and backends
+const (
+	DriftSectionProduct          = "product"
+	DriftSectionBackend          = "backend"
+	DriftSectionBackendUsages    = "backendUsages"
+	DriftSectionProxy            = "proxy"
+	DriftSectionPolicies         = "policies"
+	DriftSectionMethods          = "methods"
+	DriftSectionMetrics          = "metrics"
+	DriftSectionMappingRules     = "mappingRules"
+	DriftSectionFeatures         = "features"
+	DriftSectionApplicationPlans = "applicationPlans"
+	DriftSectionServicePlans     = "servicePlans"
+)
+
+// DriftRecorder records the sections of a 3scale object that differ from an already synchronized spec.
+// Differences found while the spec has not been synchronized yet are spec changes, not drift.
+// Safe for concurrent use by the sync tasks.
+type DriftRecorder struct {
+	detect     bool
+	reportOnly bool
+	mu         sync.Mutex
+	sections   map[string]bool
+}
+
+// NewDriftRecorder DriftRecorder constructor.
+// detect is expected to be true when the current spec generation has already been synchronized.
+// reportOnly is expected to be true when drift must not be corrected.
+func NewDriftRecorder(detect, reportOnly bool) *DriftRecorder {
+	return &DriftRecorder{
+		detect:     detect,
+		reportOnly: reportOnly,
+		sections:   map[string]bool{},
+	}
+}
+
+// SkipCorrection records the section as drifted, when drift detection is enabled,
+// and returns true when the change must not be applied to 3scale
+func (d *DriftRecorder) SkipCorrection(section string) bool {
+	if !d.detect {
+		return false
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.sections[section] = true
+	return d.reportOnly
+}
+
+// Sections returns the sorted list of drifted sections
+func (d *DriftRecorder) Sections() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	sections := make([]string, 0, len(d.sections))
+	for section := range d.sections {
+		sections = append(sections, section)
+	}
+	sort.Strings(sections)
+	return sections
+}
+
+// ReportOnly returns true when drift is reported and not corrected
+func (d *DriftRecorder) ReportOnly() bool {
+	return d.reportOnly
+}
@@ -0,0 +1,36 @@
+package helper
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDriftRecorder(t *testing.T) {
+	cases := []struct {
+		name             string
+		detect           bool
+		reportOnly       bool
+		expectedSkip     bool
+		expectedSections []string
+	}{
+		{"spec change", false, false, false, []string{}},
+		{"spec change with report policy", false, true, false, []string{}},
+		{"drift corrected", true, false, false, []string{"mappingRules", "metrics"}},
+		{"drift reported", true, true, true, []string{"mappingRules", "metrics"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(subT *testing.T) {
+			recorder := NewDriftRecorder(tc.detect, tc.reportOnly)
+			for _, section := range []string{DriftSectionMetrics, DriftSectionMappingRules, DriftSectionMetrics} {
+				if skip := recorder.SkipCorrection(section); skip != tc.expectedSkip {
+					subT.Errorf("section %s: expected skip %t, got %t", section, tc.expectedSkip, skip)
+				}
+			}
+			if !cmp.Equal(recorder.Sections(), tc.expectedSections) {
+				subT.Errorf("diff %s", cmp.Diff(recorder.Sections(), tc.expectedSections))
+			}
+		})
+	}
+}
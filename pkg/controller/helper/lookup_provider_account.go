@@ -2,16 +2,20 @@ package helper
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 
 	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
 	appsv1alpha1 "github.com/3scale/3scale-operator/pkg/apis/apps/v1alpha1"
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
 	"github.com/3scale/3scale-operator/pkg/helper"
 
 	"github.com/go-logr/logr"
-	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -26,16 +30,33 @@ var (
 	providerAccountSecretTokenFieldName = "token"
 )
 
-type providerAccountSource func(cl client.Client, ns string, providerAccountRef *corev1.LocalObjectReference, logger logr.Logger) (*ProviderAccount, error)
+// ErrProviderAccountNotFound is returned when no provider account credentials are found
+var ErrProviderAccountNotFound = errors.New("no provider account found")
+
+// IsProviderAccountNotFound returns true when the provider account credentials, or the resources holding them, do not exist
+func IsProviderAccountNotFound(err error) bool {
+	if errors.Is(err, ErrProviderAccountNotFound) {
+		return true
+	}
+
+	var statusErr *apierrors.StatusError
+	return errors.As(err, &statusErr) && apierrors.IsNotFound(statusErr)
+}
+
+type providerAccountSource func(cl client.Client, ns string, providerAccountRef *capabilitiesv1beta1.ProviderAccountReference, logger logr.Logger) (*ProviderAccount, error)
 
 // LookupProviderAccount looks up for account provider url and credentials
-// If provider_account_reference is provided, it must exist and required fields must exists
+// If provider_account_reference is provided and a ProviderAccount resource with that name exists,
+// the admin URL, token and TLS settings of the ProviderAccount resource are used.
+// ProviderAccount resources in another namespace must exist and allow the namespace of the referencing resource.
+// Otherwise, if provider_account_reference is provided, the secret must exist and required fields must exists
 // If no provider_account_reference is provided, defaul provider account secret with hardcoded name will be looked up in the namespace.
 // If no provider_account_reference is provided AND default provider account secret is not found either, then,
 // 3scale default provider account (3scale-admin) will be looked up using system-seed secret in the current namespace.
 // If nothing is successfully found, return error
-func LookupProviderAccount(cl client.Client, ns string, providerAccountRef *corev1.LocalObjectReference, logger logr.Logger) (*ProviderAccount, error) {
+func LookupProviderAccount(cl client.Client, ns string, providerAccountRef *capabilitiesv1beta1.ProviderAccountReference, logger logr.Logger) (*ProviderAccount, error) {
 	orderedSources := []providerAccountSource{
+		providerAccountFromResourceReferenceSource,
 		providerAccountFromSecretReferenceSource,
 		providerAccountFromDefaultSecretSource,
 		providerAccountFromLocal3scaleSource,
@@ -54,10 +75,83 @@ func LookupProviderAccount(cl client.Client, ns string, providerAccountRef *core
 	}
 
 	// not found, return error
-	return nil, errors.New("LookupProviderAccount: no provider account found")
+	return nil, fmt.Errorf("LookupProviderAccount: %w", ErrProviderAccountNotFound)
+}
+
+// ProviderAccountFromResource reads the admin URL, token and TLS settings of the ProviderAccount resource
+func ProviderAccountFromResource(cl client.Client, resource *capabilitiesv1beta1.ProviderAccount) (*ProviderAccount, error) {
+	secretSource := helper.NewSecretSource(cl, resource.Namespace)
+	token, err := secretSource.RequiredFieldValueFromRequiredSecret(resource.Spec.TokenSecretRef.Name, resource.Spec.TokenSecretRef.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{}
+	if resource.Spec.TLS != nil {
+		tlsConfig.InsecureSkipVerify = resource.Spec.TLS.InsecureSkipVerify
+
+		if resource.Spec.TLS.CACertificateRef != nil {
+			caCertificate, err := secretSource.RequiredFieldValueFromRequiredSecret(resource.Spec.TLS.CACertificateRef.Name, resource.Spec.TLS.CACertificateRef.Key)
+			if err != nil {
+				return nil, err
+			}
+
+			// CA certificates are trusted in addition to the system ones
+			rootCAs, err := x509.SystemCertPool()
+			if err != nil || rootCAs == nil {
+				rootCAs = x509.NewCertPool()
+			}
+
+			if !rootCAs.AppendCertsFromPEM([]byte(caCertificate)) {
+				return nil, fmt.Errorf("no valid PEM encoded CA certificate found in secret '%s' key '%s'", resource.Spec.TLS.CACertificateRef.Name, resource.Spec.TLS.CACertificateRef.Key)
+			}
+
+			tlsConfig.RootCAs = rootCAs
+		}
+	}
+
+	return &ProviderAccount{AdminURLStr: resource.Spec.AdminURL, Token: token, TLSConfig: tlsConfig}, nil
+}
+
+func providerAccountFromResourceReferenceSource(cl client.Client, ns string, providerAccountRef *capabilitiesv1beta1.ProviderAccountReference, logger logr.Logger) (*ProviderAccount, error) {
+	if providerAccountRef == nil {
+		return nil, nil
+	}
+
+	namespace := ns
+	if providerAccountRef.Namespace != "" {
+		namespace = providerAccountRef.Namespace
+	}
+
+	resource := &capabilitiesv1beta1.ProviderAccount{}
+	err := cl.Get(context.TODO(), types.NamespacedName{Name: providerAccountRef.Name, Namespace: namespace}, resource)
+	if err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			// Provider account secrets are not shared across namespaces
+			if namespace != ns {
+				return nil, fmt.Errorf("providerAccountFromResourceReferenceSource: ProviderAccount '%s/%s': %w", namespace, providerAccountRef.Name, ErrProviderAccountNotFound)
+			}
+
+			// Not found or ProviderAccount CRD not installed, look up the provider account secret
+			return nil, nil
+		}
+		return nil, fmt.Errorf("providerAccountFromResourceReferenceSource: %w", err)
+	}
+
+	if !resource.AllowsNamespace(ns) {
+		return nil, fmt.Errorf("providerAccountFromResourceReferenceSource: ProviderAccount '%s/%s' does not allow references from namespace '%s'", namespace, providerAccountRef.Name, ns)
+	}
+
+	logger.Info("LookupProviderAccount ProviderAccount resource found", "ns", namespace, "providerAccountRef", providerAccountRef)
+	providerAccount, err := ProviderAccountFromResource(cl, resource)
+	if err != nil {
+		return nil, fmt.Errorf("providerAccountFromResourceReferenceSource: %w", err)
+	}
+
+	return providerAccount, nil
 }
 
-func providerAccountFromSecretReferenceSource(cl client.Client, ns string, providerAccountRef *corev1.LocalObjectReference, logger logr.Logger) (*ProviderAccount, error) {
+func providerAccountFromSecretReferenceSource(cl client.Client, ns string, providerAccountRef *capabilitiesv1beta1.ProviderAccountReference, logger logr.Logger) (*ProviderAccount, error) {
 	if providerAccountRef != nil {
 		logger.Info("LookupProviderAccount", "ns", ns, "providerAccountRef", providerAccountRef)
 		secretSource := helper.NewSecretSource(cl, ns)
@@ -76,7 +170,7 @@ func providerAccountFromSecretReferenceSource(cl client.Client, ns string, provi
 	return nil, nil
 }
 
-func providerAccountFromDefaultSecretSource(cl client.Client, ns string, providerAccountRef *corev1.LocalObjectReference, logger logr.Logger) (*ProviderAccount, error) {
+func providerAccountFromDefaultSecretSource(cl client.Client, ns string, providerAccountRef *capabilitiesv1beta1.ProviderAccountReference, logger logr.Logger) (*ProviderAccount, error) {
 	// if exists, fiels are required.
 	defaulSecret, err := helper.GetSecret(providerAccountDefaultSecretName, ns, cl)
 	if err == nil {
@@ -98,7 +192,7 @@ func providerAccountFromDefaultSecretSource(cl client.Client, ns string, provide
 }
 
 // Lookup default provider account for the 3scale deployment in the current namespace
-func providerAccountFromLocal3scaleSource(cl client.Client, ns string, providerAccountRef *corev1.LocalObjectReference, logger logr.Logger) (*ProviderAccount, error) {
+func providerAccountFromLocal3scaleSource(cl client.Client, ns string, providerAccountRef *capabilitiesv1beta1.ProviderAccountReference, logger logr.Logger) (*ProviderAccount, error) {
 	// Read credentials and tenant url for default provider account of 3scale
 	listOps := []client.ListOption{client.InNamespace(ns)}
 	apimanagerList := &appsv1alpha1.APIManagerList{}
@@ -0,0 +1,211 @@
+package helper
+
+import (
+	"testing"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestLookupProviderAccountFromResource(t *testing.T) {
+	namespace := "operator-unittest"
+
+	s := scheme.Scheme
+	err := capabilitiesv1beta1.SchemeBuilder.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tokenSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant-token", Namespace: namespace},
+		Data:       map[string][]byte{"token": []byte("resourcetoken")},
+	}
+	providerAccountSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant-secret", Namespace: namespace},
+		Data: map[string][]byte{
+			providerAccountSecretURLFieldName:   []byte("https://secret-admin.example.com"),
+			providerAccountSecretTokenFieldName: []byte("secrettoken"),
+		},
+	}
+	providerAccountResource := &capabilitiesv1beta1.ProviderAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant", Namespace: namespace},
+		Spec: capabilitiesv1beta1.ProviderAccountSpec{
+			AdminURL: "https://resource-admin.example.com",
+			TokenSecretRef: corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "tenant-token"},
+				Key:                  "token",
+			},
+		},
+	}
+
+	sharedTokenSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant-token", Namespace: "shared"},
+		Data:       map[string][]byte{"token": []byte("sharedtoken")},
+	}
+	sharedProviderAccountResource := &capabilitiesv1beta1.ProviderAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant", Namespace: "shared"},
+		Spec: capabilitiesv1beta1.ProviderAccountSpec{
+			AdminURL: "https://shared-admin.example.com",
+			TokenSecretRef: corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "tenant-token"},
+				Key:                  "token",
+			},
+			AllowedNamespaces: []string{namespace},
+		},
+	}
+	restrictedProviderAccountResource := &capabilitiesv1beta1.ProviderAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: "restricted", Namespace: "shared"},
+		Spec: capabilitiesv1beta1.ProviderAccountSpec{
+			AdminURL: "https://shared-admin.example.com",
+			TokenSecretRef: corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "tenant-token"},
+				Key:                  "token",
+			},
+		},
+	}
+	sharedProviderAccountSecret := providerAccountSecret.DeepCopy()
+	sharedProviderAccountSecret.Namespace = "shared"
+
+	objs := []runtime.Object{
+		tokenSecret, providerAccountSecret, providerAccountResource,
+		sharedTokenSecret, sharedProviderAccountResource, restrictedProviderAccountResource, sharedProviderAccountSecret,
+	}
+	cl := fake.NewFakeClientWithScheme(s, objs...)
+	logger := logf.Log.WithName("test")
+
+	cases := []struct {
+		testName         string
+		ref              capabilitiesv1beta1.ProviderAccountReference
+		expectErr        bool
+		expectedAdminURL string
+		expectedToken    string
+		expectedTLS      bool
+	}{
+		{"ProviderAccount resource", capabilitiesv1beta1.ProviderAccountReference{Name: "tenant"}, false, "https://resource-admin.example.com", "resourcetoken", true},
+		{"provider account secret", capabilitiesv1beta1.ProviderAccountReference{Name: "tenant-secret"}, false, "https://secret-admin.example.com", "secrettoken", false},
+		{"allowed ProviderAccount resource from another namespace", capabilitiesv1beta1.ProviderAccountReference{Name: "tenant", Namespace: "shared"}, false, "https://shared-admin.example.com", "sharedtoken", true},
+		{"not allowed ProviderAccount resource from another namespace", capabilitiesv1beta1.ProviderAccountReference{Name: "restricted", Namespace: "shared"}, true, "", "", false},
+		{"provider account secret from another namespace", capabilitiesv1beta1.ProviderAccountReference{Name: "tenant-secret", Namespace: "shared"}, true, "", "", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			providerAccount, err := LookupProviderAccount(cl, namespace, &tc.ref, logger)
+			if tc.expectErr {
+				if err == nil {
+					subT.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				subT.Fatal(err)
+			}
+			if providerAccount.AdminURLStr != tc.expectedAdminURL {
+				subT.Errorf("unexpected admin URL: %s", providerAccount.AdminURLStr)
+			}
+			if providerAccount.Token != tc.expectedToken {
+				subT.Errorf("unexpected token: %s", providerAccount.Token)
+			}
+			if (providerAccount.TLSConfig != nil) != tc.expectedTLS {
+				subT.Errorf("unexpected TLS config: %v", providerAccount.TLSConfig)
+			}
+		})
+	}
+}
+
+func TestProviderAccountFromResourceInvalidCACertificate(t *testing.T) {
+	namespace := "operator-unittest"
+
+	s := scheme.Scheme
+	err := capabilitiesv1beta1.SchemeBuilder.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant", Namespace: namespace},
+		Data: map[string][]byte{
+			"token":  []byte("token"),
+			"ca.crt": []byte("not a certificate"),
+		},
+	}
+	resource := &capabilitiesv1beta1.ProviderAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant", Namespace: namespace},
+		Spec: capabilitiesv1beta1.ProviderAccountSpec{
+			AdminURL: "https://admin.example.com",
+			TokenSecretRef: corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "tenant"},
+				Key:                  "token",
+			},
+			TLS: &capabilitiesv1beta1.ProviderAccountTLSSpec{
+				CACertificateRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "tenant"},
+					Key:                  "ca.crt",
+				},
+			},
+		},
+	}
+
+	cl := fake.NewFakeClientWithScheme(s, secret)
+	_, err = ProviderAccountFromResource(cl, resource)
+	if err == nil {
+		t.Fatal("expected error for invalid CA certificate")
+	}
+}
+
+func TestIsProviderAccountNotFound(t *testing.T) {
+	namespace := "operator-unittest"
+
+	s := scheme.Scheme
+	err := capabilitiesv1beta1.SchemeBuilder.AddToScheme(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The token secret of the ProviderAccount resource has been deleted
+	resource := &capabilitiesv1beta1.ProviderAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant", Namespace: namespace},
+		Spec: capabilitiesv1beta1.ProviderAccountSpec{
+			AdminURL: "https://admin.example.com",
+			TokenSecretRef: corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "tenant-token"},
+				Key:                  "token",
+			},
+		},
+	}
+	restricted := resource.DeepCopy()
+	restricted.Name = "restricted"
+	restricted.Namespace = "shared"
+
+	cl := fake.NewFakeClientWithScheme(s, resource, restricted)
+	logger := logf.Log.WithName("test")
+
+	cases := []struct {
+		testName string
+		ref      capabilitiesv1beta1.ProviderAccountReference
+		expected bool
+	}{
+		{"missing token secret", capabilitiesv1beta1.ProviderAccountReference{Name: "tenant"}, true},
+		{"missing provider account secret", capabilitiesv1beta1.ProviderAccountReference{Name: "tenant-secret"}, true},
+		{"missing ProviderAccount resource from another namespace", capabilitiesv1beta1.ProviderAccountReference{Name: "tenant", Namespace: "other"}, true},
+		{"not allowed ProviderAccount resource from another namespace", capabilitiesv1beta1.ProviderAccountReference{Name: "restricted", Namespace: "shared"}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			_, err := LookupProviderAccount(cl, namespace, &tc.ref, logger)
+			if err == nil {
+				subT.Fatal("expected error")
+			}
+			if notFound := IsProviderAccountNotFound(err); notFound != tc.expected {
+				subT.Errorf("expected not found %t, got %t: %v", tc.expected, notFound, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package helper
+
+import (
+	"context"
+	"net/http"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	"github.com/3scale/3scale-operator/pkg/common"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ClaimSystemName records on the resource the system name of the 3scale object it is about to create.
+// The claim keeps the ownership of the 3scale object when the creation response or the ID recorded in the status are lost
+func ClaimSystemName(ctx context.Context, k8sClient client.Client, obj common.KubernetesObject, systemName string) error {
+	return Claim(ctx, k8sClient, obj, capabilitiesv1beta1.ClaimedSystemNameAnnotation, systemName)
+}
+
+// ReleaseSystemNameClaim removes the claim of the resource when 3scale rejected the creation.
+// 3scale objects created later by others with the same system name are not owned
+func ReleaseSystemNameClaim(ctx context.Context, k8sClient client.Client, obj common.KubernetesObject) error {
+	return ReleaseClaim(ctx, k8sClient, obj, capabilitiesv1beta1.ClaimedSystemNameAnnotation)
+}
+
+// Claim records in the claim annotation the identifier of the 3scale object the resource is about to create
+func Claim(ctx context.Context, k8sClient client.Client, obj common.KubernetesObject, annotation, value string) error {
+	if obj.GetAnnotations()[annotation] == value {
+		return nil
+	}
+
+	orig := obj.DeepCopyObject()
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[annotation] = value
+	obj.SetAnnotations(annotations)
+
+	// Merge patches do not conflict with concurrent updates of the resource
+	return k8sClient.Patch(ctx, obj, client.MergeFrom(orig))
+}
+
+// ReleaseClaim removes the claim annotation of the resource
+func ReleaseClaim(ctx context.Context, k8sClient client.Client, obj common.KubernetesObject, annotation string) error {
+	if _, ok := obj.GetAnnotations()[annotation]; !ok {
+		return nil
+	}
+
+	orig := obj.DeepCopyObject()
+	annotations := obj.GetAnnotations()
+	delete(annotations, annotation)
+	obj.SetAnnotations(annotations)
+
+	return k8sClient.Patch(ctx, obj, client.MergeFrom(orig))
+}
+
+// IsCreationRejected returns true when 3scale answered the creation request with a client error,
+// thus the 3scale object was not created. Network errors and server errors are not conclusive
+func IsCreationRejected(err error) bool {
+	code := ThreescaleAPIErrorCode(err)
+	return code >= http.StatusBadRequest && code < http.StatusInternalServerError
+}
+
+// PatchStatus sends the status changes made by mutateFn as a merge patch.
+// Used to record the ID of created 3scale objects right away, not conflicting with concurrent updates
+func PatchStatus(ctx context.Context, k8sClient client.Client, obj common.KubernetesObject, mutateFn func()) error {
+	orig := obj.DeepCopyObject()
+	mutateFn()
+	return k8sClient.Status().Patch(ctx, obj, client.MergeFrom(orig))
+}
@@ -11,19 +11,23 @@ import (
 )
 
 type ProductEntity struct {
-	client            *threescaleapi.ThreeScaleClient
+	client            *ThreescaleAPIClient
 	productObj        *threescaleapi.Product
 	metrics           *threescaleapi.MetricJSONList
 	metricsAndMethods *threescaleapi.MetricJSONList
 	methods           *threescaleapi.MethodList
 	mappingRules      *threescaleapi.MappingRuleJSONList
 	backendUsages     threescaleapi.BackendAPIUsageList
-	proxy             *threescaleapi.ProxyJSON
+	proxy             *ProxyExtJSON
+	oidcConfiguration *OIDCConfiguration
+	policies          *PoliciesConfigList
+	features          *FeatureJSONList
 	plans             *threescaleapi.ApplicationPlanJSONList
+	servicePlans      *ServicePlanList
 	logger            logr.Logger
 }
 
-func NewProductEntity(obj *threescaleapi.Product, cl *threescaleapi.ThreeScaleClient, logger logr.Logger) *ProductEntity {
+func NewProductEntity(obj *threescaleapi.Product, cl *ThreescaleAPIClient, logger logr.Logger) *ProductEntity {
 	return &ProductEntity{
 		productObj: obj,
 		client:     cl,
@@ -39,6 +43,10 @@ func (b *ProductEntity) Name() string {
 	return b.productObj.Element.Name
 }
 
+func (b *ProductEntity) SystemName() string {
+	return b.productObj.Element.SystemName
+}
+
 func (b *ProductEntity) State() string {
 	return b.productObj.Element.State
 }
@@ -51,8 +59,9 @@ func (b *ProductEntity) DeploymentOption() string {
 	return b.productObj.Element.DeploymentOption
 }
 
+// BackendVersion returns the authentication mode of the product, i.e. "1", "2" or "oidc"
 func (b *ProductEntity) BackendVersion() string {
-	return b.productObj.Element.DeploymentOption
+	return b.productObj.Element.BackendVersion
 }
 
 func (b *ProductEntity) Update(params threescaleapi.Params) error {
@@ -67,6 +76,16 @@ func (b *ProductEntity) Update(params threescaleapi.Params) error {
 	return nil
 }
 
+func (b *ProductEntity) Delete() error {
+	b.logger.V(1).Info("Delete")
+	err := b.client.DeleteProduct(b.productObj.Element.ID)
+	if err != nil {
+		return fmt.Errorf("product [%s] delete request: %w", b.productObj.Element.SystemName, err)
+	}
+
+	return nil
+}
+
 func (b *ProductEntity) Methods() (*threescaleapi.MethodList, error) {
 	b.logger.V(1).Info("Methods")
 	if b.methods == nil {
@@ -282,7 +301,7 @@ func (b *ProductEntity) CreateBackendUsage(params threescaleapi.Params) error {
 	return nil
 }
 
-func (b *ProductEntity) Proxy() (*threescaleapi.ProxyJSON, error) {
+func (b *ProductEntity) Proxy() (*ProxyExtJSON, error) {
 	b.logger.V(1).Info("Proxy")
 	if b.proxy == nil {
 		proxy, err := b.getProxy()
@@ -296,7 +315,7 @@ func (b *ProductEntity) Proxy() (*threescaleapi.ProxyJSON, error) {
 
 func (b *ProductEntity) UpdateProxy(params threescaleapi.Params) error {
 	b.logger.V(1).Info("UpdateProxy", "params", params)
-	updated, err := b.client.UpdateProductProxy(b.productObj.Element.ID, params)
+	updated, err := b.client.UpdateProductProxyExt(b.productObj.Element.ID, params)
 	if err != nil {
 		return fmt.Errorf("product [%s] update proxy: %w", b.productObj.Element.SystemName, err)
 	}
@@ -305,6 +324,111 @@ func (b *ProductEntity) UpdateProxy(params threescaleapi.Params) error {
 	return nil
 }
 
+func (b *ProductEntity) OIDCConfiguration() (*OIDCConfiguration, error) {
+	b.logger.V(1).Info("OIDCConfiguration")
+	if b.oidcConfiguration == nil {
+		oidcConf, err := b.getOIDCConfiguration()
+		if err != nil {
+			return nil, err
+		}
+		b.oidcConfiguration = oidcConf
+	}
+	return b.oidcConfiguration, nil
+}
+
+func (b *ProductEntity) UpdateOIDCConfiguration(params threescaleapi.Params) error {
+	b.logger.V(1).Info("UpdateOIDCConfiguration", "params", params)
+	updated, err := b.client.UpdateProductOIDCConfiguration(b.productObj.Element.ID, params)
+	if err != nil {
+		return fmt.Errorf("product [%s] update oidc configuration: %w", b.productObj.Element.SystemName, err)
+	}
+
+	b.oidcConfiguration = updated
+	return nil
+}
+
+func (b *ProductEntity) Features() (*FeatureJSONList, error) {
+	b.logger.V(1).Info("Features")
+	if b.features == nil {
+		features, err := b.getFeatures()
+		if err != nil {
+			return nil, err
+		}
+		b.features = features
+	}
+	return b.features, nil
+}
+
+func (b *ProductEntity) CreateFeature(params threescaleapi.Params) error {
+	b.logger.V(1).Info("CreateFeature", "params", params)
+	_, err := b.client.CreateProductFeature(b.productObj.Element.ID, params)
+	if err != nil {
+		return fmt.Errorf("product [%s] create feature: %w", b.productObj.Element.SystemName, err)
+	}
+	b.resetFeatures()
+	return nil
+}
+
+func (b *ProductEntity) UpdateFeature(id int64, params threescaleapi.Params) error {
+	b.logger.V(1).Info("UpdateFeature", "ID", id, "params", params)
+	_, err := b.client.UpdateProductFeature(b.productObj.Element.ID, id, params)
+	if err != nil {
+		return fmt.Errorf("product [%s] update feature: %w", b.productObj.Element.SystemName, err)
+	}
+	b.resetFeatures()
+	return nil
+}
+
+func (b *ProductEntity) DeleteFeature(id int64) error {
+	b.logger.V(1).Info("DeleteFeature", "ID", id)
+	err := b.client.DeleteProductFeature(b.productObj.Element.ID, id)
+	if err != nil {
+		return fmt.Errorf("product [%s] delete feature: %w", b.productObj.Element.SystemName, err)
+	}
+	b.resetFeatures()
+	return nil
+}
+
+// FindFeatureIDBySystemName returns feature ID from system name.
+// -1 if feature is not found
+func (b *ProductEntity) FindFeatureIDBySystemName(systemName string) (int64, error) {
+	featureList, err := b.Features()
+	if err != nil {
+		return -1, err
+	}
+
+	for _, feature := range featureList.Features {
+		if feature.Element.SystemName == systemName {
+			return feature.Element.ID, nil
+		}
+	}
+
+	return -1, nil
+}
+
+func (b *ProductEntity) Policies() (*PoliciesConfigList, error) {
+	b.logger.V(1).Info("Policies")
+	if b.policies == nil {
+		policies, err := b.getPolicies()
+		if err != nil {
+			return nil, err
+		}
+		b.policies = policies
+	}
+	return b.policies, nil
+}
+
+func (b *ProductEntity) UpdatePolicies(params threescaleapi.Params) error {
+	b.logger.V(1).Info("UpdatePolicies", "params", params)
+	updated, err := b.client.UpdateProductPolicies(b.productObj.Element.ID, params)
+	if err != nil {
+		return fmt.Errorf("product [%s] update policies: %w", b.productObj.Element.SystemName, err)
+	}
+
+	b.policies = updated
+	return nil
+}
+
 func (b *ProductEntity) ApplicationPlans() (*threescaleapi.ApplicationPlanJSONList, error) {
 	b.logger.V(1).Info("ApplicationPlans")
 	if b.plans == nil {
@@ -337,14 +461,71 @@ func (b *ProductEntity) CreateApplicationPlan(params threescaleapi.Params) (*thr
 	return obj, nil
 }
 
+func (b *ProductEntity) ServicePlans() (*ServicePlanList, error) {
+	b.logger.V(1).Info("ServicePlans")
+	if b.servicePlans == nil {
+		plans, err := b.getServicePlans()
+		if err != nil {
+			return nil, err
+		}
+		b.servicePlans = plans
+	}
+	return b.servicePlans, nil
+}
+
+func (b *ProductEntity) DeleteServicePlan(id int64) error {
+	b.logger.V(1).Info("DeleteServicePlan", "ID", id)
+	err := b.client.DeleteServicePlan(b.productObj.Element.ID, id)
+	if err != nil {
+		return fmt.Errorf("product [%s] delete service plan: %w", b.productObj.Element.SystemName, err)
+	}
+	b.resetServicePlans()
+	return nil
+}
+
+func (b *ProductEntity) CreateServicePlan(params threescaleapi.Params) (*ServicePlan, error) {
+	b.logger.V(1).Info("CreateServicePlan", "params", params)
+	obj, err := b.client.CreateServicePlan(b.productObj.Element.ID, params)
+	if err != nil {
+		return nil, fmt.Errorf("product [%s] create service plan: %w", b.productObj.Element.SystemName, err)
+	}
+	b.resetServicePlans()
+	return obj, nil
+}
+
 func (b *ProductEntity) PromoteProxyToStaging() error {
 	b.logger.V(1).Info("PromoteProxyToStaging")
-	proxyObj, err := b.client.DeployProductProxy(b.productObj.Element.ID)
+	_, err := b.client.DeployProductProxy(b.productObj.Element.ID)
 	if err != nil {
 		return fmt.Errorf("product [%s] promote proxy to staging: %w", b.productObj.Element.SystemName, err)
 	}
 
-	b.proxy = proxyObj
+	b.resetProxy()
+	return nil
+}
+
+// LatestProxyConfigVersion returns the latest proxy config version of the given environment.
+// Returns 0 when there is no proxy config in the environment.
+func (b *ProductEntity) LatestProxyConfigVersion(env string) (int64, error) {
+	b.logger.V(1).Info("LatestProxyConfigVersion", "environment", env)
+	obj, err := b.client.ProductLatestProxyConfig(b.productObj.Element.ID, env)
+	if IsThreescaleNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("product [%s] get latest %s proxy config: %w", b.productObj.Element.SystemName, env, err)
+	}
+
+	return obj.Element.Version, nil
+}
+
+func (b *ProductEntity) PromoteProxyConfigToProduction(version int64) error {
+	b.logger.V(1).Info("PromoteProxyConfigToProduction", "version", version)
+	_, err := b.client.PromoteProductProxyConfig(b.productObj.Element.ID, ProxyConfigStagingEnvironment, version, ProxyConfigProductionEnvironment)
+	if err != nil {
+		return fmt.Errorf("product [%s] promote proxy config version %d to production: %w", b.productObj.Element.SystemName, version, err)
+	}
+
 	return nil
 }
 
@@ -375,6 +556,18 @@ func (b *ProductEntity) resetApplicationPlans() {
 	b.plans = nil
 }
 
+func (b *ProductEntity) resetServicePlans() {
+	b.servicePlans = nil
+}
+
+func (b *ProductEntity) resetFeatures() {
+	b.features = nil
+}
+
+func (b *ProductEntity) resetProxy() {
+	b.proxy = nil
+}
+
 func (b *ProductEntity) getMethods() (*threescaleapi.MethodList, error) {
 	b.logger.V(1).Info("getMethods")
 	hitsID, err := b.getHitsID()
@@ -470,9 +663,9 @@ func (b *ProductEntity) getBackendUsages() (threescaleapi.BackendAPIUsageList, e
 	return list, nil
 }
 
-func (b *ProductEntity) getProxy() (*threescaleapi.ProxyJSON, error) {
+func (b *ProductEntity) getProxy() (*ProxyExtJSON, error) {
 	b.logger.V(1).Info("getProxy")
-	obj, err := b.client.ProductProxy(b.productObj.Element.ID)
+	obj, err := b.client.ProductProxyExt(b.productObj.Element.ID)
 	if err != nil {
 		return nil, fmt.Errorf("product [%s] get proxy: %w", b.productObj.Element.SystemName, err)
 	}
@@ -480,6 +673,36 @@ func (b *ProductEntity) getProxy() (*threescaleapi.ProxyJSON, error) {
 	return obj, nil
 }
 
+func (b *ProductEntity) getOIDCConfiguration() (*OIDCConfiguration, error) {
+	b.logger.V(1).Info("getOIDCConfiguration")
+	obj, err := b.client.ProductOIDCConfiguration(b.productObj.Element.ID)
+	if err != nil {
+		return nil, fmt.Errorf("product [%s] get oidc configuration: %w", b.productObj.Element.SystemName, err)
+	}
+
+	return obj, nil
+}
+
+func (b *ProductEntity) getFeatures() (*FeatureJSONList, error) {
+	b.logger.V(1).Info("getFeatures")
+	list, err := b.client.ListProductFeatures(b.productObj.Element.ID)
+	if err != nil {
+		return nil, fmt.Errorf("product [%s] get features: %w", b.productObj.Element.SystemName, err)
+	}
+
+	return list, nil
+}
+
+func (b *ProductEntity) getPolicies() (*PoliciesConfigList, error) {
+	b.logger.V(1).Info("getPolicies")
+	obj, err := b.client.ProductPolicies(b.productObj.Element.ID)
+	if err != nil {
+		return nil, fmt.Errorf("product [%s] get policies: %w", b.productObj.Element.SystemName, err)
+	}
+
+	return obj, nil
+}
+
 func (b *ProductEntity) getApplicationPlans() (*threescaleapi.ApplicationPlanJSONList, error) {
 	b.logger.V(1).Info("getApplicationPlans")
 	list, err := b.client.ListApplicationPlansByProduct(b.productObj.Element.ID)
@@ -489,3 +712,13 @@ func (b *ProductEntity) getApplicationPlans() (*threescaleapi.ApplicationPlanJSO
 
 	return list, nil
 }
+
+func (b *ProductEntity) getServicePlans() (*ServicePlanList, error) {
+	b.logger.V(1).Info("getServicePlans")
+	list, err := b.client.ListServicePlans(b.productObj.Element.ID)
+	if err != nil {
+		return nil, fmt.Errorf("product [%s] get service plans: %w", b.productObj.Element.SystemName, err)
+	}
+
+	return list, nil
+}
@@ -0,0 +1,23 @@
+package helper
+
+import (
+	"testing"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestProductEntityBackendVersion(t *testing.T) {
+	productObj := &threescaleapi.Product{Element: threescaleapi.ProductItem{
+		DeploymentOption: "hosted",
+		BackendVersion:   "oidc",
+	}}
+
+	entity := NewProductEntity(productObj, nil, logf.Log.WithName("test"))
+	if entity.BackendVersion() != "oidc" {
+		t.Fatalf("expected backend version oidc, got %s", entity.BackendVersion())
+	}
+	if entity.DeploymentOption() != "hosted" {
+		t.Fatalf("expected deployment option hosted, got %s", entity.DeploymentOption())
+	}
+}
@@ -14,6 +14,7 @@ import (
 // ProductList returns a list of product custom resources where all elements:
 // - Sync state (ensure remote product exist and in sync)
 // - Same 3scale provider Account
+// Empty namespace lists product custom resources of all the watched namespaces
 func ProductList(ns string, cl client.Client, providerAccount *ProviderAccount, logger logr.Logger) ([]capabilitiesv1beta1.Product, error) {
 	productList := &capabilitiesv1beta1.ProductList{}
 	opts := []controllerclient.ListOption{
@@ -33,7 +34,7 @@ func ProductList(ns string, cl client.Client, providerAccount *ProviderAccount,
 			continue
 		}
 
-		productProviderAccount, err := LookupProviderAccount(cl, ns, productList.Items[idx].Spec.ProviderAccountRef, logger)
+		productProviderAccount, err := LookupProviderAccount(cl, productList.Items[idx].Namespace, productList.Items[idx].Spec.ProviderAccountRef, logger)
 		if err != nil {
 			return nil, fmt.Errorf("ProductList: %w", err)
 		}
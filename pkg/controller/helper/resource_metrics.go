@@ -0,0 +1,34 @@
+package helper
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var resourceSynced = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "threescale_operator_resource_synced",
+		Help: "Sync state of the capabilities resources, 1 when synchronized with 3scale, 0 otherwise",
+	},
+	[]string{"kind", "namespace", "name"},
+)
+
+func init() {
+	crmetrics.Registry.MustRegister(resourceSynced)
+}
+
+// SetResourceSynced records the sync state of the capabilities resource
+func SetResourceSynced(kind string, obj metav1.Object, synced bool) {
+	value := 0.0
+	if synced {
+		value = 1
+	}
+	resourceSynced.WithLabelValues(kind, obj.GetNamespace(), obj.GetName()).Set(value)
+}
+
+// DeleteResourceSynced removes the sync state of the deleted capabilities resource
+func DeleteResourceSynced(kind string, nn types.NamespacedName) {
+	resourceSynced.DeleteLabelValues(kind, nn.Namespace, nn.Name)
+}
@@ -0,0 +1,32 @@
+package helper
+
+import (
+	"time"
+
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	"github.com/go-logr/logr"
+)
+
+const (
+	PRODUCT_RESYNC_PERIOD_ENVVAR         = "PRODUCT_RESYNC_PERIOD"
+	BACKEND_RESYNC_PERIOD_ENVVAR         = "BACKEND_RESYNC_PERIOD"
+	PROVIDERACCOUNT_RESYNC_PERIOD_ENVVAR = "PROVIDERACCOUNT_RESYNC_PERIOD"
+)
+
+// ResyncPeriod reads the periodic resync period from the environment variable in Go duration format, i.e. "10m".
+// Zero, periodic resync disabled, when not set or not valid.
+func ResyncPeriod(envVar string, logger logr.Logger) time.Duration {
+	value := helper.GetEnvVar(envVar, "")
+	if value == "" {
+		return 0
+	}
+
+	period, err := time.ParseDuration(value)
+	if err != nil || period < 0 {
+		logger.Info("invalid resync period, periodic resync disabled", envVar, value)
+		return 0
+	}
+
+	return period
+}
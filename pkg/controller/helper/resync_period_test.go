@@ -0,0 +1,35 @@
+package helper
+
+import (
+	"os"
+	"testing"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestResyncPeriod(t *testing.T) {
+	cases := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{"not set", "", "0s"},
+		{"valid", "10m", "10m0s"},
+		{"invalid", "ten minutes", "0s"},
+		{"negative", "-1m", "0s"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(subT *testing.T) {
+			envVar := "TEST_RESYNC_PERIOD"
+			if tc.value != "" {
+				os.Setenv(envVar, tc.value)
+				defer os.Unsetenv(envVar)
+			}
+			period := ResyncPeriod(envVar, logf.Log)
+			if period.String() != tc.expected {
+				subT.Errorf("expected %s, got %s", tc.expected, period)
+			}
+		})
+	}
+}
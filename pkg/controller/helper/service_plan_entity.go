@@ -0,0 +1,66 @@
+package helper
+
+import (
+	"fmt"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+
+	"github.com/go-logr/logr"
+)
+
+type ServicePlanEntity struct {
+	productID int64
+	client    *ThreescaleAPIClient
+	obj       ServicePlanItem
+	logger    logr.Logger
+}
+
+func NewServicePlanEntity(productID int64, obj ServicePlanItem, cl *ThreescaleAPIClient, logger logr.Logger) *ServicePlanEntity {
+	return &ServicePlanEntity{
+		productID: productID,
+		obj:       obj,
+		client:    cl,
+		logger:    logger.WithValues("ServicePlanEntity", obj.ID),
+	}
+}
+
+func (b *ServicePlanEntity) ID() int64 {
+	return b.obj.ID
+}
+
+func (b *ServicePlanEntity) Name() string {
+	return b.obj.Name
+}
+
+func (b *ServicePlanEntity) ApprovalRequired() bool {
+	return b.obj.ApprovalRequired
+}
+
+func (b *ServicePlanEntity) TrialPeriodDays() int {
+	return b.obj.TrialPeriodDays
+}
+
+func (b *ServicePlanEntity) SetupFee() float64 {
+	return b.obj.SetupFee
+}
+
+func (b *ServicePlanEntity) CostPerMonth() float64 {
+	return b.obj.CostPerMonth
+}
+
+// Published returns true when the plan is available for developers to subscribe
+func (b *ServicePlanEntity) Published() bool {
+	return b.obj.State == PlanPublishedState
+}
+
+func (b *ServicePlanEntity) Update(params threescaleapi.Params) error {
+	b.logger.V(1).Info("Update", "params", params)
+	updated, err := b.client.UpdateServicePlan(b.productID, b.obj.ID, params)
+	if err != nil {
+		return fmt.Errorf("product [%d] service plan [%s] update: %w", b.productID, b.obj.SystemName, err)
+	}
+
+	b.obj = updated.Element
+
+	return nil
+}
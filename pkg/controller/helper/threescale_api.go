@@ -2,6 +2,7 @@ package helper
 
 import (
 	"crypto/tls"
+	"fmt"
 	"net/http"
 	"net/url"
 
@@ -17,14 +18,33 @@ const (
 type ProviderAccount struct {
 	AdminURLStr string
 	Token       string
+	// TLSConfig used to connect to the admin portal.
+	// When nil, the admin portal certificate is not verified.
+	TLSConfig *tls.Config
 }
 
-// PortaClient instantiate porta_client.ThreeScaleClient from ProviderAccount object
-func PortaClient(providerAccount *ProviderAccount) (*threescaleapi.ThreeScaleClient, error) {
-	return PortaClientFromURLString(providerAccount.AdminURLStr, providerAccount.Token)
+// ThreescaleAPIClient wraps porta_client.ThreeScaleClient
+// adding Account Management API endpoints not implemented by the porta client
+type ThreescaleAPIClient struct {
+	*threescaleapi.ThreeScaleClient
+	baseURL    *url.URL
+	token      string
+	httpClient *http.Client
+	// cache of 3scale objects shared by the clients of the same provider account.
+	// Nil when disabled
+	cache *remoteCache
 }
 
-func PortaClientFromURLString(adminURLStr, token string) (*threescaleapi.ThreeScaleClient, error) {
+// PortaClient instantiate ThreescaleAPIClient from ProviderAccount object
+func PortaClient(providerAccount *ProviderAccount) (*ThreescaleAPIClient, error) {
+	adminURL, err := url.Parse(providerAccount.AdminURLStr)
+	if err != nil {
+		return nil, err
+	}
+	return portaClientFromURL(adminURL, providerAccount.Token, providerAccount.TLSConfig)
+}
+
+func PortaClientFromURLString(adminURLStr, token string) (*ThreescaleAPIClient, error) {
 	adminURL, err := url.Parse(adminURLStr)
 	if err != nil {
 		return nil, err
@@ -32,22 +52,49 @@ func PortaClientFromURLString(adminURLStr, token string) (*threescaleapi.ThreeSc
 	return PortaClientFromURL(adminURL, token)
 }
 
-// PortaClientFromURL instantiates porta_client.ThreeScaleClient from admin url object
-func PortaClientFromURL(url *url.URL, token string) (*threescaleapi.ThreeScaleClient, error) {
-	adminPortal, err := threescaleapi.NewAdminPortal(url.Scheme, url.Hostname(), helper.PortFromURL(url))
+// PortaClientFromURL instantiates ThreescaleAPIClient from admin url object
+func PortaClientFromURL(adminURL *url.URL, token string) (*ThreescaleAPIClient, error) {
+	return portaClientFromURL(adminURL, token, nil)
+}
+
+func portaClientFromURL(adminURL *url.URL, token string, tlsConfig *tls.Config) (*ThreescaleAPIClient, error) {
+	adminPortal, err := threescaleapi.NewAdminPortal(adminURL.Scheme, adminURL.Hostname(), helper.PortFromURL(adminURL))
 	if err != nil {
 		return nil, err
 	}
 
 	// TODO By default should not skip verification
-	// Activated by some env var or Spec param
+	// Only ProviderAccount resources provide TLS settings
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
 	var transport http.RoundTripper = &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		TLSClientConfig: tlsConfig,
 	}
 
 	if helper.GetEnvVar(HTTP_VERBOSE_ENVVAR, "0") == "1" {
 		transport = &helper.Transport{Transport: transport}
 	}
 
-	return threescaleapi.NewThreeScale(adminPortal, token, &http.Client{Transport: transport}), nil
+	baseURL, err := url.Parse(fmt.Sprintf("%s://%s:%d", adminURL.Scheme, adminURL.Hostname(), helper.PortFromURL(adminURL)))
+	if err != nil {
+		return nil, err
+	}
+
+	// Every attempt is measured, retries included
+	transport = &metricsTransport{transport: transport}
+
+	// Transient errors are retried and requests are rate limited per tenant
+	transport = newResilientTransport(transport, baseURL)
+
+	httpClient := &http.Client{Transport: transport}
+
+	return &ThreescaleAPIClient{
+		ThreeScaleClient: threescaleapi.NewThreeScale(adminPortal, token, httpClient),
+		baseURL:          baseURL,
+		token:            token,
+		httpClient:       httpClient,
+		cache:            remoteCacheFor(baseURL, token),
+	}, nil
 }
@@ -0,0 +1,163 @@
+package helper
+
+import (
+	"fmt"
+	"net/http"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+)
+
+const (
+	developerAccountSignupEndpoint        = "/admin/api/signup.json"
+	developerAccountFindEndpoint          = "/admin/api/accounts/find.json"
+	developerAccountResourceEndpoint      = "/admin/api/accounts/%d.json"
+	developerAccountStateEventEndpoint    = "/admin/api/accounts/%d/%s.json"
+	developerAccountPlanEndpoint          = "/admin/api/accounts/%d/plan.json"
+	developerAccountChangePlanEndpoint    = "/admin/api/accounts/%d/change_plan.json"
+	accountPlanListResourceEndpoint       = "/admin/api/account_plans.json"
+	accountPlanResourceEndpoint           = "/admin/api/account_plans/%d.json"
+	developerAccountApproveStateEvent     = "approve"
+	developerAccountRejectStateEvent      = "reject"
+	developerAccountMakePendingStateEvent = "make_pending"
+)
+
+type BillingAddress struct {
+	Company  string `json:"company"`
+	Address1 string `json:"address1"`
+	Address2 string `json:"address2"`
+	City     string `json:"city"`
+	State    string `json:"state"`
+	Country  string `json:"country"`
+	Zip      string `json:"zip"`
+	Phone    string `json:"phone_number"`
+}
+
+type DeveloperAccountItem struct {
+	ID                     int64           `json:"id"`
+	State                  string          `json:"state"`
+	OrgName                string          `json:"org_name"`
+	VatCode                string          `json:"vat_code"`
+	MonthlyBillingEnabled  bool            `json:"monthly_billing_enabled"`
+	MonthlyChargingEnabled bool            `json:"monthly_charging_enabled"`
+	BillingAddress         *BillingAddress `json:"billing_address"`
+}
+
+type DeveloperAccount struct {
+	Element DeveloperAccountItem `json:"account"`
+}
+
+type AccountPlanItem struct {
+	ID               int64   `json:"id"`
+	Name             string  `json:"name"`
+	SystemName       string  `json:"system_name"`
+	State            string  `json:"state"`
+	SetupFee         float64 `json:"setup_fee"`
+	CostPerMonth     float64 `json:"cost_per_month"`
+	TrialPeriodDays  int     `json:"trial_period_days"`
+	ApprovalRequired bool    `json:"approval_required"`
+	Default          bool    `json:"default"`
+}
+
+type AccountPlan struct {
+	Element AccountPlanItem `json:"account_plan"`
+}
+
+type AccountPlanList struct {
+	Plans []AccountPlan `json:"plans"`
+}
+
+// CreateDeveloperAccount Create developer account and its admin user
+func (c *ThreescaleAPIClient) CreateDeveloperAccount(params threescaleapi.Params) (*DeveloperAccount, error) {
+	obj := &DeveloperAccount{}
+	err := c.doJSON(http.MethodPost, developerAccountSignupEndpoint, params, http.StatusCreated, obj)
+	return obj, err
+}
+
+// DeveloperAccount Read developer account
+func (c *ThreescaleAPIClient) DeveloperAccount(id int64) (*DeveloperAccount, error) {
+	obj := &DeveloperAccount{}
+	err := c.doJSON(http.MethodGet, fmt.Sprintf(developerAccountResourceEndpoint, id), nil, http.StatusOK, obj)
+	return obj, err
+}
+
+// FindDeveloperAccountByUsername Find developer account by username of any of its users
+func (c *ThreescaleAPIClient) FindDeveloperAccountByUsername(username string) (*DeveloperAccount, error) {
+	obj := &DeveloperAccount{}
+	params := threescaleapi.Params{"username": username}
+	err := c.doJSON(http.MethodGet, developerAccountFindEndpoint, params, http.StatusOK, obj)
+	return obj, err
+}
+
+// UpdateDeveloperAccount Update developer account
+func (c *ThreescaleAPIClient) UpdateDeveloperAccount(id int64, params threescaleapi.Params) (*DeveloperAccount, error) {
+	obj := &DeveloperAccount{}
+	err := c.doJSON(http.MethodPut, fmt.Sprintf(developerAccountResourceEndpoint, id), params, http.StatusOK, obj)
+	return obj, err
+}
+
+// DeleteDeveloperAccount Delete developer account
+func (c *ThreescaleAPIClient) DeleteDeveloperAccount(id int64) error {
+	return c.doJSON(http.MethodDelete, fmt.Sprintf(developerAccountResourceEndpoint, id), nil, http.StatusOK, nil)
+}
+
+// ApproveDeveloperAccount Change developer account state to approved
+func (c *ThreescaleAPIClient) ApproveDeveloperAccount(id int64) (*DeveloperAccount, error) {
+	return c.developerAccountStateEvent(id, developerAccountApproveStateEvent)
+}
+
+// RejectDeveloperAccount Change developer account state to rejected
+func (c *ThreescaleAPIClient) RejectDeveloperAccount(id int64) (*DeveloperAccount, error) {
+	return c.developerAccountStateEvent(id, developerAccountRejectStateEvent)
+}
+
+// MakePendingDeveloperAccount Change developer account state to pending
+func (c *ThreescaleAPIClient) MakePendingDeveloperAccount(id int64) (*DeveloperAccount, error) {
+	return c.developerAccountStateEvent(id, developerAccountMakePendingStateEvent)
+}
+
+func (c *ThreescaleAPIClient) developerAccountStateEvent(id int64, event string) (*DeveloperAccount, error) {
+	obj := &DeveloperAccount{}
+	err := c.doJSON(http.MethodPut, fmt.Sprintf(developerAccountStateEventEndpoint, id, event), nil, http.StatusOK, obj)
+	return obj, err
+}
+
+// DeveloperAccountPlan Read developer account's account plan
+func (c *ThreescaleAPIClient) DeveloperAccountPlan(id int64) (*AccountPlan, error) {
+	obj := &AccountPlan{}
+	err := c.doJSON(http.MethodGet, fmt.Sprintf(developerAccountPlanEndpoint, id), nil, http.StatusOK, obj)
+	return obj, err
+}
+
+// ChangeDeveloperAccountPlan Change developer account's account plan
+func (c *ThreescaleAPIClient) ChangeDeveloperAccountPlan(id, planID int64) (*AccountPlan, error) {
+	obj := &AccountPlan{}
+	params := threescaleapi.Params{"plan_id": fmt.Sprintf("%d", planID)}
+	err := c.doJSON(http.MethodPut, fmt.Sprintf(developerAccountChangePlanEndpoint, id), params, http.StatusOK, obj)
+	return obj, err
+}
+
+// ListAccountPlans List existing account plans
+func (c *ThreescaleAPIClient) ListAccountPlans() (*AccountPlanList, error) {
+	obj := &AccountPlanList{}
+	err := c.doJSON(http.MethodGet, accountPlanListResourceEndpoint, nil, http.StatusOK, obj)
+	return obj, err
+}
+
+// CreateAccountPlan Create account plan
+func (c *ThreescaleAPIClient) CreateAccountPlan(params threescaleapi.Params) (*AccountPlan, error) {
+	obj := &AccountPlan{}
+	err := c.doJSON(http.MethodPost, accountPlanListResourceEndpoint, params, http.StatusCreated, obj)
+	return obj, err
+}
+
+// UpdateAccountPlan Update account plan
+func (c *ThreescaleAPIClient) UpdateAccountPlan(id int64, params threescaleapi.Params) (*AccountPlan, error) {
+	obj := &AccountPlan{}
+	err := c.doJSON(http.MethodPut, fmt.Sprintf(accountPlanResourceEndpoint, id), params, http.StatusOK, obj)
+	return obj, err
+}
+
+// DeleteAccountPlan Delete account plan
+func (c *ThreescaleAPIClient) DeleteAccountPlan(id int64) error {
+	return c.doJSON(http.MethodDelete, fmt.Sprintf(accountPlanResourceEndpoint, id), nil, http.StatusOK, nil)
+}
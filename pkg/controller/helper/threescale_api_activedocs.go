@@ -0,0 +1,58 @@
+package helper
+
+import (
+	"fmt"
+	"net/http"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+)
+
+const (
+	activeDocListResourceEndpoint = "/admin/api/active_docs.json"
+	activeDocResourceEndpoint     = "/admin/api/active_docs/%d.json"
+)
+
+type ActiveDocItem struct {
+	ID                     int64  `json:"id"`
+	SystemName             string `json:"system_name"`
+	Name                   string `json:"name"`
+	Description            string `json:"description"`
+	Published              bool   `json:"published"`
+	SkipSwaggerValidations bool   `json:"skip_swagger_validations"`
+	Body                   string `json:"body"`
+	ServiceID              *int64 `json:"service_id"`
+}
+
+type ActiveDoc struct {
+	Element ActiveDocItem `json:"api_doc"`
+}
+
+type ActiveDocList struct {
+	ActiveDocs []ActiveDoc `json:"api_docs"`
+}
+
+// ListActiveDocs List existing activedocs
+func (c *ThreescaleAPIClient) ListActiveDocs() (*ActiveDocList, error) {
+	obj := &ActiveDocList{}
+	err := c.doJSON(http.MethodGet, activeDocListResourceEndpoint, nil, http.StatusOK, obj)
+	return obj, err
+}
+
+// CreateActiveDoc Create activedoc
+func (c *ThreescaleAPIClient) CreateActiveDoc(params threescaleapi.Params) (*ActiveDoc, error) {
+	obj := &ActiveDoc{}
+	err := c.doJSON(http.MethodPost, activeDocListResourceEndpoint, params, http.StatusCreated, obj)
+	return obj, err
+}
+
+// UpdateActiveDoc Update activedoc
+func (c *ThreescaleAPIClient) UpdateActiveDoc(id int64, params threescaleapi.Params) (*ActiveDoc, error) {
+	obj := &ActiveDoc{}
+	err := c.doJSON(http.MethodPut, fmt.Sprintf(activeDocResourceEndpoint, id), params, http.StatusOK, obj)
+	return obj, err
+}
+
+// DeleteActiveDoc Delete activedoc
+func (c *ThreescaleAPIClient) DeleteActiveDoc(id int64) error {
+	return c.doJSON(http.MethodDelete, fmt.Sprintf(activeDocResourceEndpoint, id), nil, http.StatusOK, nil)
+}
@@ -0,0 +1,35 @@
+package helper
+
+import (
+	"fmt"
+	"net/http"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+)
+
+const (
+	applicationPlanDefaultResourceEndpoint = "/admin/api/services/%d/application_plans/%d/default.json"
+
+	// PlanPublishedState is the state of the plans available for developers to sign up.
+	// Application, service and account plans share the same states.
+	PlanPublishedState = "published"
+
+	// Plan state events
+	PlanPublishStateEvent = "publish"
+	PlanHideStateEvent    = "hide"
+)
+
+// PlanStateEvent returns the state event that publishes or hides a plan
+func PlanStateEvent(published bool) string {
+	if published {
+		return PlanPublishStateEvent
+	}
+	return PlanHideStateEvent
+}
+
+// SetDefaultApplicationPlan Make the application plan the default plan of the product
+func (c *ThreescaleAPIClient) SetDefaultApplicationPlan(productID, planID int64) (*threescaleapi.ApplicationPlan, error) {
+	obj := &threescaleapi.ApplicationPlan{}
+	err := c.doJSON(http.MethodPut, fmt.Sprintf(applicationPlanDefaultResourceEndpoint, productID, planID), nil, http.StatusOK, obj)
+	return obj, err
+}
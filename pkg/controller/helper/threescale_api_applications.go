@@ -0,0 +1,113 @@
+package helper
+
+import (
+	"fmt"
+	"net/http"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+)
+
+const (
+	applicationListResourceEndpoint = "/admin/api/accounts/%d/applications.json"
+	applicationResourceEndpoint     = "/admin/api/accounts/%d/applications/%d.json"
+	applicationActionEndpoint       = "/admin/api/accounts/%d/applications/%d/%s.json"
+	applicationKeyListEndpoint      = "/admin/api/accounts/%d/applications/%d/keys.json"
+	applicationChangePlanAction     = "change_plan"
+	applicationSuspendAction        = "suspend"
+	applicationResumeAction         = "resume"
+)
+
+type ApplicationItem struct {
+	ID          int64  `json:"id"`
+	State       string `json:"state"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	ServiceID   int64  `json:"service_id"`
+	PlanID      int64  `json:"plan_id"`
+	// Set when the product authentication mode is API key
+	UserKey string `json:"user_key"`
+	// Set when the product authentication mode is App ID and App Key
+	ApplicationID string `json:"application_id"`
+}
+
+type Application struct {
+	Element ApplicationItem `json:"application"`
+}
+
+type ApplicationList struct {
+	Applications []Application `json:"applications"`
+}
+
+type ApplicationKeyItem struct {
+	Value string `json:"value"`
+}
+
+type ApplicationKey struct {
+	Element ApplicationKeyItem `json:"key"`
+}
+
+type ApplicationKeyList struct {
+	Keys []ApplicationKey `json:"keys"`
+}
+
+// ListDeveloperAccountApplications List existing applications of the developer account
+func (c *ThreescaleAPIClient) ListDeveloperAccountApplications(accountID int64) (*ApplicationList, error) {
+	obj := &ApplicationList{}
+	err := c.doJSON(http.MethodGet, fmt.Sprintf(applicationListResourceEndpoint, accountID), nil, http.StatusOK, obj)
+	return obj, err
+}
+
+// DeveloperAccountApplication Read application
+func (c *ThreescaleAPIClient) DeveloperAccountApplication(accountID, id int64) (*Application, error) {
+	obj := &Application{}
+	err := c.doJSON(http.MethodGet, fmt.Sprintf(applicationResourceEndpoint, accountID, id), nil, http.StatusOK, obj)
+	return obj, err
+}
+
+// CreateDeveloperAccountApplication Create application
+func (c *ThreescaleAPIClient) CreateDeveloperAccountApplication(accountID int64, params threescaleapi.Params) (*Application, error) {
+	obj := &Application{}
+	err := c.doJSON(http.MethodPost, fmt.Sprintf(applicationListResourceEndpoint, accountID), params, http.StatusCreated, obj)
+	return obj, err
+}
+
+// UpdateDeveloperAccountApplication Update application
+func (c *ThreescaleAPIClient) UpdateDeveloperAccountApplication(accountID, id int64, params threescaleapi.Params) (*Application, error) {
+	obj := &Application{}
+	err := c.doJSON(http.MethodPut, fmt.Sprintf(applicationResourceEndpoint, accountID, id), params, http.StatusOK, obj)
+	return obj, err
+}
+
+// DeleteDeveloperAccountApplication Delete application
+func (c *ThreescaleAPIClient) DeleteDeveloperAccountApplication(accountID, id int64) error {
+	return c.doJSON(http.MethodDelete, fmt.Sprintf(applicationResourceEndpoint, accountID, id), nil, http.StatusOK, nil)
+}
+
+// ChangeDeveloperAccountApplicationPlan Change application plan
+func (c *ThreescaleAPIClient) ChangeDeveloperAccountApplicationPlan(accountID, id, planID int64) (*Application, error) {
+	params := threescaleapi.Params{"plan_id": fmt.Sprintf("%d", planID)}
+	return c.applicationAction(accountID, id, applicationChangePlanAction, params)
+}
+
+// SuspendDeveloperAccountApplication Change application state to suspended
+func (c *ThreescaleAPIClient) SuspendDeveloperAccountApplication(accountID, id int64) (*Application, error) {
+	return c.applicationAction(accountID, id, applicationSuspendAction, nil)
+}
+
+// ResumeDeveloperAccountApplication Change application state to live
+func (c *ThreescaleAPIClient) ResumeDeveloperAccountApplication(accountID, id int64) (*Application, error) {
+	return c.applicationAction(accountID, id, applicationResumeAction, nil)
+}
+
+func (c *ThreescaleAPIClient) applicationAction(accountID, id int64, action string, params threescaleapi.Params) (*Application, error) {
+	obj := &Application{}
+	err := c.doJSON(http.MethodPut, fmt.Sprintf(applicationActionEndpoint, accountID, id, action), params, http.StatusOK, obj)
+	return obj, err
+}
+
+// ListDeveloperAccountApplicationKeys List application keys
+func (c *ThreescaleAPIClient) ListDeveloperAccountApplicationKeys(accountID, id int64) (*ApplicationKeyList, error) {
+	obj := &ApplicationKeyList{}
+	err := c.doJSON(http.MethodGet, fmt.Sprintf(applicationKeyListEndpoint, accountID, id), nil, http.StatusOK, obj)
+	return obj, err
+}
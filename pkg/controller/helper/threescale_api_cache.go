@@ -0,0 +1,482 @@
+package helper
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+	"github.com/prometheus/client_golang/prometheus"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	THREESCALE_CACHE_TTL_ENVVAR = "THREESCALE_CACHE_TTL"
+
+	// DefaultRemoteCacheTTL is the time 3scale objects are cached
+	// when THREESCALE_CACHE_TTL is not set
+	DefaultRemoteCacheTTL = 30 * time.Second
+)
+
+// Cached 3scale objects, used as metric labels
+const (
+	cacheObjectBackendAPIs            = "backendapis"
+	cacheObjectBackendAPIMethods      = "backendapi_methods"
+	cacheObjectBackendAPIMetrics      = "backendapi_metrics"
+	cacheObjectBackendAPIMappingRules = "backendapi_mappingrules"
+	cacheObjectProducts               = "products"
+	cacheObjectProductMethods         = "product_methods"
+	cacheObjectProductMetrics         = "product_metrics"
+	cacheObjectProductMappingRules    = "product_mappingrules"
+)
+
+var (
+	remoteCacheRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "threescale_operator_remote_cache_requests_total",
+			Help: "3scale objects cache lookups by object and result (hit or miss)",
+		},
+		[]string{"object", "result"},
+	)
+
+	remoteCacheInvalidations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "threescale_operator_remote_cache_invalidations_total",
+			Help: "3scale objects cache entries invalidated by writes of the operator, by written object",
+		},
+		[]string{"object"},
+	)
+
+	remoteCaches = struct {
+		sync.Mutex
+		caches map[string]*remoteCache
+	}{caches: map[string]*remoteCache{}}
+
+	remoteCacheTTL     time.Duration
+	remoteCacheTTLOnce sync.Once
+)
+
+func init() {
+	crmetrics.Registry.MustRegister(remoteCacheRequests, remoteCacheInvalidations)
+}
+
+// RemoteCacheTTL reads the 3scale objects cache TTL from the environment variable in Go duration format, i.e. "1m".
+// Zero disables the cache. DefaultRemoteCacheTTL when not set or not valid.
+func RemoteCacheTTL() time.Duration {
+	remoteCacheTTLOnce.Do(func() {
+		remoteCacheTTL = DefaultRemoteCacheTTL
+
+		value := helper.GetEnvVar(THREESCALE_CACHE_TTL_ENVVAR, "")
+		if value == "" {
+			return
+		}
+
+		ttl, err := time.ParseDuration(value)
+		if err != nil || ttl < 0 {
+			logf.Log.WithName("remote_cache").Info("invalid cache TTL, using default", THREESCALE_CACHE_TTL_ENVVAR, value, "default", DefaultRemoteCacheTTL)
+			return
+		}
+
+		remoteCacheTTL = ttl
+	})
+
+	return remoteCacheTTL
+}
+
+// remoteCache caches 3scale objects of one provider account.
+// Shared by all the clients of the provider account, hence between controllers and reconciles.
+// Objects are stored serialized, every lookup returns a new copy the caller can modify.
+// Entries are invalidated on writes of the operator and expire after the TTL,
+// so changes made outside the operator are picked up.
+type remoteCache struct {
+	cache *helper.TTLCache
+	// lastUsed is the last time a client got the cache. Guarded by the remoteCaches lock
+	lastUsed time.Time
+	mu       sync.Mutex
+	// generation is increased on every invalidation.
+	// Objects read from 3scale before an invalidation are not cached, they may be stale
+	generation uint64
+}
+
+// remoteCacheFor returns the cache shared by the clients of the provider account.
+// Caches of provider accounts no longer used, i.e. rotated tokens, are dropped.
+// Nil when the cache is disabled
+func remoteCacheFor(baseURL *url.URL, token string) *remoteCache {
+	ttl := RemoteCacheTTL()
+	if ttl == 0 {
+		return nil
+	}
+
+	// Tokens are not kept in memory as cache keys
+	tokenHash := sha256.Sum256([]byte(token))
+	key := fmt.Sprintf("%s#%s", baseURL.String(), hex.EncodeToString(tokenHash[:]))
+
+	remoteCaches.Lock()
+	defer remoteCaches.Unlock()
+
+	now := time.Now()
+	pruneRemoteCaches(now, ttl)
+
+	cache, ok := remoteCaches.caches[key]
+	if !ok {
+		cache = &remoteCache{cache: helper.NewTTLCache(ttl)}
+		remoteCaches.caches[key] = cache
+	}
+	cache.lastUsed = now
+
+	return cache
+}
+
+// pruneRemoteCaches drops the caches no client got for longer than the TTL and without live entries.
+// Clients are created on every reconcile, so those caches are not used anymore.
+// Must be called holding the remoteCaches lock
+func pruneRemoteCaches(now time.Time, ttl time.Duration) {
+	for key, cache := range remoteCaches.caches {
+		if now.Sub(cache.lastUsed) > ttl && cache.cache.Len() == 0 {
+			delete(remoteCaches.caches, key)
+		}
+	}
+}
+
+// get decodes the cached object into obj.
+// Returns whether the object was found and the cache generation to store the object read from 3scale on miss
+func (r *remoteCache) get(object, key string, obj interface{}) (bool, uint64) {
+	if r == nil {
+		return false, 0
+	}
+
+	r.mu.Lock()
+	generation := r.generation
+	r.mu.Unlock()
+
+	data, err := r.cache.Get(key)
+	if err == nil && json.Unmarshal(data.([]byte), obj) == nil {
+		remoteCacheRequests.WithLabelValues(object, "hit").Inc()
+		return true, generation
+	}
+
+	remoteCacheRequests.WithLabelValues(object, "miss").Inc()
+	return false, generation
+}
+
+// put caches the object read from 3scale unless the cache has been invalidated since the lookup
+func (r *remoteCache) put(key string, generation uint64, obj interface{}) {
+	if r == nil {
+		return
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if generation != r.generation {
+		return
+	}
+	r.cache.Put(key, data)
+}
+
+// invalidate deletes the cached entries starting with any of the prefixes
+func (r *remoteCache) invalidate(object string, prefixes ...string) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.generation++
+	for _, prefix := range prefixes {
+		remoteCacheInvalidations.WithLabelValues(object).Add(float64(r.cache.DeletePrefix(prefix)))
+	}
+}
+
+func backendAPIsCacheKey() string {
+	return "backendapis"
+}
+
+func backendAPICachePrefix(backendAPIID int64) string {
+	return fmt.Sprintf("backendapi/%d/", backendAPIID)
+}
+
+func productsCacheKey() string {
+	return "products"
+}
+
+func productCachePrefix(productID int64) string {
+	return fmt.Sprintf("product/%d/", productID)
+}
+
+//
+// Cached list endpoints
+//
+
+func (c *ThreescaleAPIClient) ListBackendApis() (*threescaleapi.BackendApiList, error) {
+	key := backendAPIsCacheKey()
+	cached := &threescaleapi.BackendApiList{}
+	found, generation := c.cache.get(cacheObjectBackendAPIs, key, cached)
+	if found {
+		return cached, nil
+	}
+
+	obj, err := c.ThreeScaleClient.ListBackendApis()
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.put(key, generation, obj)
+	return obj, nil
+}
+
+func (c *ThreescaleAPIClient) ListBackendapiMethods(backendapiID, hitsID int64) (*threescaleapi.MethodList, error) {
+	key := fmt.Sprintf("%smethods/%d", backendAPICachePrefix(backendapiID), hitsID)
+	cached := &threescaleapi.MethodList{}
+	found, generation := c.cache.get(cacheObjectBackendAPIMethods, key, cached)
+	if found {
+		return cached, nil
+	}
+
+	obj, err := c.ThreeScaleClient.ListBackendapiMethods(backendapiID, hitsID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.put(key, generation, obj)
+	return obj, nil
+}
+
+func (c *ThreescaleAPIClient) ListBackendapiMetrics(backendapiID int64) (*threescaleapi.MetricJSONList, error) {
+	key := backendAPICachePrefix(backendapiID) + "metrics"
+	cached := &threescaleapi.MetricJSONList{}
+	found, generation := c.cache.get(cacheObjectBackendAPIMetrics, key, cached)
+	if found {
+		return cached, nil
+	}
+
+	obj, err := c.ThreeScaleClient.ListBackendapiMetrics(backendapiID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.put(key, generation, obj)
+	return obj, nil
+}
+
+func (c *ThreescaleAPIClient) ListBackendapiMappingRules(backendapiID int64) (*threescaleapi.MappingRuleJSONList, error) {
+	key := backendAPICachePrefix(backendapiID) + "mappingrules"
+	cached := &threescaleapi.MappingRuleJSONList{}
+	found, generation := c.cache.get(cacheObjectBackendAPIMappingRules, key, cached)
+	if found {
+		return cached, nil
+	}
+
+	obj, err := c.ThreeScaleClient.ListBackendapiMappingRules(backendapiID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.put(key, generation, obj)
+	return obj, nil
+}
+
+func (c *ThreescaleAPIClient) ListProducts() (*threescaleapi.ProductList, error) {
+	key := productsCacheKey()
+	cached := &threescaleapi.ProductList{}
+	found, generation := c.cache.get(cacheObjectProducts, key, cached)
+	if found {
+		return cached, nil
+	}
+
+	obj, err := c.ThreeScaleClient.ListProducts()
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.put(key, generation, obj)
+	return obj, nil
+}
+
+func (c *ThreescaleAPIClient) ListProductMethods(productID, hitsID int64) (*threescaleapi.MethodList, error) {
+	key := fmt.Sprintf("%smethods/%d", productCachePrefix(productID), hitsID)
+	cached := &threescaleapi.MethodList{}
+	found, generation := c.cache.get(cacheObjectProductMethods, key, cached)
+	if found {
+		return cached, nil
+	}
+
+	obj, err := c.ThreeScaleClient.ListProductMethods(productID, hitsID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.put(key, generation, obj)
+	return obj, nil
+}
+
+func (c *ThreescaleAPIClient) ListProductMetrics(productID int64) (*threescaleapi.MetricJSONList, error) {
+	key := productCachePrefix(productID) + "metrics"
+	cached := &threescaleapi.MetricJSONList{}
+	found, generation := c.cache.get(cacheObjectProductMetrics, key, cached)
+	if found {
+		return cached, nil
+	}
+
+	obj, err := c.ThreeScaleClient.ListProductMetrics(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.put(key, generation, obj)
+	return obj, nil
+}
+
+func (c *ThreescaleAPIClient) ListProductMappingRules(productID int64) (*threescaleapi.MappingRuleJSONList, error) {
+	key := productCachePrefix(productID) + "mappingrules"
+	cached := &threescaleapi.MappingRuleJSONList{}
+	found, generation := c.cache.get(cacheObjectProductMappingRules, key, cached)
+	if found {
+		return cached, nil
+	}
+
+	obj, err := c.ThreeScaleClient.ListProductMappingRules(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.put(key, generation, obj)
+	return obj, nil
+}
+
+//
+// Write endpoints invalidating cached objects.
+// Entries are invalidated even when the request fails, the object may have been changed anyway.
+// Method and metric deletion removes the mapping rules referencing them.
+//
+
+func (c *ThreescaleAPIClient) CreateBackendApi(params threescaleapi.Params) (*threescaleapi.BackendApi, error) {
+	defer c.cache.invalidate(cacheObjectBackendAPIs, backendAPIsCacheKey())
+	return c.ThreeScaleClient.CreateBackendApi(params)
+}
+
+func (c *ThreescaleAPIClient) UpdateBackendApi(id int64, params threescaleapi.Params) (*threescaleapi.BackendApi, error) {
+	defer c.cache.invalidate(cacheObjectBackendAPIs, backendAPIsCacheKey())
+	return c.ThreeScaleClient.UpdateBackendApi(id, params)
+}
+
+func (c *ThreescaleAPIClient) DeleteBackendApi(id int64) error {
+	defer c.cache.invalidate(cacheObjectBackendAPIs, backendAPIsCacheKey(), backendAPICachePrefix(id))
+	return c.ThreeScaleClient.DeleteBackendApi(id)
+}
+
+func (c *ThreescaleAPIClient) CreateBackendApiMethod(backendapiID, hitsID int64, params threescaleapi.Params) (*threescaleapi.Method, error) {
+	defer c.cache.invalidate(cacheObjectBackendAPIMethods, backendAPICachePrefix(backendapiID))
+	return c.ThreeScaleClient.CreateBackendApiMethod(backendapiID, hitsID, params)
+}
+
+func (c *ThreescaleAPIClient) UpdateBackendApiMethod(backendapiID, hitsID, methodID int64, params threescaleapi.Params) (*threescaleapi.Method, error) {
+	defer c.cache.invalidate(cacheObjectBackendAPIMethods, backendAPICachePrefix(backendapiID))
+	return c.ThreeScaleClient.UpdateBackendApiMethod(backendapiID, hitsID, methodID, params)
+}
+
+func (c *ThreescaleAPIClient) DeleteBackendApiMethod(backendapiID, hitsID, methodID int64) error {
+	defer c.cache.invalidate(cacheObjectBackendAPIMethods, backendAPICachePrefix(backendapiID))
+	return c.ThreeScaleClient.DeleteBackendApiMethod(backendapiID, hitsID, methodID)
+}
+
+func (c *ThreescaleAPIClient) CreateBackendApiMetric(backendapiID int64, params threescaleapi.Params) (*threescaleapi.MetricJSON, error) {
+	defer c.cache.invalidate(cacheObjectBackendAPIMetrics, backendAPICachePrefix(backendapiID))
+	return c.ThreeScaleClient.CreateBackendApiMetric(backendapiID, params)
+}
+
+func (c *ThreescaleAPIClient) UpdateBackendApiMetric(backendapiID, metricID int64, params threescaleapi.Params) (*threescaleapi.MetricJSON, error) {
+	defer c.cache.invalidate(cacheObjectBackendAPIMetrics, backendAPICachePrefix(backendapiID))
+	return c.ThreeScaleClient.UpdateBackendApiMetric(backendapiID, metricID, params)
+}
+
+func (c *ThreescaleAPIClient) DeleteBackendApiMetric(backendapiID, metricID int64) error {
+	defer c.cache.invalidate(cacheObjectBackendAPIMetrics, backendAPICachePrefix(backendapiID))
+	return c.ThreeScaleClient.DeleteBackendApiMetric(backendapiID, metricID)
+}
+
+func (c *ThreescaleAPIClient) CreateBackendapiMappingRule(backendapiID int64, params threescaleapi.Params) (*threescaleapi.MappingRuleJSON, error) {
+	defer c.cache.invalidate(cacheObjectBackendAPIMappingRules, backendAPICachePrefix(backendapiID)+"mappingrules")
+	return c.ThreeScaleClient.CreateBackendapiMappingRule(backendapiID, params)
+}
+
+func (c *ThreescaleAPIClient) UpdateBackendapiMappingRule(backendapiID, mrID int64, params threescaleapi.Params) (*threescaleapi.MappingRuleJSON, error) {
+	defer c.cache.invalidate(cacheObjectBackendAPIMappingRules, backendAPICachePrefix(backendapiID)+"mappingrules")
+	return c.ThreeScaleClient.UpdateBackendapiMappingRule(backendapiID, mrID, params)
+}
+
+func (c *ThreescaleAPIClient) DeleteBackendapiMappingRule(backendapiID, mrID int64) error {
+	defer c.cache.invalidate(cacheObjectBackendAPIMappingRules, backendAPICachePrefix(backendapiID)+"mappingrules")
+	return c.ThreeScaleClient.DeleteBackendapiMappingRule(backendapiID, mrID)
+}
+
+func (c *ThreescaleAPIClient) CreateProduct(name string, params threescaleapi.Params) (*threescaleapi.Product, error) {
+	defer c.cache.invalidate(cacheObjectProducts, productsCacheKey())
+	return c.ThreeScaleClient.CreateProduct(name, params)
+}
+
+func (c *ThreescaleAPIClient) UpdateProduct(id int64, params threescaleapi.Params) (*threescaleapi.Product, error) {
+	defer c.cache.invalidate(cacheObjectProducts, productsCacheKey())
+	return c.ThreeScaleClient.UpdateProduct(id, params)
+}
+
+func (c *ThreescaleAPIClient) DeleteProduct(id int64) error {
+	defer c.cache.invalidate(cacheObjectProducts, productsCacheKey(), productCachePrefix(id))
+	return c.ThreeScaleClient.DeleteProduct(id)
+}
+
+func (c *ThreescaleAPIClient) CreateProductMethod(productID, hitsID int64, params threescaleapi.Params) (*threescaleapi.Method, error) {
+	defer c.cache.invalidate(cacheObjectProductMethods, productCachePrefix(productID))
+	return c.ThreeScaleClient.CreateProductMethod(productID, hitsID, params)
+}
+
+func (c *ThreescaleAPIClient) UpdateProductMethod(productID, hitsID, methodID int64, params threescaleapi.Params) (*threescaleapi.Method, error) {
+	defer c.cache.invalidate(cacheObjectProductMethods, productCachePrefix(productID))
+	return c.ThreeScaleClient.UpdateProductMethod(productID, hitsID, methodID, params)
+}
+
+func (c *ThreescaleAPIClient) DeleteProductMethod(productID, hitsID, methodID int64) error {
+	defer c.cache.invalidate(cacheObjectProductMethods, productCachePrefix(productID))
+	return c.ThreeScaleClient.DeleteProductMethod(productID, hitsID, methodID)
+}
+
+func (c *ThreescaleAPIClient) CreateProductMetric(productID int64, params threescaleapi.Params) (*threescaleapi.MetricJSON, error) {
+	defer c.cache.invalidate(cacheObjectProductMetrics, productCachePrefix(productID))
+	return c.ThreeScaleClient.CreateProductMetric(productID, params)
+}
+
+func (c *ThreescaleAPIClient) UpdateProductMetric(productID, metricID int64, params threescaleapi.Params) (*threescaleapi.MetricJSON, error) {
+	defer c.cache.invalidate(cacheObjectProductMetrics, productCachePrefix(productID))
+	return c.ThreeScaleClient.UpdateProductMetric(productID, metricID, params)
+}
+
+func (c *ThreescaleAPIClient) DeleteProductMetric(productID, metricID int64) error {
+	defer c.cache.invalidate(cacheObjectProductMetrics, productCachePrefix(productID))
+	return c.ThreeScaleClient.DeleteProductMetric(productID, metricID)
+}
+
+func (c *ThreescaleAPIClient) CreateProductMappingRule(productID int64, params threescaleapi.Params) (*threescaleapi.MappingRuleJSON, error) {
+	defer c.cache.invalidate(cacheObjectProductMappingRules, productCachePrefix(productID)+"mappingrules")
+	return c.ThreeScaleClient.CreateProductMappingRule(productID, params)
+}
+
+func (c *ThreescaleAPIClient) UpdateProductMappingRule(productID, itemID int64, params threescaleapi.Params) (*threescaleapi.MappingRuleJSON, error) {
+	defer c.cache.invalidate(cacheObjectProductMappingRules, productCachePrefix(productID)+"mappingrules")
+	return c.ThreeScaleClient.UpdateProductMappingRule(productID, itemID, params)
+}
+
+func (c *ThreescaleAPIClient) DeleteProductMappingRule(productID, itemID int64) error {
+	defer c.cache.invalidate(cacheObjectProductMappingRules, productCachePrefix(productID)+"mappingrules")
+	return c.ThreeScaleClient.DeleteProductMappingRule(productID, itemID)
+}
@@ -0,0 +1,100 @@
+package helper
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+)
+
+func TestThreescaleAPIClientCache(t *testing.T) {
+	var listRequests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"backend_api":{"id":2,"system_name":"backend2"}}`))
+			return
+		}
+		atomic.AddInt32(&listRequests, 1)
+		w.Write([]byte(`{"backend_apis":[{"backend_api":{"id":1,"system_name":"backend1"}}]}`))
+	}))
+	defer srv.Close()
+
+	client, err := PortaClientFromURLString(srv.URL, "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Another client of the same provider account shares the cache
+	otherClient, err := PortaClientFromURLString(srv.URL, "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := client.ListBackendApis()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Cached objects are copies
+	list.Backends[0].Element.SystemName = "modified"
+
+	list, err = otherClient.ListBackendApis()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if listRequests != 1 {
+		t.Fatalf("expected 1 list request, got %d", listRequests)
+	}
+	if list.Backends[0].Element.SystemName != "backend1" {
+		t.Fatalf("expected unmodified cached object, got %s", list.Backends[0].Element.SystemName)
+	}
+
+	_, err = client.CreateBackendApi(threescaleapi.Params{"name": "backend2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = otherClient.ListBackendApis()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if listRequests != 2 {
+		t.Fatalf("expected cache invalidated by write, got %d list requests", listRequests)
+	}
+}
+
+func TestPruneRemoteCaches(t *testing.T) {
+	now := time.Now()
+	ttl := time.Minute
+
+	used := &remoteCache{cache: helper.NewTTLCache(ttl), lastUsed: now}
+	unused := &remoteCache{cache: helper.NewTTLCache(ttl), lastUsed: now.Add(-2 * ttl)}
+	unusedWithEntries := &remoteCache{cache: helper.NewTTLCache(ttl), lastUsed: now.Add(-2 * ttl)}
+	unusedWithEntries.cache.Put("products", []byte("{}"))
+
+	remoteCaches.Lock()
+	defer remoteCaches.Unlock()
+	orig := remoteCaches.caches
+	defer func() { remoteCaches.caches = orig }()
+
+	remoteCaches.caches = map[string]*remoteCache{
+		"used":              used,
+		"unused":            unused,
+		"unusedWithEntries": unusedWithEntries,
+	}
+
+	pruneRemoteCaches(now, ttl)
+
+	if _, ok := remoteCaches.caches["unused"]; ok {
+		t.Fatal("expected unused cache to be dropped")
+	}
+	if len(remoteCaches.caches) != 2 {
+		t.Fatalf("expected caches in use or with entries to be kept, got %v", remoteCaches.caches)
+	}
+}
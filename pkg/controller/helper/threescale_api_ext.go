@@ -0,0 +1,103 @@
+package helper
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+)
+
+// ThreescaleAPIError is returned by ThreescaleAPIClient extension endpoints
+// when 3scale responds with unexpected status code
+type ThreescaleAPIError struct {
+	code    int
+	message string
+}
+
+func (e *ThreescaleAPIError) Error() string {
+	return fmt.Sprintf("error calling 3scale system - reason: %s - code: %d", e.message, e.code)
+}
+
+func (e *ThreescaleAPIError) Code() int {
+	return e.code
+}
+
+// ThreescaleAPIErrorCode returns the HTTP status code of errors
+// returned by both porta client and ThreescaleAPIClient extension endpoints.
+// -1 when unknown
+func ThreescaleAPIErrorCode(err error) int {
+	var apiErr threescaleapi.ApiErr
+	if errors.As(err, &apiErr) {
+		return apiErr.Code()
+	}
+
+	var extErr *ThreescaleAPIError
+	if errors.As(err, &extErr) {
+		return extErr.Code()
+	}
+
+	return -1
+}
+
+// IsThreescaleNotFound returns true when 3scale responded with 404 Not Found
+func IsThreescaleNotFound(err error) bool {
+	return ThreescaleAPIErrorCode(err) == http.StatusNotFound
+}
+
+// doJSON sends request to the given Account Management API JSON endpoint.
+// Params are sent as query string on GET requests and form encoded otherwise.
+// When decodeInto is not nil, the response body is decoded into it.
+func (c *ThreescaleAPIClient) doJSON(method, path string, params threescaleapi.Params, expectCode int, decodeInto interface{}) error {
+	values := url.Values{}
+	for k, v := range params {
+		values.Add(k, v)
+	}
+
+	endpoint := c.baseURL.ResolveReference(&url.URL{Path: path})
+
+	var body io.Reader
+	if method == http.MethodGet {
+		endpoint.RawQuery = values.Encode()
+	} else {
+		body = strings.NewReader(values.Encode())
+	}
+
+	req, err := http.NewRequest(method, endpoint.String(), body)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(":"+c.token)))
+	if body != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != expectCode {
+		respBody, _ := ioutil.ReadAll(resp.Body)
+		return &ThreescaleAPIError{code: resp.StatusCode, message: string(respBody)}
+	}
+
+	if decodeInto == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(decodeInto); err != nil {
+		return &ThreescaleAPIError{code: resp.StatusCode, message: fmt.Sprintf("decoding error - %s", err.Error())}
+	}
+
+	return nil
+}
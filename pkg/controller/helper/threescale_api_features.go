@@ -0,0 +1,83 @@
+package helper
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+)
+
+const (
+	productFeatureListResourceEndpoint         = "/admin/api/services/%d/features.json"
+	productFeatureResourceEndpoint             = "/admin/api/services/%d/features/%d.json"
+	applicationPlanFeatureListResourceEndpoint = "/admin/api/application_plans/%d/features.json"
+	applicationPlanFeatureResourceEndpoint     = "/admin/api/application_plans/%d/features/%d.json"
+
+	// ApplicationPlanFeatureScope is the scope of the features that can be enabled on application plans
+	ApplicationPlanFeatureScope = "ApplicationPlan"
+)
+
+// FeatureItem defines product feature
+type FeatureItem struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	SystemName  string `json:"system_name"`
+	Description string `json:"description"`
+	Scope       string `json:"scope"`
+	Visible     bool   `json:"visible"`
+}
+
+type FeatureJSON struct {
+	Element FeatureItem `json:"feature"`
+}
+
+type FeatureJSONList struct {
+	Features []FeatureJSON `json:"features"`
+}
+
+// ListProductFeatures List existing features for a product
+func (c *ThreescaleAPIClient) ListProductFeatures(productID int64) (*FeatureJSONList, error) {
+	obj := &FeatureJSONList{}
+	err := c.doJSON(http.MethodGet, fmt.Sprintf(productFeatureListResourceEndpoint, productID), nil, http.StatusOK, obj)
+	return obj, err
+}
+
+// CreateProductFeature Create product feature
+func (c *ThreescaleAPIClient) CreateProductFeature(productID int64, params threescaleapi.Params) (*FeatureJSON, error) {
+	obj := &FeatureJSON{}
+	err := c.doJSON(http.MethodPost, fmt.Sprintf(productFeatureListResourceEndpoint, productID), params, http.StatusCreated, obj)
+	return obj, err
+}
+
+// UpdateProductFeature Update product feature
+func (c *ThreescaleAPIClient) UpdateProductFeature(productID, featureID int64, params threescaleapi.Params) (*FeatureJSON, error) {
+	obj := &FeatureJSON{}
+	err := c.doJSON(http.MethodPut, fmt.Sprintf(productFeatureResourceEndpoint, productID, featureID), params, http.StatusOK, obj)
+	return obj, err
+}
+
+// DeleteProductFeature Delete product feature
+func (c *ThreescaleAPIClient) DeleteProductFeature(productID, featureID int64) error {
+	return c.doJSON(http.MethodDelete, fmt.Sprintf(productFeatureResourceEndpoint, productID, featureID), nil, http.StatusOK, nil)
+}
+
+// ListApplicationPlanFeatures List features enabled in the application plan
+func (c *ThreescaleAPIClient) ListApplicationPlanFeatures(planID int64) (*FeatureJSONList, error) {
+	obj := &FeatureJSONList{}
+	err := c.doJSON(http.MethodGet, fmt.Sprintf(applicationPlanFeatureListResourceEndpoint, planID), nil, http.StatusOK, obj)
+	return obj, err
+}
+
+// CreateApplicationPlanFeature Enable product feature in the application plan
+func (c *ThreescaleAPIClient) CreateApplicationPlanFeature(planID, featureID int64) (*FeatureJSON, error) {
+	obj := &FeatureJSON{}
+	params := threescaleapi.Params{"feature_id": strconv.FormatInt(featureID, 10)}
+	err := c.doJSON(http.MethodPost, fmt.Sprintf(applicationPlanFeatureListResourceEndpoint, planID), params, http.StatusCreated, obj)
+	return obj, err
+}
+
+// DeleteApplicationPlanFeature Disable product feature in the application plan
+func (c *ThreescaleAPIClient) DeleteApplicationPlanFeature(planID, featureID int64) error {
+	return c.doJSON(http.MethodDelete, fmt.Sprintf(applicationPlanFeatureResourceEndpoint, planID, featureID), nil, http.StatusOK, nil)
+}
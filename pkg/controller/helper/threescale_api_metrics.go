@@ -0,0 +1,76 @@
+package helper
+
+import (
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// apiRequestErrorCode labels the requests failing without response
+const apiRequestErrorCode = "error"
+
+var (
+	apiRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "threescale_operator_api_requests_total",
+			Help: "Requests sent to the 3scale admin portal by method, endpoint and response status code",
+		},
+		[]string{"method", "endpoint", "code"},
+	)
+
+	apiRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "threescale_operator_api_request_duration_seconds",
+			Help:    "Latency of the requests sent to the 3scale admin portal by method, endpoint and response status code",
+			Buckets: prometheus.ExponentialBuckets(0.01, 2, 12),
+		},
+		[]string{"method", "endpoint", "code"},
+	)
+)
+
+func init() {
+	crmetrics.Registry.MustRegister(apiRequests, apiRequestDuration)
+}
+
+// metricsTransport records the count and latency of every request sent to the 3scale admin portal
+type metricsTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *metricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+
+	code := apiRequestErrorCode
+	if err == nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+
+	labels := prometheus.Labels{
+		"method":   req.Method,
+		"endpoint": apiEndpoint(req.URL.Path),
+		"code":     code,
+	}
+	apiRequests.With(labels).Inc()
+	apiRequestDuration.With(labels).Observe(time.Since(start).Seconds())
+
+	return resp, err
+}
+
+// apiEndpoint replaces the object IDs of the path to keep the endpoint label bounded.
+// i.e. /admin/api/services/3/metrics/5.json -> /admin/api/services/:id/metrics/:id.json
+func apiEndpoint(urlPath string) string {
+	segments := strings.Split(urlPath, "/")
+	for idx, segment := range segments {
+		ext := path.Ext(segment)
+		if _, err := strconv.ParseUint(strings.TrimSuffix(segment, ext), 10, 64); err == nil {
+			segments[idx] = ":id" + ext
+		}
+	}
+	return strings.Join(segments, "/")
+}
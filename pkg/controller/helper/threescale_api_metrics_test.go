@@ -0,0 +1,51 @@
+package helper
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestAPIEndpoint(t *testing.T) {
+	cases := []struct {
+		path     string
+		expected string
+	}{
+		{"/admin/api/services.json", "/admin/api/services.json"},
+		{"/admin/api/services/3.json", "/admin/api/services/:id.json"},
+		{"/admin/api/services/3/metrics/5/methods.json", "/admin/api/services/:id/metrics/:id/methods.json"},
+		{"/admin/api/services/3/proxy/configs/sandbox/7/promote.json", "/admin/api/services/:id/proxy/configs/sandbox/:id/promote.json"},
+		{"/admin/api/accounts/find.json", "/admin/api/accounts/find.json"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.path, func(subT *testing.T) {
+			if endpoint := apiEndpoint(tc.path); endpoint != tc.expected {
+				subT.Errorf("expected %s, got %s", tc.expected, endpoint)
+			}
+		})
+	}
+}
+
+func TestMetricsTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	counter := apiRequests.WithLabelValues(http.MethodGet, "/admin/api/backend_apis/:id.json", "404")
+	before := testutil.ToFloat64(counter)
+
+	client := &http.Client{Transport: &metricsTransport{transport: http.DefaultTransport}}
+	resp, err := client.Get(srv.URL + "/admin/api/backend_apis/42.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if after := testutil.ToFloat64(counter); after != before+1 {
+		t.Fatalf("expected request to be counted once, got %v", after-before)
+	}
+}
@@ -0,0 +1,40 @@
+package helper
+
+import (
+	"fmt"
+	"net/http"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+)
+
+const (
+	productPoliciesResourceEndpoint = "/admin/api/services/%d/proxy/policies.json"
+)
+
+// PolicyConfigItem defines policy chain item
+type PolicyConfigItem struct {
+	Name          string                 `json:"name"`
+	Version       string                 `json:"version"`
+	Configuration map[string]interface{} `json:"configuration"`
+	Enabled       bool                   `json:"enabled"`
+}
+
+// PoliciesConfigList defines the product policy chain
+type PoliciesConfigList struct {
+	Policies []PolicyConfigItem `json:"policies_config"`
+}
+
+// ProductPolicies Read product policy chain
+func (c *ThreescaleAPIClient) ProductPolicies(productID int64) (*PoliciesConfigList, error) {
+	obj := &PoliciesConfigList{}
+	err := c.doJSON(http.MethodGet, fmt.Sprintf(productPoliciesResourceEndpoint, productID), nil, http.StatusOK, obj)
+	return obj, err
+}
+
+// UpdateProductPolicies Update product policy chain.
+// "policies_config" param holds the JSON encoded policy chain
+func (c *ThreescaleAPIClient) UpdateProductPolicies(productID int64, params threescaleapi.Params) (*PoliciesConfigList, error) {
+	obj := &PoliciesConfigList{}
+	err := c.doJSON(http.MethodPut, fmt.Sprintf(productPoliciesResourceEndpoint, productID), params, http.StatusOK, obj)
+	return obj, err
+}
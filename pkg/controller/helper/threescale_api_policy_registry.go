@@ -0,0 +1,57 @@
+package helper
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+)
+
+const (
+	policyRegistryListResourceEndpoint = "/admin/api/registry/policies.json"
+	policyRegistryResourceEndpoint     = "/admin/api/registry/policies/%d.json"
+)
+
+type RegistryPolicyItem struct {
+	ID      int64  `json:"id"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	// APIcast policy manifest
+	Schema json.RawMessage `json:"schema"`
+}
+
+type RegistryPolicy struct {
+	Element RegistryPolicyItem `json:"policy"`
+}
+
+type RegistryPolicyList struct {
+	Policies []RegistryPolicy `json:"policies"`
+}
+
+// ListRegistryPolicies List custom policies of the policy registry
+func (c *ThreescaleAPIClient) ListRegistryPolicies() (*RegistryPolicyList, error) {
+	obj := &RegistryPolicyList{}
+	err := c.doJSON(http.MethodGet, policyRegistryListResourceEndpoint, nil, http.StatusOK, obj)
+	return obj, err
+}
+
+// CreateRegistryPolicy Register custom policy.
+// "schema" param holds the JSON encoded policy manifest
+func (c *ThreescaleAPIClient) CreateRegistryPolicy(params threescaleapi.Params) (*RegistryPolicy, error) {
+	obj := &RegistryPolicy{}
+	err := c.doJSON(http.MethodPost, policyRegistryListResourceEndpoint, params, http.StatusCreated, obj)
+	return obj, err
+}
+
+// UpdateRegistryPolicy Update custom policy
+func (c *ThreescaleAPIClient) UpdateRegistryPolicy(id int64, params threescaleapi.Params) (*RegistryPolicy, error) {
+	obj := &RegistryPolicy{}
+	err := c.doJSON(http.MethodPut, fmt.Sprintf(policyRegistryResourceEndpoint, id), params, http.StatusOK, obj)
+	return obj, err
+}
+
+// DeleteRegistryPolicy Remove custom policy from the policy registry
+func (c *ThreescaleAPIClient) DeleteRegistryPolicy(id int64) error {
+	return c.doJSON(http.MethodDelete, fmt.Sprintf(policyRegistryResourceEndpoint, id), nil, http.StatusOK, nil)
+}
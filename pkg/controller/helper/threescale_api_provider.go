@@ -0,0 +1,28 @@
+package helper
+
+import (
+	"net/http"
+)
+
+const (
+	providerAccountResourceEndpoint = "/admin/api/provider.json"
+)
+
+type ProviderAccountItem struct {
+	ID           int64  `json:"id"`
+	State        string `json:"state"`
+	OrgName      string `json:"org_name"`
+	AdminDomain  string `json:"admin_domain"`
+	SupportEmail string `json:"support_email"`
+}
+
+type ProviderAccountJSON struct {
+	Element ProviderAccountItem `json:"account"`
+}
+
+// ReadProviderAccount Returns the provider account of the access token
+func (c *ThreescaleAPIClient) ReadProviderAccount() (*ProviderAccountJSON, error) {
+	obj := &ProviderAccountJSON{}
+	err := c.doJSON(http.MethodGet, providerAccountResourceEndpoint, nil, http.StatusOK, obj)
+	return obj, err
+}
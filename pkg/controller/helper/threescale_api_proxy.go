@@ -0,0 +1,66 @@
+package helper
+
+import (
+	"fmt"
+	"net/http"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+)
+
+const (
+	productProxyExtResourceEndpoint        = "/admin/api/services/%d/proxy.json"
+	productProxyOIDCConfigResourceEndpoint = "/admin/api/services/%d/proxy/oidc_configuration.json"
+)
+
+// ProxyExtItem extends porta client ProxyItem with attributes the porta client does not decode
+type ProxyExtItem struct {
+	threescaleapi.ProxyItem
+	OidcIssuerType           string `json:"oidc_issuer_type"`
+	JwtClaimWithClientID     string `json:"jwt_claim_with_client_id"`
+	JwtClaimWithClientIDType string `json:"jwt_claim_with_client_id_type"`
+}
+
+type ProxyExtJSON struct {
+	Element ProxyExtItem `json:"proxy"`
+}
+
+// OIDCConfigurationItem defines the OAuth2.0 flows enabled for the OpenID Connect authentication
+type OIDCConfigurationItem struct {
+	ID                        int64 `json:"id"`
+	StandardFlowEnabled       bool  `json:"standard_flow_enabled"`
+	ImplicitFlowEnabled       bool  `json:"implicit_flow_enabled"`
+	ServiceAccountsEnabled    bool  `json:"service_accounts_enabled"`
+	DirectAccessGrantsEnabled bool  `json:"direct_access_grants_enabled"`
+}
+
+type OIDCConfiguration struct {
+	Element OIDCConfigurationItem `json:"oidc_configuration"`
+}
+
+// ProductProxyExt Read product proxy including attributes not decoded by porta client
+func (c *ThreescaleAPIClient) ProductProxyExt(productID int64) (*ProxyExtJSON, error) {
+	obj := &ProxyExtJSON{}
+	err := c.doJSON(http.MethodGet, fmt.Sprintf(productProxyExtResourceEndpoint, productID), nil, http.StatusOK, obj)
+	return obj, err
+}
+
+// UpdateProductProxyExt Update product proxy including attributes not decoded by porta client
+func (c *ThreescaleAPIClient) UpdateProductProxyExt(productID int64, params threescaleapi.Params) (*ProxyExtJSON, error) {
+	obj := &ProxyExtJSON{}
+	err := c.doJSON(http.MethodPut, fmt.Sprintf(productProxyExtResourceEndpoint, productID), params, http.StatusOK, obj)
+	return obj, err
+}
+
+// ProductOIDCConfiguration Read product proxy OIDC configuration
+func (c *ThreescaleAPIClient) ProductOIDCConfiguration(productID int64) (*OIDCConfiguration, error) {
+	obj := &OIDCConfiguration{}
+	err := c.doJSON(http.MethodGet, fmt.Sprintf(productProxyOIDCConfigResourceEndpoint, productID), nil, http.StatusOK, obj)
+	return obj, err
+}
+
+// UpdateProductOIDCConfiguration Update product proxy OIDC configuration
+func (c *ThreescaleAPIClient) UpdateProductOIDCConfiguration(productID int64, params threescaleapi.Params) (*OIDCConfiguration, error) {
+	obj := &OIDCConfiguration{}
+	err := c.doJSON(http.MethodPatch, fmt.Sprintf(productProxyOIDCConfigResourceEndpoint, productID), params, http.StatusOK, obj)
+	return obj, err
+}
@@ -0,0 +1,45 @@
+package helper
+
+import (
+	"fmt"
+	"net/http"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+)
+
+const (
+	// ProxyConfigStagingEnvironment is the 3scale name of the staging environment
+	ProxyConfigStagingEnvironment = "sandbox"
+	// ProxyConfigProductionEnvironment is the 3scale name of the production environment
+	ProxyConfigProductionEnvironment = "production"
+
+	productProxyConfigLatestResourceEndpoint  = "/admin/api/services/%d/proxy/configs/%s/latest.json"
+	productProxyConfigPromoteResourceEndpoint = "/admin/api/services/%d/proxy/configs/%s/%d/promote.json"
+)
+
+// ProxyConfigVersionItem holds proxy config identification attributes.
+// Proxy config content is not decoded.
+type ProxyConfigVersionItem struct {
+	ID          int64  `json:"id"`
+	Version     int64  `json:"version"`
+	Environment string `json:"environment"`
+}
+
+type ProxyConfigVersion struct {
+	Element ProxyConfigVersionItem `json:"proxy_config"`
+}
+
+// ProductLatestProxyConfig Read latest product proxy config of the given environment
+func (c *ThreescaleAPIClient) ProductLatestProxyConfig(productID int64, env string) (*ProxyConfigVersion, error) {
+	obj := &ProxyConfigVersion{}
+	err := c.doJSON(http.MethodGet, fmt.Sprintf(productProxyConfigLatestResourceEndpoint, productID, env), nil, http.StatusOK, obj)
+	return obj, err
+}
+
+// PromoteProductProxyConfig Promote product proxy config version from one environment to another environment
+func (c *ThreescaleAPIClient) PromoteProductProxyConfig(productID int64, env string, version int64, toEnv string) (*ProxyConfigVersion, error) {
+	obj := &ProxyConfigVersion{}
+	params := threescaleapi.Params{"to": toEnv}
+	err := c.doJSON(http.MethodPost, fmt.Sprintf(productProxyConfigPromoteResourceEndpoint, productID, env, version), params, http.StatusCreated, obj)
+	return obj, err
+}
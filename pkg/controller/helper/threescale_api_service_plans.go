@@ -0,0 +1,61 @@
+package helper
+
+import (
+	"fmt"
+	"net/http"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+)
+
+const (
+	servicePlanListResourceEndpoint = "/admin/api/services/%d/service_plans.json"
+	servicePlanResourceEndpoint     = "/admin/api/services/%d/service_plans/%d.json"
+)
+
+// ServicePlanItem defines product service plan.
+// Service plans gate developer account subscriptions to the product
+type ServicePlanItem struct {
+	ID               int64   `json:"id"`
+	Name             string  `json:"name"`
+	SystemName       string  `json:"system_name"`
+	State            string  `json:"state"`
+	SetupFee         float64 `json:"setup_fee"`
+	CostPerMonth     float64 `json:"cost_per_month"`
+	TrialPeriodDays  int     `json:"trial_period_days"`
+	ApprovalRequired bool    `json:"approval_required"`
+	Default          bool    `json:"default"`
+}
+
+type ServicePlan struct {
+	Element ServicePlanItem `json:"service_plan"`
+}
+
+type ServicePlanList struct {
+	Plans []ServicePlan `json:"plans"`
+}
+
+// ListServicePlans List existing service plans for a product
+func (c *ThreescaleAPIClient) ListServicePlans(productID int64) (*ServicePlanList, error) {
+	obj := &ServicePlanList{}
+	err := c.doJSON(http.MethodGet, fmt.Sprintf(servicePlanListResourceEndpoint, productID), nil, http.StatusOK, obj)
+	return obj, err
+}
+
+// CreateServicePlan Create product service plan
+func (c *ThreescaleAPIClient) CreateServicePlan(productID int64, params threescaleapi.Params) (*ServicePlan, error) {
+	obj := &ServicePlan{}
+	err := c.doJSON(http.MethodPost, fmt.Sprintf(servicePlanListResourceEndpoint, productID), params, http.StatusCreated, obj)
+	return obj, err
+}
+
+// UpdateServicePlan Update product service plan
+func (c *ThreescaleAPIClient) UpdateServicePlan(productID, planID int64, params threescaleapi.Params) (*ServicePlan, error) {
+	obj := &ServicePlan{}
+	err := c.doJSON(http.MethodPut, fmt.Sprintf(servicePlanResourceEndpoint, productID, planID), params, http.StatusOK, obj)
+	return obj, err
+}
+
+// DeleteServicePlan Delete product service plan
+func (c *ThreescaleAPIClient) DeleteServicePlan(productID, planID int64) error {
+	return c.doJSON(http.MethodDelete, fmt.Sprintf(servicePlanResourceEndpoint, productID, planID), nil, http.StatusOK, nil)
+}
@@ -0,0 +1,388 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	"k8s.io/client-go/util/flowcontrol"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	THREESCALE_RATE_LIMIT_ENVVAR = "THREESCALE_RATE_LIMIT"
+
+	// DefaultThreescaleRateLimit is the maximum number of requests per second sent to each tenant
+	// when THREESCALE_RATE_LIMIT is not set
+	DefaultThreescaleRateLimit = 10
+
+	// ThreescaleUnavailableRequeueDelay is the time the circuit of an unavailable tenant stays open.
+	// Resources failing because 3scale is unavailable are reconciled again after this delay
+	ThreescaleUnavailableRequeueDelay = 30 * time.Second
+
+	// threescaleRequestTimeout bounds every attempt of a request
+	threescaleRequestTimeout = 30 * time.Second
+	// threescaleMaxRetries is the number of retries of failed retryable requests
+	threescaleMaxRetries = 3
+	// threescaleRetryBaseDelay and threescaleRetryMaxDelay bound the exponential backoff between retries
+	threescaleRetryBaseDelay = 200 * time.Millisecond
+	threescaleRetryMaxDelay  = 5 * time.Second
+	// threescaleCircuitThreshold is the number of consecutive failed requests opening the circuit
+	threescaleCircuitThreshold = 5
+)
+
+// ErrThreescaleCircuitOpen is returned when requests are not sent
+// because the latest requests to the tenant failed
+var ErrThreescaleCircuitOpen = errors.New("circuit breaker open after consecutive failures")
+
+// ThreescaleUnavailableError is returned when the 3scale admin portal could not be reached
+type ThreescaleUnavailableError struct {
+	Host string
+	Err  error
+}
+
+func (e *ThreescaleUnavailableError) Error() string {
+	return fmt.Sprintf("3scale admin portal %s unavailable: %v", e.Host, e.Err)
+}
+
+func (e *ThreescaleUnavailableError) Unwrap() error {
+	return e.Err
+}
+
+// IsThreescaleUnavailable returns true when the 3scale admin portal could not be reached
+// or it kept responding with gateway errors after retrying.
+// Errors of several tasks are only unavailability errors when all of them are
+func IsThreescaleUnavailable(err error) bool {
+	var taskErrs helper.TaskErrors
+	if errors.As(err, &taskErrs) {
+		for _, taskErr := range taskErrs {
+			if !IsThreescaleUnavailable(taskErr) {
+				return false
+			}
+		}
+		return len(taskErrs) > 0
+	}
+
+	var unavailableErr *ThreescaleUnavailableError
+	if errors.As(err, &unavailableErr) {
+		return true
+	}
+
+	return isUnavailableStatusCode(ThreescaleAPIErrorCode(err))
+}
+
+func isUnavailableStatusCode(code int) bool {
+	switch code {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// threescaleStateEventActions are the 3scale endpoint actions transitioning the state of an object,
+// i.e. /admin/api/accounts/:id/approve.json
+var threescaleStateEventActions = map[string]bool{
+	"approve":      true,
+	"reject":       true,
+	"make_pending": true,
+	"suspend":      true,
+	"resume":       true,
+	"activate":     true,
+}
+
+// isRetryableRequest returns true for the requests that can be sent again without side effects.
+// 3scale PUT requests setting attributes have the same effect when sent twice.
+// State transitions, like publishing a plan or suspending a tenant, are rejected when sent again after succeeding
+func isRetryableRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead:
+		return true
+	case http.MethodPut:
+		// Requests with body can only be sent again when the body can be read again
+		return (req.Body == nil || req.GetBody != nil) && !isStateTransition(req)
+	}
+	return false
+}
+
+// isStateTransition returns true when the request triggers a state event,
+// either with the state_event parameter or with a state event action endpoint
+func isStateTransition(req *http.Request) bool {
+	action := strings.TrimSuffix(path.Base(req.URL.Path), path.Ext(req.URL.Path))
+	if threescaleStateEventActions[action] || req.URL.Query().Get("state_event") != "" {
+		return true
+	}
+
+	if req.GetBody == nil {
+		return false
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		// Unknown, not retried
+		return true
+	}
+	defer body.Close()
+
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return true
+	}
+
+	values, err := url.ParseQuery(string(data))
+	return err != nil || values.Get("state_event") != ""
+}
+
+// circuitBreaker stops sending requests to a tenant once threescaleCircuitThreshold consecutive requests failed.
+// After ThreescaleUnavailableRequeueDelay, a single trial request is sent. Its result closes or opens the circuit again.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	// trial is true while the trial request of the half open circuit is in flight
+	trial bool
+	now   func() time.Time
+}
+
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < threescaleCircuitThreshold {
+		return true
+	}
+
+	if b.now().Before(b.openUntil) || b.trial {
+		return false
+	}
+
+	b.trial = true
+	return true
+}
+
+func (b *circuitBreaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = 0
+	b.trial = false
+}
+
+// abort releases the trial request without result
+func (b *circuitBreaker) abort() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.trial = false
+}
+
+func (b *circuitBreaker) failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	b.trial = false
+	if b.failures >= threescaleCircuitThreshold {
+		b.openUntil = b.now().Add(ThreescaleUnavailableRequeueDelay)
+	}
+}
+
+// tenantGuard holds the rate limiter and circuit breaker shared by the clients of one tenant
+type tenantGuard struct {
+	limiter flowcontrol.RateLimiter
+	breaker *circuitBreaker
+}
+
+var tenantGuards = struct {
+	sync.Mutex
+	guards map[string]*tenantGuard
+}{guards: map[string]*tenantGuard{}}
+
+// tenantGuardFor returns the guard shared by the clients of the tenant
+func tenantGuardFor(baseURL *url.URL) *tenantGuard {
+	tenantGuards.Lock()
+	defer tenantGuards.Unlock()
+
+	key := baseURL.String()
+	guard, ok := tenantGuards.guards[key]
+	if !ok {
+		limiter := flowcontrol.NewFakeAlwaysRateLimiter()
+		if qps := ThreescaleRateLimit(); qps > 0 {
+			burst := int(2 * qps)
+			if burst < 1 {
+				burst = 1
+			}
+			limiter = flowcontrol.NewTokenBucketRateLimiter(float32(qps), burst)
+		}
+
+		guard = &tenantGuard{
+			limiter: limiter,
+			breaker: &circuitBreaker{now: time.Now},
+		}
+		tenantGuards.guards[key] = guard
+	}
+
+	return guard
+}
+
+// ThreescaleRateLimit reads the maximum number of requests per second to each tenant from the environment variable.
+// Zero disables the rate limit. DefaultThreescaleRateLimit when not set or not valid.
+func ThreescaleRateLimit() float64 {
+	value := helper.GetEnvVar(THREESCALE_RATE_LIMIT_ENVVAR, "")
+	if value == "" {
+		return DefaultThreescaleRateLimit
+	}
+
+	qps, err := strconv.ParseFloat(value, 64)
+	if err != nil || qps < 0 {
+		logf.Log.WithName("threescale_api").Info("invalid rate limit, using default", THREESCALE_RATE_LIMIT_ENVVAR, value, "default", DefaultThreescaleRateLimit)
+		return DefaultThreescaleRateLimit
+	}
+
+	return qps
+}
+
+// resilientTransport sends requests to the 3scale admin portal
+// honoring the tenant rate limit and circuit breaker.
+// Retryable requests failing because 3scale is unavailable are retried with exponential backoff and jitter.
+type resilientTransport struct {
+	transport http.RoundTripper
+	guard     *tenantGuard
+	// sleep waits between retries. Replaceable for testing purposes
+	sleep func(context.Context, time.Duration) error
+}
+
+func newResilientTransport(transport http.RoundTripper, baseURL *url.URL) *resilientTransport {
+	return &resilientTransport{
+		transport: transport,
+		guard:     tenantGuardFor(baseURL),
+		sleep:     sleepContext,
+	}
+}
+
+func (t *resilientTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	retryable := isRetryableRequest(req)
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 {
+			err := t.sleep(req.Context(), backoffDelay(attempt))
+			if err != nil {
+				return nil, err
+			}
+
+			attemptReq, err = cloneRequest(req)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if !t.guard.breaker.allow() {
+			return nil, &ThreescaleUnavailableError{Host: req.URL.Host, Err: ErrThreescaleCircuitOpen}
+		}
+
+		err := t.guard.limiter.Wait(req.Context())
+		if err != nil {
+			// The request was not sent, the trial request of the half open circuit is released
+			t.guard.breaker.abort()
+			return nil, err
+		}
+
+		resp, err := t.roundTripWithTimeout(attemptReq)
+		if req.Context().Err() != nil {
+			// Canceled by the caller, it says nothing about 3scale availability
+			t.guard.breaker.abort()
+			return resp, err
+		}
+
+		unavailable := err != nil || isUnavailableStatusCode(resp.StatusCode)
+		if unavailable {
+			t.guard.breaker.failure()
+		} else {
+			t.guard.breaker.success()
+		}
+
+		retry := unavailable || resp.StatusCode == http.StatusTooManyRequests
+		if !retry || !retryable || attempt == threescaleMaxRetries {
+			if err != nil {
+				return nil, &ThreescaleUnavailableError{Host: req.URL.Host, Err: err}
+			}
+			// Error responses are decoded by the client
+			return resp, nil
+		}
+
+		if resp != nil {
+			// Drain the body to reuse the connection
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+	}
+}
+
+// roundTripWithTimeout sends the request canceling it after threescaleRequestTimeout.
+// The response body must be read before the timeout as well
+func (t *resilientTransport) roundTripWithTimeout(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), threescaleRequestTimeout)
+	resp, err := t.transport.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	resp.Body = &cancelReadCloser{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelReadCloser releases the request context once the response body is closed
+type cancelReadCloser struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelReadCloser) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
+
+// cloneRequest returns a copy of the request with a new body to be sent again
+func cloneRequest(req *http.Request) (*http.Request, error) {
+	clone := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		clone.Body = body
+	}
+	return clone, nil
+}
+
+// backoffDelay returns the delay before the given retry, exponential with full jitter
+func backoffDelay(attempt int) time.Duration {
+	maxDelay := threescaleRetryBaseDelay << uint(attempt-1)
+	if maxDelay > threescaleRetryMaxDelay || maxDelay <= 0 {
+		maxDelay = threescaleRetryMaxDelay
+	}
+	return time.Duration(rand.Int63n(int64(maxDelay) + 1))
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
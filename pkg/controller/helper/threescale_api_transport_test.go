@@ -0,0 +1,196 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/3scale/3scale-operator/pkg/helper"
+)
+
+func newTestResilientTransport(t *testing.T, srv *httptest.Server) *resilientTransport {
+	baseURL, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transport := newResilientTransport(http.DefaultTransport, baseURL)
+	transport.sleep = func(context.Context, time.Duration) error { return nil }
+	return transport
+}
+
+func TestResilientTransportRetries(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: newTestResilientTransport(t, srv)}
+
+	req, _ := http.NewRequest(http.MethodPut, srv.URL, strings.NewReader("name=test"))
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || requests != 3 {
+		t.Fatalf("expected success after 2 retries, got %d after %d requests", resp.StatusCode, requests)
+	}
+}
+
+func TestResilientTransportNotRetryable(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: newTestResilientTransport(t, srv)}
+
+	req, _ := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader("name=test"))
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable || requests != 1 {
+		t.Fatalf("expected POST not retried, got %d after %d requests", resp.StatusCode, requests)
+	}
+}
+
+func TestResilientTransportCircuitBreaker(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	transport := newTestResilientTransport(t, srv)
+	now := time.Now()
+	transport.guard.breaker.now = func() time.Time { return now }
+	client := &http.Client{Transport: transport}
+
+	// threescaleMaxRetries + 1 attempts
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(srv.URL)
+		if err != nil {
+			break
+		}
+		resp.Body.Close()
+	}
+	if requests != threescaleCircuitThreshold {
+		t.Fatalf("expected circuit opened after %d requests, got %d", threescaleCircuitThreshold, requests)
+	}
+
+	_, err := client.Get(srv.URL)
+	if !IsThreescaleUnavailable(err) {
+		t.Fatalf("expected unavailable error, got %v", err)
+	}
+
+	// Half open circuit sends a trial request
+	now = now.Add(ThreescaleUnavailableRequeueDelay)
+	client.Get(srv.URL)
+	if requests != threescaleCircuitThreshold+1 {
+		t.Fatalf("expected a single trial request, got %d requests", requests)
+	}
+}
+
+func TestResilientTransportCanceledTrial(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	transport := newTestResilientTransport(t, srv)
+	now := time.Now()
+	transport.guard.breaker.now = func() time.Time { return now }
+	for i := 0; i < threescaleCircuitThreshold; i++ {
+		transport.guard.breaker.failure()
+	}
+	client := &http.Client{Transport: transport}
+
+	// The trial request is canceled while waiting for the rate limiter
+	now = now.Add(ThreescaleUnavailableRequeueDelay)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if _, err := client.Do(req.WithContext(ctx)); err == nil {
+		t.Fatal("expected canceled request error")
+	}
+
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("expected a new trial request after the canceled one, got %v", err)
+	}
+	resp.Body.Close()
+	if requests != 1 {
+		t.Fatalf("expected a single request, got %d", requests)
+	}
+}
+
+func TestIsRetryableRequest(t *testing.T) {
+	cases := []struct {
+		testName string
+		method   string
+		path     string
+		body     string
+		expected bool
+	}{
+		{"get", http.MethodGet, "/admin/api/services.json", "", true},
+		{"post", http.MethodPost, "/admin/api/services.json", "name=test", false},
+		{"put attributes", http.MethodPut, "/admin/api/services/3.json", "name=test", true},
+		{"put plan state event", http.MethodPut, "/admin/api/services/3/application_plans/4.json", "name=test&state_event=publish", false},
+		{"put state event action", http.MethodPut, "/admin/api/accounts/3/approve.json", "", false},
+		{"put change plan", http.MethodPut, "/admin/api/accounts/3/change_plan.json", "plan_id=4", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			req, _ := http.NewRequest(tc.method, "https://3scale-admin.example.com"+tc.path, strings.NewReader(tc.body))
+			if retryable := isRetryableRequest(req); retryable != tc.expected {
+				subT.Errorf("expected retryable %t, got %t", tc.expected, retryable)
+			}
+		})
+	}
+}
+
+func TestIsThreescaleUnavailableTaskErrors(t *testing.T) {
+	unavailableErr := &ThreescaleUnavailableError{Host: "3scale-admin.example.com", Err: ErrThreescaleCircuitOpen}
+	invalidErr := &ThreescaleAPIError{code: http.StatusUnprocessableEntity, message: "invalid"}
+
+	cases := []struct {
+		testName string
+		err      error
+		expected bool
+	}{
+		{"unavailable", unavailableErr, true},
+		{"all tasks unavailable", fmt.Errorf("sync: %w", helper.TaskErrors{unavailableErr, unavailableErr}), true},
+		{"some tasks unavailable", fmt.Errorf("sync: %w", helper.TaskErrors{unavailableErr, invalidErr}), false},
+		{"no task unavailable", helper.TaskErrors{invalidErr}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			if unavailable := IsThreescaleUnavailable(tc.err); unavailable != tc.expected {
+				subT.Errorf("expected unavailable %t, got %t", tc.expected, unavailable)
+			}
+		})
+	}
+}
@@ -10,23 +10,39 @@ import (
 
 	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
+// syncParallelism is the maximum number of sync tasks executed concurrently.
+// Bounds the concurrent requests to the 3scale API at each level: product sections, plans and plan items.
+const syncParallelism = 4
+
 type ThreescaleReconciler struct {
 	*reconcilers.BaseReconciler
 	resource            *capabilitiesv1beta1.Product
 	productEntity       *controllerhelper.ProductEntity
 	backendRemoteIndex  *controllerhelper.BackendAPIRemoteIndex
-	threescaleAPIClient *threescaleapi.ThreeScaleClient
-	logger              logr.Logger
+	threescaleAPIClient *controllerhelper.ThreescaleAPIClient
+	policyChainDrift    bool
+	drift               *controllerhelper.DriftRecorder
+	plan                *controllerhelper.ChangePlanner
+	// latest proxy config versions, only known when the sync process completed
+	stagingConfigVersion    *int64
+	productionConfigVersion *int64
+	logger                  logr.Logger
 }
 
-func NewThreescaleReconciler(b *reconcilers.BaseReconciler, resource *capabilitiesv1beta1.Product, threescaleAPIClient *threescaleapi.ThreeScaleClient, backendRemoteIndex *controllerhelper.BackendAPIRemoteIndex) *ThreescaleReconciler {
+func NewThreescaleReconciler(b *reconcilers.BaseReconciler, resource *capabilitiesv1beta1.Product, threescaleAPIClient *controllerhelper.ThreescaleAPIClient, backendRemoteIndex *controllerhelper.BackendAPIRemoteIndex) *ThreescaleReconciler {
+	// Once the spec generation has been synchronized, any difference is 3scale drift
+	detectDrift := resource.IsGenerationSynced()
+
 	return &ThreescaleReconciler{
 		BaseReconciler:      b,
 		resource:            resource,
 		threescaleAPIClient: threescaleAPIClient,
 		backendRemoteIndex:  backendRemoteIndex,
+		drift:               controllerhelper.NewDriftRecorder(detectDrift, resource.Spec.ReportDriftOnly()),
+		plan:                controllerhelper.NewChangePlanner(resource.Spec.DryRun),
 		logger:              b.Logger().WithValues("3scale Reconciler", resource.Name),
 	}
 }
@@ -36,29 +52,111 @@ func (t *ThreescaleReconciler) Reconcile() (*controllerhelper.ProductEntity, err
 	if err != nil {
 		return nil, err
 	}
+
+	if productEntity == nil {
+		// Dry run of a product not created yet
+		t.planNewProduct()
+		return nil, nil
+	}
 	t.productEntity = productEntity
 
-	taskRunner := helper.NewTaskRunner(nil, t.logger)
+	// Product sections are synchronized concurrently once the product is synchronized.
+	// Sections depending on each other, or sharing product data read on demand, are chained.
+	taskRunner := helper.NewParallelTaskRunner(nil, syncParallelism, t.logger)
+	taskRunner.ObserveDurations("product-controller")
 	taskRunner.AddTask("SyncProduct", t.syncProduct)
-	taskRunner.AddTask("SyncBackendUsage", t.syncBackendUsage)
-	taskRunner.AddTask("SyncProxy", t.syncProxy)
+	taskRunner.AddTaskWithDependencies("SyncBackendUsage", t.syncBackendUsage, "SyncProduct")
+	taskRunner.AddTaskWithDependencies("SyncProxy", t.syncProxy, "SyncProduct")
+	taskRunner.AddTaskWithDependencies("SyncPolicies", t.syncPolicies, "SyncProduct")
 	// First methods and metrics, then mapping rules.
 	// Mapping rules reference methods and metrics.
 	// When a method/metric is deleted,
 	// any orphan mapping rule will be deleted automatically by 3scale
-	taskRunner.AddTask("SyncMethods", t.syncMethods)
-	taskRunner.AddTask("SyncMetrics", t.syncMetrics)
-	taskRunner.AddTask("SyncMappingRules", t.syncMappingRules)
-	taskRunner.AddTask("SyncApplicationPlans", t.syncApplicationPlans)
+	taskRunner.AddTaskWithDependencies("SyncMethods", t.syncMethods, "SyncProduct")
+	taskRunner.AddTaskWithDependencies("SyncMetrics", t.syncMetrics, "SyncMethods")
+	taskRunner.AddTaskWithDependencies("SyncMappingRules", t.syncMappingRules, "SyncMetrics", "SyncBackendUsage")
+	taskRunner.AddTaskWithDependencies("SyncFeatures", t.syncFeatures, "SyncProduct")
+	// Application plans reference features, methods and metrics
+	taskRunner.AddTaskWithDependencies("SyncApplicationPlans", t.syncApplicationPlans, "SyncFeatures", "SyncMappingRules")
+	taskRunner.AddTaskWithDependencies("SyncServicePlans", t.syncServicePlans, "SyncProduct")
+	// Promotion to production must be the last task
+	taskRunner.AddTask("PromoteProxyConfig", t.promoteProxyConfig)
 
+	// The product entity is returned on error as well, the product exists and it is managed by the resource
 	err = taskRunner.Run()
 	if err != nil {
-		return nil, err
+		return t.productEntity, err
 	}
 
 	return t.productEntity, nil
 }
 
+// PlannedChanges returns the changes computed in dry run mode
+func (t *ThreescaleReconciler) PlannedChanges() []capabilitiesv1beta1.PlannedChange {
+	return t.plan.Changes()
+}
+
+// skipChange returns true when the change must not be applied to 3scale,
+// because drift is only reported or changes are only planned
+func (t *ThreescaleReconciler) skipChange(section, action, name string, params threescaleapi.Params) bool {
+	return t.drift.SkipCorrection(section) || t.plan.Skip(section, action, name, params)
+}
+
+// planNewProduct records the creation of the product and all the items of the spec
+func (t *ThreescaleReconciler) planNewProduct() {
+	t.plan.Skip(controllerhelper.DriftSectionProduct, capabilitiesv1beta1.PlannedChangeCreate, t.resource.Spec.SystemName, nil)
+
+	for systemName := range t.resource.Spec.BackendUsages {
+		t.plan.Skip(controllerhelper.DriftSectionBackendUsages, capabilitiesv1beta1.PlannedChangeCreate, systemName, nil)
+	}
+
+	if t.resource.Spec.Policies != nil {
+		t.plan.Skip(controllerhelper.DriftSectionPolicies, capabilitiesv1beta1.PlannedChangeUpdate, t.resource.Spec.SystemName, nil)
+	}
+
+	for systemName := range t.resource.Spec.Methods {
+		t.plan.Skip(controllerhelper.DriftSectionMethods, capabilitiesv1beta1.PlannedChangeCreate, systemName, nil)
+	}
+
+	for systemName := range t.resource.Spec.Metrics {
+		t.plan.Skip(controllerhelper.DriftSectionMetrics, capabilitiesv1beta1.PlannedChangeCreate, systemName, nil)
+	}
+
+	for _, spec := range t.resource.Spec.MappingRules {
+		key := fmt.Sprintf("%s:%s", spec.HTTPMethod, spec.Pattern)
+		t.plan.Skip(controllerhelper.DriftSectionMappingRules, capabilitiesv1beta1.PlannedChangeCreate, key, nil)
+	}
+
+	for systemName := range t.resource.Spec.Features {
+		t.plan.Skip(controllerhelper.DriftSectionFeatures, capabilitiesv1beta1.PlannedChangeCreate, systemName, nil)
+	}
+
+	for systemName := range t.resource.Spec.ApplicationPlans {
+		t.plan.Skip(controllerhelper.DriftSectionApplicationPlans, capabilitiesv1beta1.PlannedChangeCreate, systemName, nil)
+	}
+
+	for systemName := range t.resource.Spec.ServicePlans {
+		t.plan.Skip(controllerhelper.DriftSectionServicePlans, capabilitiesv1beta1.PlannedChangeCreate, systemName, nil)
+	}
+}
+
+// PolicyChainDrift returns true when the 3scale policy chain differed
+// from the desired policy chain and it was overwritten
+func (t *ThreescaleReconciler) PolicyChainDrift() bool {
+	return t.policyChainDrift
+}
+
+// DriftedSections returns the sections where 3scale product differed from the already synchronized spec
+func (t *ThreescaleReconciler) DriftedSections() []string {
+	return t.drift.Sections()
+}
+
+// ProxyConfigVersions returns the latest staging and production proxy config versions.
+// Nil when unknown.
+func (t *ThreescaleReconciler) ProxyConfigVersions() (*int64, *int64) {
+	return t.stagingConfigVersion, t.productionConfigVersion
+}
+
 func (t *ThreescaleReconciler) reconcile3scaleProduct() (*controllerhelper.ProductEntity, error) {
 	productList, err := t.threescaleAPIClient.ListProducts()
 	if err != nil {
@@ -78,7 +176,27 @@ func (t *ThreescaleReconciler) reconcile3scaleProduct() (*controllerhelper.Produ
 	var productObj *threescaleapi.Product
 	if exists {
 		productObj = &productList.Products[idx]
+		// Existing products not created by this resource are only taken over on explicit adoption
+		if !t.resource.Spec.Adopt && !t.resource.Owns(productObj.Element.ID) {
+			return nil, &helper.SpecFieldError{
+				ErrorType: helper.InvalidError,
+				FieldErrorList: field.ErrorList{
+					field.Invalid(field.NewPath("spec").Child("systemName"), t.resource.Spec.SystemName,
+						"3scale product already exists and it is not managed by this resource. Set spec.adopt to take it over"),
+				},
+			}
+		}
+	} else if t.plan.Enabled() {
+		// Nothing to read from 3scale in dry run mode
+		return nil, nil
 	} else {
+		// The product is claimed before its creation.
+		// When the response or the status update are lost, the next reconciliation still owns it
+		err = controllerhelper.ClaimSystemName(t.Context(), t.Client(), t.resource, t.resource.Spec.SystemName)
+		if err != nil {
+			return nil, fmt.Errorf("reconcile3scaleProduct product [%s]: claim: %w", t.resource.Spec.SystemName, err)
+		}
+
 		// Create product using system_name.
 		// it cannot be modified later
 		params := threescaleapi.Params{
@@ -86,9 +204,24 @@ func (t *ThreescaleReconciler) reconcile3scaleProduct() (*controllerhelper.Produ
 		}
 		product, err := t.threescaleAPIClient.CreateProduct(t.resource.Spec.Name, params)
 		if err != nil {
+			if controllerhelper.IsCreationRejected(err) {
+				releaseErr := controllerhelper.ReleaseSystemNameClaim(t.Context(), t.Client(), t.resource)
+				if releaseErr != nil {
+					t.logger.Error(releaseErr, "failed to release product system name claim")
+				}
+			}
 			return nil, fmt.Errorf("reconcile3scaleProduct product [%s]: %w", t.resource.Spec.SystemName, err)
 		}
 
+		// The ID is recorded before any other task runs. On failure, the claim keeps the ownership
+		err = controllerhelper.PatchStatus(t.Context(), t.Client(), t.resource, func() {
+			productID := product.Element.ID
+			t.resource.Status.ID = &productID
+		})
+		if err != nil {
+			t.logger.Error(err, "failed to record product ID", "ID", product.Element.ID)
+		}
+
 		productObj = product
 	}
 
@@ -0,0 +1,198 @@
+package product
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// fakeProductsAPI serves the 3scale products list and creation endpoint
+type fakeProductsAPI struct {
+	mu       sync.Mutex
+	products []threescaleapi.Product
+	// createStatus overrides the response status of the next creation
+	createStatus int
+	// createProduct is false when the creation is rejected
+	createProduct bool
+}
+
+func (f *fakeProductsAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if r.URL.Path != "/admin/api/services.json" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if r.Method == http.MethodGet {
+		json.NewEncoder(w).Encode(threescaleapi.ProductList{Products: f.products})
+		return
+	}
+
+	r.ParseForm()
+	product := threescaleapi.Product{Element: threescaleapi.ProductItem{
+		ID:         int64(len(f.products) + 1),
+		Name:       r.PostForm.Get("name"),
+		SystemName: r.PostForm.Get("system_name"),
+	}}
+	if f.createProduct {
+		f.products = append(f.products, product)
+	}
+
+	w.WriteHeader(f.createStatus)
+	json.NewEncoder(w).Encode(product)
+}
+
+func newTestProductReconciler(t *testing.T, k8sClient client.Client, nn types.NamespacedName, srv *httptest.Server) *ThreescaleReconciler {
+	product := &capabilitiesv1beta1.Product{}
+	err := k8sClient.Get(context.TODO(), nn, product)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	adminURL, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	threescaleAPIClient, err := controllerhelper.PortaClientFromURL(adminURL, "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := runtime.NewScheme()
+	if err := capabilitiesv1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	baseReconciler := reconcilers.NewBaseReconciler(k8sClient, s, k8sClient, context.TODO(), logf.Log.WithName("test"), nil, record.NewFakeRecorder(10))
+	return NewThreescaleReconciler(baseReconciler, product, threescaleAPIClient, nil)
+}
+
+func newTestProductClient(t *testing.T, annotations map[string]string) (client.Client, types.NamespacedName) {
+	product := &capabilitiesv1beta1.Product{
+		ObjectMeta: metav1.ObjectMeta{Name: "product1", Namespace: "test", Annotations: annotations},
+		Spec:       capabilitiesv1beta1.ProductSpec{Name: "Product 1", SystemName: "product1"},
+	}
+
+	s := runtime.NewScheme()
+	if err := capabilitiesv1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	return fake.NewFakeClientWithScheme(s, product), types.NamespacedName{Name: "product1", Namespace: "test"}
+}
+
+// loseStatus discards the recorded product ID, as a lost status update does
+func loseStatus(t *testing.T, k8sClient client.Client, nn types.NamespacedName) {
+	product := &capabilitiesv1beta1.Product{}
+	if err := k8sClient.Get(context.TODO(), nn, product); err != nil {
+		t.Fatal(err)
+	}
+	product.Status.ID = nil
+	if err := k8sClient.Update(context.TODO(), product); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReconcile3scaleProductLostStatus(t *testing.T) {
+	api := &fakeProductsAPI{createStatus: http.StatusCreated, createProduct: true}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+
+	k8sClient, nn := newTestProductClient(t, nil)
+
+	entity, err := newTestProductReconciler(t, k8sClient, nn, srv).reconcile3scaleProduct()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loseStatus(t, k8sClient, nn)
+
+	sameEntity, err := newTestProductReconciler(t, k8sClient, nn, srv).reconcile3scaleProduct()
+	if err != nil {
+		t.Fatalf("created product should still be owned after losing the status, got %v", err)
+	}
+
+	if sameEntity.ID() != entity.ID() || len(api.products) != 1 {
+		t.Fatalf("expected product %d to be reused, got %d and %d products", entity.ID(), sameEntity.ID(), len(api.products))
+	}
+}
+
+func TestReconcile3scaleProductLostCreationResponse(t *testing.T) {
+	// The product is created but the response is lost
+	api := &fakeProductsAPI{createStatus: http.StatusGatewayTimeout, createProduct: true}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+
+	k8sClient, nn := newTestProductClient(t, nil)
+
+	_, err := newTestProductReconciler(t, k8sClient, nn, srv).reconcile3scaleProduct()
+	if err == nil {
+		t.Fatal("expected creation error")
+	}
+
+	entity, err := newTestProductReconciler(t, k8sClient, nn, srv).reconcile3scaleProduct()
+	if err != nil {
+		t.Fatalf("product created without response should be owned, got %v", err)
+	}
+
+	if entity.ID() != 1 || len(api.products) != 1 {
+		t.Fatalf("expected product 1 to be reused, got %d and %d products", entity.ID(), len(api.products))
+	}
+}
+
+func TestReconcile3scaleProductRejectedCreation(t *testing.T) {
+	api := &fakeProductsAPI{createStatus: http.StatusUnprocessableEntity}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+
+	k8sClient, nn := newTestProductClient(t, nil)
+
+	_, err := newTestProductReconciler(t, k8sClient, nn, srv).reconcile3scaleProduct()
+	if err == nil {
+		t.Fatal("expected creation error")
+	}
+
+	product := &capabilitiesv1beta1.Product{}
+	if err := k8sClient.Get(context.TODO(), nn, product); err != nil {
+		t.Fatal(err)
+	}
+	if product.ClaimsSystemName() {
+		t.Fatal("claim should be released when the creation is rejected")
+	}
+}
+
+func TestReconcile3scaleProductNotOwned(t *testing.T) {
+	api := &fakeProductsAPI{createStatus: http.StatusCreated, createProduct: true}
+	api.products = []threescaleapi.Product{{Element: threescaleapi.ProductItem{ID: 1, SystemName: "product1"}}}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+
+	k8sClient, nn := newTestProductClient(t, nil)
+
+	_, err := newTestProductReconciler(t, k8sClient, nn, srv).reconcile3scaleProduct()
+	if !helper.IsInvalidSpecError(err) {
+		t.Fatalf("expected invalid spec error for a product not created by the resource, got %v", err)
+	}
+}
@@ -33,17 +33,21 @@ type applicationPlanReconciler struct {
 	productEntity       *controllerhelper.ProductEntity
 	backendRemoteIndex  *controllerhelper.BackendAPIRemoteIndex
 	planEntity          *controllerhelper.ApplicationPlanEntity
-	threescaleAPIClient *threescaleapi.ThreeScaleClient
+	threescaleAPIClient *controllerhelper.ThreescaleAPIClient
+	drift               *controllerhelper.DriftRecorder
+	plan                *controllerhelper.ChangePlanner
 	logger              logr.Logger
 }
 
 func newApplicationPlanReconciler(b *reconcilers.BaseReconciler,
 	systemName string,
 	resource capabilitiesv1beta1.ApplicationPlanSpec,
-	threescaleAPIClient *threescaleapi.ThreeScaleClient,
+	threescaleAPIClient *controllerhelper.ThreescaleAPIClient,
 	productEntity *controllerhelper.ProductEntity,
 	backendRemoteIndex *controllerhelper.BackendAPIRemoteIndex,
 	planEntity *controllerhelper.ApplicationPlanEntity,
+	drift *controllerhelper.DriftRecorder,
+	plan *controllerhelper.ChangePlanner,
 	logger logr.Logger,
 ) *applicationPlanReconciler {
 
@@ -55,16 +59,20 @@ func newApplicationPlanReconciler(b *reconcilers.BaseReconciler,
 		productEntity:       productEntity,
 		backendRemoteIndex:  backendRemoteIndex,
 		planEntity:          planEntity,
+		drift:               drift,
+		plan:                plan,
 		logger:              logger.WithValues("Plan", systemName),
 	}
 }
 
-// Reconcile ensures plan attrs, limits and pricingRules are reconciled
+// Reconcile ensures plan attrs, limits, pricingRules and features are reconciled
 func (a *applicationPlanReconciler) Reconcile() error {
-	taskRunner := helper.NewTaskRunner(nil, a.logger)
+	// Limits, pricing rules and features are independent from each other
+	taskRunner := helper.NewParallelTaskRunner(nil, syncParallelism, a.logger)
 	taskRunner.AddTask("SyncPlan", a.syncPlan)
-	taskRunner.AddTask("SyncLimits", a.syncLimits)
-	taskRunner.AddTask("SyncPricingRules", a.syncPricingRules)
+	taskRunner.AddTaskWithDependencies("SyncLimits", a.syncLimits, "SyncPlan")
+	taskRunner.AddTaskWithDependencies("SyncPricingRules", a.syncPricingRules, "SyncPlan")
+	taskRunner.AddTaskWithDependencies("SyncFeatures", a.syncFeatures, "SyncPlan")
 
 	err := taskRunner.Run()
 	if err != nil {
@@ -111,7 +119,17 @@ func (a *applicationPlanReconciler) syncPlan(_ interface{}) error {
 		}
 	}
 
+	if a.resource.Published != nil {
+		if a.planEntity.Published() != *a.resource.Published {
+			params["state_event"] = controllerhelper.PlanStateEvent(*a.resource.Published)
+		}
+	}
+
 	if len(params) > 0 {
+		if a.skipChange(capabilitiesv1beta1.PlannedChangeUpdate, a.systemName, params) {
+			return nil
+		}
+
 		err := a.planEntity.Update(params)
 		if err != nil {
 			return fmt.Errorf("Error sync plan [%s;%d]: %w", a.systemName, a.planEntity.ID(), err)
@@ -138,6 +156,12 @@ func (a *applicationPlanReconciler) syncLimits(_ interface{}) error {
 		return fmt.Errorf("Error sync plan [%s] limits: %w", a.systemName, err)
 	}
 	for idx := range undesiredLimits {
+		limit := undesiredLimits[idx].Element
+		name := fmt.Sprintf("%s:limit:%d:%s:%d", a.systemName, limit.MetricID, limit.Period, limit.Value)
+		if a.skipChange(capabilitiesv1beta1.PlannedChangeDelete, name, nil) {
+			continue
+		}
+
 		err := a.planEntity.DeleteLimit(undesiredLimits[idx].Element.MetricID, undesiredLimits[idx].Element.ID)
 		if err != nil {
 			return err
@@ -152,6 +176,12 @@ func (a *applicationPlanReconciler) syncLimits(_ interface{}) error {
 	}
 
 	for idx := range desiredLimits {
+		limit := desiredLimits[idx]
+		name := fmt.Sprintf("%s:limit:%s:%s:%d", a.systemName, limit.MetricMethodRef.SystemName, limit.Period, limit.Value)
+		if a.skipChange(capabilitiesv1beta1.PlannedChangeCreate, name, nil) {
+			continue
+		}
+
 		params := threescaleapi.Params{
 			"period": desiredLimits[idx].Period,
 			"value":  strconv.Itoa(desiredLimits[idx].Value),
@@ -188,6 +218,12 @@ func (a *applicationPlanReconciler) syncPricingRules(_ interface{}) error {
 		return fmt.Errorf("Error sync plan [%s] pricing rules: %w", a.systemName, err)
 	}
 	for idx := range undesiredRules {
+		rule := undesiredRules[idx].Element
+		name := fmt.Sprintf("%s:pricingRule:%d:%d-%d", a.systemName, rule.MetricID, rule.Min, rule.Max)
+		if a.skipChange(capabilitiesv1beta1.PlannedChangeDelete, name, nil) {
+			continue
+		}
+
 		err := a.planEntity.DeletePricingRule(undesiredRules[idx].Element.MetricID, undesiredRules[idx].Element.ID)
 		if err != nil {
 			return err
@@ -202,6 +238,12 @@ func (a *applicationPlanReconciler) syncPricingRules(_ interface{}) error {
 	}
 
 	for idx := range desiredRules {
+		rule := desiredRules[idx]
+		name := fmt.Sprintf("%s:pricingRule:%s:%d-%d", a.systemName, rule.MetricMethodRef.SystemName, rule.From, rule.To)
+		if a.skipChange(capabilitiesv1beta1.PlannedChangeCreate, name, nil) {
+			continue
+		}
+
 		params := threescaleapi.Params{
 			"min":           strconv.Itoa(desiredRules[idx].From),
 			"max":           strconv.Itoa(desiredRules[idx].To),
@@ -222,6 +264,80 @@ func (a *applicationPlanReconciler) syncPricingRules(_ interface{}) error {
 	return nil
 }
 
+func (a *applicationPlanReconciler) syncFeatures(_ interface{}) error {
+	// If plan features are not set in CR, will not be reconciled, respecting 3scale enabled features.
+	if a.resource.Features == nil {
+		return nil
+	}
+
+	// desired features
+	desiredKeys := a.resource.Features
+
+	// existing features
+	existingList, err := a.planEntity.Features()
+	if err != nil {
+		return fmt.Errorf("Error sync plan [%s] features: %w", a.systemName, err)
+	}
+
+	existingKeys := make([]string, 0, len(existingList.Features))
+	existingMap := map[string]int64{}
+	for _, existing := range existingList.Features {
+		existingKeys = append(existingKeys, existing.Element.SystemName)
+		existingMap[existing.Element.SystemName] = existing.Element.ID
+	}
+
+	//
+	// Disable existing and not desired features
+	//
+	notDesiredExistingKeys := helper.ArrayStringDifference(existingKeys, desiredKeys)
+	a.logger.V(1).Info("syncFeatures", "notDesiredExistingKeys", notDesiredExistingKeys)
+	for _, systemName := range notDesiredExistingKeys {
+		if a.skipChange(capabilitiesv1beta1.PlannedChangeDelete, fmt.Sprintf("%s:feature:%s", a.systemName, systemName), nil) {
+			continue
+		}
+
+		err := a.planEntity.DisableFeature(existingMap[systemName])
+		if err != nil {
+			return err
+		}
+	}
+
+	//
+	// Enable not existing and desired features
+	//
+	desiredNewKeys := helper.ArrayStringDifference(desiredKeys, existingKeys)
+	a.logger.V(1).Info("syncFeatures", "desiredNewKeys", desiredNewKeys)
+	for _, systemName := range desiredNewKeys {
+		if a.skipChange(capabilitiesv1beta1.PlannedChangeCreate, fmt.Sprintf("%s:feature:%s", a.systemName, systemName), nil) {
+			continue
+		}
+
+		featureID, err := a.productEntity.FindFeatureIDBySystemName(systemName)
+		if err != nil {
+			return fmt.Errorf("Error sync plan [%s] features: %w", a.systemName, err)
+		}
+
+		if featureID < 0 {
+			// Spec validation should ensure feature references exist in product features
+			return fmt.Errorf("Error sync plan [%s] features: feature [%s] not found", a.systemName, systemName)
+		}
+
+		err = a.planEntity.EnableFeature(featureID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// skipChange returns true when the plan change must not be applied to 3scale,
+// because drift is only reported or changes are only planned
+func (a *applicationPlanReconciler) skipChange(action, name string, params threescaleapi.Params) bool {
+	return a.drift.SkipCorrection(controllerhelper.DriftSectionApplicationPlans) ||
+		a.plan.Skip(controllerhelper.DriftSectionApplicationPlans, action, name, params)
+}
+
 func (a *applicationPlanReconciler) computeUnDesiredLimits(
 	existingList []threescaleapi.ApplicationPlanLimit,
 	desiredList []capabilitiesv1beta1.LimitSpec) ([]threescaleapi.ApplicationPlanLimit, error) {
@@ -2,7 +2,9 @@ package product
 
 import (
 	"fmt"
+	"sort"
 
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
 	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
 	"github.com/3scale/3scale-operator/pkg/helper"
 
@@ -20,6 +22,9 @@ func (t *ThreescaleReconciler) syncApplicationPlans(_ interface{}) error {
 		return fmt.Errorf("Error sync product [%s] plans: %w", t.resource.Spec.SystemName, err)
 	}
 
+	// interfaces to remote plan entities, used to reconcile the default plan
+	planEntities := map[string]*controllerhelper.ApplicationPlanEntity{}
+
 	existingKeys := make([]string, 0, len(existingList.Plans))
 	existingMap := map[string]threescaleapi.ApplicationPlanItem{}
 	for _, existing := range existingList.Plans {
@@ -37,6 +42,10 @@ func (t *ThreescaleReconciler) syncApplicationPlans(_ interface{}) error {
 	for _, systemName := range notDesiredExistingKeys {
 		// key is expected to exist
 		// notDesiredExistingKeys is a subset of the existingMap key set
+		if t.skipChange(controllerhelper.DriftSectionApplicationPlans, capabilitiesv1beta1.PlannedChangeDelete, systemName, nil) {
+			continue
+		}
+
 		err := t.productEntity.DeleteApplicationPlan(existingMap[systemName].ID)
 		if err != nil {
 			return fmt.Errorf("Error sync product [%s] plans: %w", t.resource.Spec.SystemName, err)
@@ -50,14 +59,7 @@ func (t *ThreescaleReconciler) syncApplicationPlans(_ interface{}) error {
 	t.logger.V(1).Info("syncApplicationPlans", "matchedKeys", matchedKeys)
 	for _, systemName := range matchedKeys {
 		// interface to remote entity
-		planEntity := controllerhelper.NewApplicationPlanEntity(t.productEntity.ID(), existingMap[systemName], t.threescaleAPIClient, t.logger)
-		// desired spec
-		planSpec := t.resource.Spec.ApplicationPlans[systemName]
-		reconciler := newApplicationPlanReconciler(t.BaseReconciler, systemName, planSpec, t.threescaleAPIClient, t.productEntity, t.backendRemoteIndex, planEntity, t.logger)
-		err := reconciler.Reconcile()
-		if err != nil {
-			return fmt.Errorf("Error sync product [%s] plan [%s]: %w", t.resource.Spec.SystemName, systemName, err)
-		}
+		planEntities[systemName] = controllerhelper.NewApplicationPlanEntity(t.productEntity.ID(), existingMap[systemName], t.threescaleAPIClient, t.logger)
 	}
 
 	//
@@ -69,7 +71,9 @@ func (t *ThreescaleReconciler) syncApplicationPlans(_ interface{}) error {
 	for _, systemName := range desiredNewKeys {
 		// key is expected to exist
 		// desiredNewKeys is a subset of the Spec.ApplicationPlans map key set
-		planSpec := t.resource.Spec.ApplicationPlans[systemName]
+		if t.skipChange(controllerhelper.DriftSectionApplicationPlans, capabilitiesv1beta1.PlannedChangeCreate, systemName, nil) {
+			continue
+		}
 
 		// Create Application Plan using system_name.
 		// it cannot be modified later
@@ -79,14 +83,119 @@ func (t *ThreescaleReconciler) syncApplicationPlans(_ interface{}) error {
 			return fmt.Errorf("Error sync product [%s] plan [%s]: %w", t.resource.Spec.SystemName, systemName, err)
 		}
 		// interface to remote entity
-		planEntity := controllerhelper.NewApplicationPlanEntity(t.productEntity.ID(), obj.Element, t.threescaleAPIClient, t.logger)
+		planEntities[systemName] = controllerhelper.NewApplicationPlanEntity(t.productEntity.ID(), obj.Element, t.threescaleAPIClient, t.logger)
+	}
 
-		reconciler := newApplicationPlanReconciler(t.BaseReconciler, systemName, planSpec, t.threescaleAPIClient, t.productEntity, t.backendRemoteIndex, planEntity, t.logger)
-		err = reconciler.Reconcile()
-		if err != nil {
-			return fmt.Errorf("Error sync product [%s] plan [%s]: %w", t.resource.Spec.SystemName, systemName, err)
+	//
+	// Reconcile existing and created plans concurrently
+	//
+
+	err = t.warmApplicationPlanReferences()
+	if err != nil {
+		return fmt.Errorf("Error sync product [%s] plans: %w", t.resource.Spec.SystemName, err)
+	}
+
+	planKeys := make([]string, 0, len(planEntities))
+	for systemName := range planEntities {
+		planKeys = append(planKeys, systemName)
+	}
+	sort.Strings(planKeys)
+
+	taskRunner := helper.NewParallelTaskRunner(nil, syncParallelism, t.logger)
+	for _, systemName := range planKeys {
+		systemName := systemName
+		// desired spec
+		planSpec := t.resource.Spec.ApplicationPlans[systemName]
+		reconciler := newApplicationPlanReconciler(t.BaseReconciler, systemName, planSpec, t.threescaleAPIClient, t.productEntity, t.backendRemoteIndex, planEntities[systemName], t.drift, t.plan, t.logger)
+		taskRunner.AddTaskWithDependencies(fmt.Sprintf("SyncApplicationPlan[%s]", systemName), func(_ interface{}) error {
+			err := reconciler.Reconcile()
+			if err != nil {
+				return fmt.Errorf("Error sync product [%s] plan [%s]: %w", t.resource.Spec.SystemName, systemName, err)
+			}
+			return nil
+		})
+	}
+
+	err = taskRunner.Run()
+	if err != nil {
+		return err
+	}
+
+	//
+	// Reconcile default plan
+	//
+	err = t.syncDefaultApplicationPlan(planEntities)
+	if err != nil {
+		return fmt.Errorf("Error sync product [%s] plans: %w", t.resource.Spec.SystemName, err)
+	}
+
+	return nil
+}
+
+// warmApplicationPlanReferences loads the product and backend data referenced by plans.
+// Plans are reconciled concurrently and read that data, which is otherwise loaded on demand.
+func (t *ThreescaleReconciler) warmApplicationPlanReferences() error {
+	_, err := t.productEntity.Features()
+	if err != nil {
+		return err
+	}
+
+	_, err = t.productEntity.MetricsAndMethods()
+	if err != nil {
+		return err
+	}
+
+	for _, planSpec := range t.resource.Spec.ApplicationPlans {
+		refs := make([]capabilitiesv1beta1.MetricMethodRefSpec, 0, len(planSpec.Limits)+len(planSpec.PricingRules))
+		for _, limitSpec := range planSpec.Limits {
+			refs = append(refs, limitSpec.MetricMethodRef)
+		}
+		for _, ruleSpec := range planSpec.PricingRules {
+			refs = append(refs, ruleSpec.MetricMethodRef)
+		}
+
+		for _, ref := range refs {
+			if ref.BackendSystemName == nil {
+				continue
+			}
+
+			// Unknown backends are reported by the plan reconciler
+			backendEntity, ok := t.backendRemoteIndex.FindBySystemName(*ref.BackendSystemName)
+			if !ok {
+				continue
+			}
+
+			_, err = backendEntity.MetricsAndMethods()
+			if err != nil {
+				return err
+			}
 		}
 	}
 
 	return nil
 }
+
+func (t *ThreescaleReconciler) syncDefaultApplicationPlan(planEntities map[string]*controllerhelper.ApplicationPlanEntity) error {
+	// If default plan is not set in CR, will not be reconciled, respecting 3scale default plan.
+	if t.resource.Spec.DefaultApplicationPlan == nil {
+		return nil
+	}
+
+	systemName := *t.resource.Spec.DefaultApplicationPlan
+	planEntity, ok := planEntities[systemName]
+	if ok && planEntity.Default() {
+		return nil
+	}
+
+	// Plans not created yet, because of dry run mode or drift report, are not found
+	if t.skipChange(controllerhelper.DriftSectionApplicationPlans, capabilitiesv1beta1.PlannedChangeUpdate, systemName, threescaleapi.Params{"default": "true"}) {
+		return nil
+	}
+
+	if !ok {
+		// Spec validation should ensure the default plan is one of the product plans
+		return fmt.Errorf("default plan [%s] not found", systemName)
+	}
+
+	return planEntity.SetDefault()
+}
@@ -0,0 +1,48 @@
+package product
+
+import (
+	"context"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// backendEventMapper maps Backend events to reconcile requests of the products
+// using the backend, from any of the watched namespaces
+type backendEventMapper struct {
+	client client.Client
+	logger logr.Logger
+}
+
+func (b *backendEventMapper) Map(obj handler.MapObject) []reconcile.Request {
+	backend, ok := obj.Object.(*capabilitiesv1beta1.Backend)
+	if !ok {
+		return nil
+	}
+
+	productList := &capabilitiesv1beta1.ProductList{}
+	err := b.client.List(context.TODO(), productList)
+	if err != nil {
+		b.logger.Error(err, "failed to list products", "backend", obj.Meta.GetName(), "namespace", obj.Meta.GetNamespace())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0)
+	for idx := range productList.Items {
+		if productList.Items[idx].UsesBackend(backend) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      productList.Items[idx].Name,
+					Namespace: productList.Items[idx].Namespace,
+				},
+			})
+		}
+	}
+
+	return requests
+}
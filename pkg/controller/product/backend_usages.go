@@ -106,6 +106,14 @@ func (t *ThreescaleReconciler) syncBackendUsage(_ interface{}) error {
 
 func (t *ThreescaleReconciler) processNotDesiredBackendUsages(notDesiredList []threescaleapi.BackendAPIUsageItem) error {
 	for _, item := range notDesiredList {
+		systemName := ""
+		if backend, ok := t.backendRemoteIndex.FindByID(item.BackendAPIID); ok {
+			systemName = backend.SystemName()
+		}
+		if t.skipChange(controllerhelper.DriftSectionBackendUsages, capabilitiesv1beta1.PlannedChangeDelete, systemName, nil) {
+			continue
+		}
+
 		err := t.productEntity.DeleteBackendUsage(item.ID)
 		if err != nil {
 			return err
@@ -115,13 +123,17 @@ func (t *ThreescaleReconciler) processNotDesiredBackendUsages(notDesiredList []t
 }
 
 func (t *ThreescaleReconciler) reconcileMatchedBackendUsages(matchedMap map[string]backendUsageData) error {
-	for _, data := range matchedMap {
+	for systemName, data := range matchedMap {
 		params := threescaleapi.Params{}
 		if data.spec.Path != data.item.Path {
 			params["path"] = data.spec.Path
 		}
 
 		if len(params) > 0 {
+			if t.skipChange(controllerhelper.DriftSectionBackendUsages, capabilitiesv1beta1.PlannedChangeUpdate, systemName, params) {
+				continue
+			}
+
 			err := t.productEntity.UpdateBackendUsage(data.item.ID, params)
 			if err != nil {
 				return fmt.Errorf("Error updating product backendusage: %w", err)
@@ -134,6 +146,10 @@ func (t *ThreescaleReconciler) reconcileMatchedBackendUsages(matchedMap map[stri
 
 func (t *ThreescaleReconciler) createNewBackendUsage(matchedList []newBackendUsageData) error {
 	for _, data := range matchedList {
+		if t.skipChange(controllerhelper.DriftSectionBackendUsages, capabilitiesv1beta1.PlannedChangeCreate, data.item.SystemName(), nil) {
+			continue
+		}
+
 		params := threescaleapi.Params{
 			"path":           data.spec.Path,
 			"backend_api_id": strconv.FormatInt(data.item.ID(), 10),
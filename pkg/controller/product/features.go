@@ -0,0 +1,140 @@
+package product
+
+import (
+	"fmt"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+)
+
+type featureData struct {
+	item controllerhelper.FeatureItem
+	spec capabilitiesv1beta1.FeatureSpec
+}
+
+func (t *ThreescaleReconciler) syncFeatures(_ interface{}) error {
+	// If features are not set in CR, will not be reconciled, respecting 3scale features.
+	if t.resource.Spec.Features == nil {
+		return nil
+	}
+
+	desiredKeys := make([]string, 0, len(t.resource.Spec.Features))
+	for systemName := range t.resource.Spec.Features {
+		desiredKeys = append(desiredKeys, systemName)
+	}
+
+	existingList, err := t.productEntity.Features()
+	if err != nil {
+		return fmt.Errorf("Error sync product features [%s]: %w", t.resource.Spec.SystemName, err)
+	}
+
+	// Only application plan features are reconciled.
+	// Account and service plan scoped features are left untouched.
+	existingMap := map[string]controllerhelper.FeatureItem{}
+	existingKeys := make([]string, 0, len(existingList.Features))
+	for _, existing := range existingList.Features {
+		if existing.Element.Scope != controllerhelper.ApplicationPlanFeatureScope {
+			continue
+		}
+		systemName := existing.Element.SystemName
+		existingKeys = append(existingKeys, systemName)
+		existingMap[systemName] = existing.Element
+	}
+
+	//
+	// Deleted existing and not desired features
+	//
+
+	notDesiredExistingKeys := helper.ArrayStringDifference(existingKeys, desiredKeys)
+	t.logger.V(1).Info("syncFeatures", "notDesiredExistingKeys", notDesiredExistingKeys)
+	for _, systemName := range notDesiredExistingKeys {
+		// key is expected to exist
+		// notDesiredExistingKeys is a subset of the existingMap key set
+		if t.skipChange(controllerhelper.DriftSectionFeatures, capabilitiesv1beta1.PlannedChangeDelete, systemName, nil) {
+			continue
+		}
+
+		err := t.productEntity.DeleteFeature(existingMap[systemName].ID)
+		if err != nil {
+			return fmt.Errorf("Error sync product features [%s]: %w", t.resource.Spec.SystemName, err)
+		}
+	}
+
+	//
+	// Reconcile existing and changed features
+	//
+
+	matchedKeys := helper.ArrayStringIntersection(existingKeys, desiredKeys)
+	t.logger.V(1).Info("syncFeatures", "matchedKeys", matchedKeys)
+	matchedMap := map[string]featureData{}
+	for _, systemName := range matchedKeys {
+		matchedMap[systemName] = featureData{
+			item: existingMap[systemName],
+			spec: t.resource.Spec.Features[systemName],
+		}
+	}
+
+	err = t.reconcileMatchedFeatures(matchedMap)
+	if err != nil {
+		return fmt.Errorf("Error sync product features [%s]: %w", t.resource.Spec.SystemName, err)
+	}
+
+	//
+	// Create not existing and desired features
+	//
+
+	desiredNewKeys := helper.ArrayStringDifference(desiredKeys, existingKeys)
+	t.logger.V(1).Info("syncFeatures", "desiredNewKeys", desiredNewKeys)
+	for _, systemName := range desiredNewKeys {
+		// key is expected to exist
+		// desiredNewKeys is a subset of the Spec.Features map key set
+		if t.skipChange(controllerhelper.DriftSectionFeatures, capabilitiesv1beta1.PlannedChangeCreate, systemName, nil) {
+			continue
+		}
+
+		feature := t.resource.Spec.Features[systemName]
+		params := threescaleapi.Params{
+			"name":        feature.Name,
+			"system_name": systemName,
+			"scope":       controllerhelper.ApplicationPlanFeatureScope,
+		}
+		if len(feature.Description) > 0 {
+			params["description"] = feature.Description
+		}
+		err := t.productEntity.CreateFeature(params)
+		if err != nil {
+			return fmt.Errorf("Error sync product features [%s]: %w", t.resource.Spec.SystemName, err)
+		}
+	}
+
+	return nil
+}
+
+func (t *ThreescaleReconciler) reconcileMatchedFeatures(matchedMap map[string]featureData) error {
+	for systemName, data := range matchedMap {
+		params := threescaleapi.Params{}
+		if data.spec.Name != data.item.Name {
+			params["name"] = data.spec.Name
+		}
+
+		if data.spec.Description != data.item.Description {
+			params["description"] = data.spec.Description
+		}
+
+		if len(params) > 0 {
+			if t.skipChange(controllerhelper.DriftSectionFeatures, capabilitiesv1beta1.PlannedChangeUpdate, systemName, params) {
+				continue
+			}
+
+			err := t.productEntity.UpdateFeature(data.item.ID, params)
+			if err != nil {
+				return fmt.Errorf("Error updating product feature: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
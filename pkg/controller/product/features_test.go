@@ -0,0 +1,68 @@
+package product
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+	"k8s.io/client-go/tools/record"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// newUnexpectedRequestServer fails the test on any 3scale API request
+func newUnexpectedRequestServer(t *testing.T) (*httptest.Server, *controllerhelper.ThreescaleAPIClient) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+
+	threescaleAPIClient, err := controllerhelper.PortaClientFromURLString(srv.URL, "token")
+	if err != nil {
+		srv.Close()
+		t.Fatal(err)
+	}
+
+	return srv, threescaleAPIClient
+}
+
+func TestSyncFeaturesNotSet(t *testing.T) {
+	srv, threescaleAPIClient := newUnexpectedRequestServer(t)
+	defer srv.Close()
+
+	product := &capabilitiesv1beta1.Product{
+		Spec: capabilitiesv1beta1.ProductSpec{Name: "Product 1", SystemName: "product1"},
+	}
+	productEntity := controllerhelper.NewProductEntity(&threescaleapi.Product{Element: threescaleapi.ProductItem{ID: 1}}, threescaleAPIClient, logf.Log.WithName("test"))
+
+	baseReconciler := reconcilers.NewBaseReconciler(nil, nil, nil, context.TODO(), logf.Log.WithName("test"), nil, record.NewFakeRecorder(10))
+	reconciler := NewThreescaleReconciler(baseReconciler, product, threescaleAPIClient, nil)
+	reconciler.productEntity = productEntity
+
+	if err := reconciler.syncFeatures(nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSyncApplicationPlanFeaturesNotSet(t *testing.T) {
+	srv, threescaleAPIClient := newUnexpectedRequestServer(t)
+	defer srv.Close()
+
+	logger := logf.Log.WithName("test")
+	productEntity := controllerhelper.NewProductEntity(&threescaleapi.Product{Element: threescaleapi.ProductItem{ID: 1}}, threescaleAPIClient, logger)
+	planEntity := controllerhelper.NewApplicationPlanEntity(1, threescaleapi.ApplicationPlanItem{ID: 2}, threescaleAPIClient, logger)
+
+	baseReconciler := reconcilers.NewBaseReconciler(nil, nil, nil, context.TODO(), logger, nil, record.NewFakeRecorder(10))
+	reconciler := newApplicationPlanReconciler(baseReconciler, "plan1", capabilitiesv1beta1.ApplicationPlanSpec{},
+		threescaleAPIClient, productEntity, nil, planEntity,
+		controllerhelper.NewDriftRecorder(false, false), controllerhelper.NewChangePlanner(false), logger)
+
+	if err := reconciler.syncFeatures(nil); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
 	"github.com/3scale/3scale-operator/pkg/helper"
 
 	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
@@ -105,6 +106,11 @@ func (t *ThreescaleReconciler) syncMappingRules(_ interface{}) error {
 
 func (t *ThreescaleReconciler) processNotDesiredMappingRules(notDesiredList []threescaleapi.MappingRuleItem) error {
 	for _, mappingRule := range notDesiredList {
+		key := fmt.Sprintf("%s:%s", mappingRule.HTTPMethod, mappingRule.Pattern)
+		if t.skipChange(controllerhelper.DriftSectionMappingRules, capabilitiesv1beta1.PlannedChangeDelete, key, nil) {
+			continue
+		}
+
 		err := t.productEntity.DeleteMappingRule(mappingRule.ID)
 		if err != nil {
 			return err
@@ -138,7 +144,8 @@ func (t *ThreescaleReconciler) reconcileMappingRuleWithPosition(desired capabili
 		return fmt.Errorf("Error reconcile product mapping rule: %w", err)
 	}
 
-	if metricID < 0 {
+	// In dry run mode, the referenced metric or method may not have been created yet
+	if metricID < 0 && !t.plan.Enabled() {
 		// Should not happen as metric and method references have been validated and should exists
 		return errors.New("product metric method ref for mapping rule not found")
 	}
@@ -173,6 +180,11 @@ func (t *ThreescaleReconciler) reconcileMappingRuleWithPosition(desired capabili
 	}
 
 	if len(params) > 0 {
+		key := fmt.Sprintf("%s:%s", desired.HTTPMethod, desired.Pattern)
+		if t.skipChange(controllerhelper.DriftSectionMappingRules, capabilitiesv1beta1.PlannedChangeUpdate, key, params) {
+			return nil
+		}
+
 		err := t.productEntity.UpdateMappingRule(existing.ID, params)
 		if err != nil {
 			return fmt.Errorf("Error reconcile product mapping rule: %w", err)
@@ -183,6 +195,11 @@ func (t *ThreescaleReconciler) reconcileMappingRuleWithPosition(desired capabili
 }
 
 func (t *ThreescaleReconciler) createNewMappingRuleWithPosition(desired capabilitiesv1beta1.MappingRuleSpec, desiredPosition int) error {
+	key := fmt.Sprintf("%s:%s", desired.HTTPMethod, desired.Pattern)
+	if t.skipChange(controllerhelper.DriftSectionMappingRules, capabilitiesv1beta1.PlannedChangeCreate, key, nil) {
+		return nil
+	}
+
 	metricID, err := t.productEntity.FindMethodMetricIDBySystemName(desired.MetricMethodRef)
 	if err != nil {
 		return fmt.Errorf("Error creating product [%s] mappingrule: %w", t.resource.Spec.SystemName, err)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
 	"github.com/3scale/3scale-operator/pkg/helper"
 
 	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
@@ -88,6 +89,10 @@ func (t *ThreescaleReconciler) syncMethods(_ interface{}) error {
 
 func (t *ThreescaleReconciler) createNewMethods(desiredNewMap map[string]capabilitiesv1beta1.MethodSpec) error {
 	for systemName, method := range desiredNewMap {
+		if t.skipChange(controllerhelper.DriftSectionMethods, capabilitiesv1beta1.PlannedChangeCreate, systemName, nil) {
+			continue
+		}
+
 		params := threescaleapi.Params{
 			"friendly_name": method.Name,
 			"system_name":   systemName,
@@ -104,7 +109,11 @@ func (t *ThreescaleReconciler) createNewMethods(desiredNewMap map[string]capabil
 }
 
 func (t *ThreescaleReconciler) processNotDesiredMethods(notDesiredMap map[string]threescaleapi.MethodItem) error {
-	for _, notDesiredMethod := range notDesiredMap {
+	for systemName, notDesiredMethod := range notDesiredMap {
+		if t.skipChange(controllerhelper.DriftSectionMethods, capabilitiesv1beta1.PlannedChangeDelete, systemName, nil) {
+			continue
+		}
+
 		err := t.productEntity.DeleteMethod(notDesiredMethod.ID)
 		if err != nil {
 			return err
@@ -114,7 +123,7 @@ func (t *ThreescaleReconciler) processNotDesiredMethods(notDesiredMap map[string
 }
 
 func (t *ThreescaleReconciler) reconcileMatchedMethods(matchedMap map[string]methodData) error {
-	for _, data := range matchedMap {
+	for systemName, data := range matchedMap {
 		params := threescaleapi.Params{}
 		if data.spec.Name != data.item.Name {
 			params["friendly_name"] = data.spec.Name
@@ -125,6 +134,10 @@ func (t *ThreescaleReconciler) reconcileMatchedMethods(matchedMap map[string]met
 		}
 
 		if len(params) > 0 {
+			if t.skipChange(controllerhelper.DriftSectionMethods, capabilitiesv1beta1.PlannedChangeUpdate, systemName, params) {
+				continue
+			}
+
 			err := t.productEntity.UpdateMethod(data.item.ID, params)
 			if err != nil {
 				return fmt.Errorf("Error reconcile product methods: %w", err)
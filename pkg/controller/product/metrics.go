@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
 	"github.com/3scale/3scale-operator/pkg/helper"
 
 	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
@@ -90,7 +91,11 @@ func (t *ThreescaleReconciler) syncMetrics(_ interface{}) error {
 }
 
 func (t *ThreescaleReconciler) processNotDesiredMetrics(notDesiredMap map[string]threescaleapi.MetricItem) error {
-	for _, metric := range notDesiredMap {
+	for systemName, metric := range notDesiredMap {
+		if t.skipChange(controllerhelper.DriftSectionMetrics, capabilitiesv1beta1.PlannedChangeDelete, systemName, nil) {
+			continue
+		}
+
 		err := t.productEntity.DeleteMetric(metric.ID)
 		if err != nil {
 			return err
@@ -100,7 +105,7 @@ func (t *ThreescaleReconciler) processNotDesiredMetrics(notDesiredMap map[string
 }
 
 func (t *ThreescaleReconciler) reconcileMatchedMetrics(matchedMap map[string]metricData) error {
-	for _, data := range matchedMap {
+	for systemName, data := range matchedMap {
 		params := threescaleapi.Params{}
 		if data.spec.Name != data.item.Name {
 			params["friendly_name"] = data.spec.Name
@@ -115,6 +120,10 @@ func (t *ThreescaleReconciler) reconcileMatchedMetrics(matchedMap map[string]met
 		}
 
 		if len(params) > 0 {
+			if t.skipChange(controllerhelper.DriftSectionMetrics, capabilitiesv1beta1.PlannedChangeUpdate, systemName, params) {
+				continue
+			}
+
 			err := t.productEntity.UpdateMetric(data.item.ID, params)
 			if err != nil {
 				return fmt.Errorf("Error updating product metric: %w", err)
@@ -127,6 +136,10 @@ func (t *ThreescaleReconciler) reconcileMatchedMetrics(matchedMap map[string]met
 
 func (t *ThreescaleReconciler) createNewMetrics(desiredNewMap map[string]capabilitiesv1beta1.MetricSpec) error {
 	for systemName, metric := range desiredNewMap {
+		if t.skipChange(controllerhelper.DriftSectionMetrics, capabilitiesv1beta1.PlannedChangeCreate, systemName, nil) {
+			continue
+		}
+
 		params := threescaleapi.Params{
 			"friendly_name": metric.Name,
 			"unit":          metric.Unit,
@@ -0,0 +1,100 @@
+package product
+
+import (
+	"fmt"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	"github.com/3scale/3scale-operator/pkg/common"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+
+	"github.com/ghodss/yaml"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// maxStatusPlannedChanges is the maximum number of planned changes published in the status.
+	// Larger plans are published in a ConfigMap.
+	maxStatusPlannedChanges = 50
+
+	// planConfigMapKey is the ConfigMap key holding the planned changes
+	planConfigMapKey = "plan.yaml"
+)
+
+func planConfigMapName(product *capabilitiesv1beta1.Product) string {
+	return fmt.Sprintf("%s-plan", product.Name)
+}
+
+// reconcilePlan publishes the planned changes in a ConfigMap owned by the product when they do not fit in the status.
+// The ConfigMap is deleted when it is no longer needed.
+func (r *ReconcileProduct) reconcilePlan(product *capabilitiesv1beta1.Product, changes []capabilitiesv1beta1.PlannedChange) (*capabilitiesv1beta1.PlanStatus, error) {
+	plan := &capabilitiesv1beta1.PlanStatus{Total: len(changes)}
+
+	if len(changes) <= maxStatusPlannedChanges {
+		plan.Changes = changes
+		return plan, r.deletePlan(product)
+	}
+
+	data, err := yaml.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	desired := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      planConfigMapName(product),
+			Namespace: product.Namespace,
+		},
+		Data: map[string]string{planConfigMapKey: string(data)},
+	}
+
+	err = r.SetOwnerReference(product, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.ReconcileResource(&corev1.ConfigMap{}, desired, planConfigMapMutator)
+	if err != nil {
+		return nil, err
+	}
+
+	plan.ConfigMapRef = &corev1.LocalObjectReference{Name: desired.Name}
+	return plan, nil
+}
+
+// deletePlan deletes the ConfigMap published in dry run mode, if any
+func (r *ReconcileProduct) deletePlan(product *capabilitiesv1beta1.Product) error {
+	if product.Status.Plan == nil || product.Status.Plan.ConfigMapRef == nil {
+		return nil
+	}
+
+	desired := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      product.Status.Plan.ConfigMapRef.Name,
+			Namespace: product.Namespace,
+		},
+	}
+	common.TagObjectToDelete(desired)
+	return r.ReconcileResource(&corev1.ConfigMap{}, desired, planConfigMapMutator)
+}
+
+func planConfigMapMutator(existingObj, desiredObj common.KubernetesObject) (bool, error) {
+	existing, ok := existingObj.(*corev1.ConfigMap)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *corev1.ConfigMap", existingObj)
+	}
+	desired, ok := desiredObj.(*corev1.ConfigMap)
+	if !ok {
+		return false, fmt.Errorf("%T is not a *corev1.ConfigMap", desiredObj)
+	}
+
+	if existing.Data == nil {
+		existing.Data = map[string]string{}
+	}
+
+	return reconcilers.ConfigMapReconcileField(desired, existing, planConfigMapKey), nil
+}
@@ -0,0 +1,119 @@
+package product
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	// apicastPolicyName is the name of the builtin APIcast policy
+	// performing the 3scale authorization and reporting.
+	apicastPolicyName = "apicast"
+)
+
+func (t *ThreescaleReconciler) syncPolicies(_ interface{}) error {
+	// If policies are not set in CR, will not be reconciled, respecting 3scale policy chain.
+	if t.resource.Spec.Policies == nil {
+		return nil
+	}
+
+	desired, err := desiredPolicyChain(t.resource.Spec.Policies)
+	if err != nil {
+		return fmt.Errorf("Error sync product [%s] policies: %w", t.resource.Spec.SystemName, err)
+	}
+
+	existing, err := t.productEntity.Policies()
+	if err != nil {
+		return fmt.Errorf("Error sync product [%s] policies: %w", t.resource.Spec.SystemName, err)
+	}
+
+	existingChain := normalizePolicyChain(existing.Policies)
+	if reflect.DeepEqual(existingChain, desired) {
+		return nil
+	}
+
+	diff := cmp.Diff(existingChain, desired)
+	t.logger.V(1).Info("syncPolicies", "policy chain difference", diff)
+	serializedChain, err := json.Marshal(desired)
+	if err != nil {
+		return fmt.Errorf("Error sync product [%s] policies: %w", t.resource.Spec.SystemName, err)
+	}
+
+	params := threescaleapi.Params{
+		"policies_config": string(serializedChain),
+	}
+
+	if t.skipChange(controllerhelper.DriftSectionPolicies, capabilitiesv1beta1.PlannedChangeUpdate, t.resource.Spec.SystemName, params) {
+		return nil
+	}
+
+	// First synchronizations and spec changes are not drift
+	if t.resource.IsGenerationSynced() {
+		t.policyChainDrift = true
+	}
+
+	err = t.productEntity.UpdatePolicies(params)
+	if err != nil {
+		return fmt.Errorf("Error sync product [%s] policies: %w", t.resource.Spec.SystemName, err)
+	}
+
+	return nil
+}
+
+// desiredPolicyChain converts the CR policy chain to the 3scale policy chain.
+// When not declared, the builtin apicast policy is appended at the end of the chain,
+// otherwise the gateway would not authorize nor report traffic.
+func desiredPolicyChain(policies []capabilitiesv1beta1.PolicyConfig) ([]controllerhelper.PolicyConfigItem, error) {
+	chain := make([]controllerhelper.PolicyConfigItem, 0, len(policies)+1)
+	apicastFound := false
+	for _, policy := range policies {
+		configuration := map[string]interface{}{}
+		if len(policy.Configuration.Raw) > 0 {
+			err := json.Unmarshal(policy.Configuration.Raw, &configuration)
+			if err != nil {
+				return nil, fmt.Errorf("policy [%s] configuration: %w", policy.Name, err)
+			}
+		}
+
+		if policy.Name == apicastPolicyName {
+			apicastFound = true
+		}
+
+		chain = append(chain, controllerhelper.PolicyConfigItem{
+			Name:          policy.Name,
+			Version:       policy.Version,
+			Configuration: configuration,
+			Enabled:       policy.Enabled,
+		})
+	}
+
+	if !apicastFound {
+		chain = append(chain, controllerhelper.PolicyConfigItem{
+			Name:          apicastPolicyName,
+			Version:       "builtin",
+			Configuration: map[string]interface{}{},
+			Enabled:       true,
+		})
+	}
+
+	return chain, nil
+}
+
+// normalizePolicyChain replaces nil configurations with empty ones
+func normalizePolicyChain(policies []controllerhelper.PolicyConfigItem) []controllerhelper.PolicyConfigItem {
+	chain := make([]controllerhelper.PolicyConfigItem, 0, len(policies))
+	for _, policy := range policies {
+		if policy.Configuration == nil {
+			policy.Configuration = map[string]interface{}{}
+		}
+		chain = append(chain, policy)
+	}
+	return chain
+}
@@ -3,6 +3,9 @@ package product
 import (
 	"fmt"
 
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+
 	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
 )
 
@@ -32,6 +35,10 @@ func (t *ThreescaleReconciler) syncProduct(_ interface{}) error {
 	} // only update backend_version when set in the CR
 
 	if len(params) > 0 {
+		if t.skipChange(controllerhelper.DriftSectionProduct, capabilitiesv1beta1.PlannedChangeUpdate, t.resource.Spec.SystemName, params) {
+			return nil
+		}
+
 		err := t.productEntity.Update(params)
 		if err != nil {
 			return fmt.Errorf("Error sync product [%s;%d]: %w", t.resource.Spec.SystemName, t.productEntity.ID(), err)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
 	"github.com/3scale/3scale-operator/pkg/common"
@@ -12,11 +13,14 @@ import (
 	"github.com/3scale/3scale-operator/pkg/reconcilers"
 	"github.com/3scale/3scale-operator/version"
 
+	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -66,6 +70,7 @@ func newReconciler(mgr manager.Manager) (reconcile.Reconciler, error) {
 	recorder := mgr.GetEventRecorderFor(controllerName)
 	return &ReconcileProduct{
 		BaseReconciler: reconcilers.NewBaseReconciler(client, scheme, apiClientReader, ctx, log, discoveryClient, recorder),
+		resyncPeriod:   controllerhelper.ResyncPeriod(controllerhelper.PRODUCT_RESYNC_PERIOD_ENVVAR, log),
 	}, nil
 }
 
@@ -83,6 +88,15 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 		return err
 	}
 
+	// Watch for changes to Backend resources used by products, including backends from other namespaces
+	backendHandler := &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: &backendEventMapper{client: mgr.GetClient(), logger: log},
+	}
+	err = c.Watch(&source.Kind{Type: &capabilitiesv1beta1.Backend{}}, backendHandler)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -92,6 +106,9 @@ var _ reconcile.Reconciler = &ReconcileProduct{}
 // ReconcileProduct reconciles a Product object
 type ReconcileProduct struct {
 	*reconcilers.BaseReconciler
+	// resyncPeriod is the period synchronized products are reconciled again to detect 3scale drift.
+	// Zero disables periodic resync
+	resyncPeriod time.Duration
 }
 
 // Reconcile reads that state of the cluster for a Product object and makes changes based on the state read
@@ -108,6 +125,7 @@ func (r *ReconcileProduct) Reconcile(request reconcile.Request) (reconcile.Resul
 			// Request object not found, could have been deleted after reconcile request.
 			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
 			// Return and don't requeue
+			controllerhelper.DeleteResourceSynced(capabilitiesv1beta1.ProductKind, request.NamespacedName)
 			reqLogger.Info("resource not found. Ignoring since object must have been deleted")
 			return reconcile.Result{}, nil
 		}
@@ -123,12 +141,37 @@ func (r *ReconcileProduct) Reconcile(request reconcile.Request) (reconcile.Resul
 		reqLogger.V(1).Info(string(jsonData))
 	}
 
+	if product.GetDeletionTimestamp() != nil && helper.ArrayContains(product.GetFinalizers(), capabilitiesv1beta1.ProductFinalizer) {
+		return r.reconcileDeletion(product, reqLogger)
+	}
+
 	// Ignore deleted Products, this can happen when foregroundDeletion is enabled
 	// https://kubernetes.io/docs/concepts/workloads/controllers/garbage-collection/#foreground-cascading-deletion
-	if product.DeletionTimestamp != nil {
+	if product.GetDeletionTimestamp() != nil {
 		return reconcile.Result{}, nil
 	}
 
+	if !helper.ArrayContains(product.GetFinalizers(), capabilitiesv1beta1.ProductFinalizer) {
+		controllerutil.AddFinalizer(product, capabilitiesv1beta1.ProductFinalizer)
+		// Resources reconciled before the finalizer was introduced, whose ID was never recorded,
+		// keep managing the 3scale product with their system name, as those operator versions did
+		if product.Status.ID == nil && len(product.Status.Conditions) > 0 {
+			annotations := product.GetAnnotations()
+			if annotations == nil {
+				annotations = map[string]string{}
+			}
+			annotations[capabilitiesv1beta1.ClaimedSystemNameAnnotation] = product.Spec.SystemName
+			product.SetAnnotations(annotations)
+		}
+		err := r.Client().Update(r.Context(), product)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("Failed adding product finalizer: %w", err)
+		}
+
+		reqLogger.Info("resource finalizer added. Requeueing.")
+		return reconcile.Result{Requeue: true}, nil
+	}
+
 	if product.SetDefaults(reqLogger) {
 		err := r.Client().Update(r.Context(), product)
 		if err != nil {
@@ -161,6 +204,13 @@ func (r *ReconcileProduct) Reconcile(request reconcile.Request) (reconcile.Resul
 			return reconcile.Result{}, nil
 		}
 
+		if controllerhelper.IsThreescaleUnavailable(reconcileErr) {
+			// 3scale is expected to recover, retry once the circuit breaker lets requests through again
+			reqLogger.Info("ERROR", "3scale unavailable", reconcileErr)
+			r.EventRecorder().Eventf(product, corev1.EventTypeWarning, "Unavailable", "%v", reconcileErr)
+			return reconcile.Result{RequeueAfter: controllerhelper.ThreescaleUnavailableRequeueDelay}, nil
+		}
+
 		if helper.IsOrphanSpecError(reconcileErr) {
 			// On Orphan spec error, retry
 			reqLogger.Info("ERROR", "spec orphan error", reconcileErr)
@@ -172,9 +222,84 @@ func (r *ReconcileProduct) Reconcile(request reconcile.Request) (reconcile.Resul
 	}
 
 	reqLogger.Info("END", "error", reconcileErr)
+	if reconcileErr == nil && r.resyncPeriod > 0 {
+		return reconcile.Result{RequeueAfter: r.resyncPeriod}, nil
+	}
 	return reconcile.Result{}, reconcileErr
 }
 
+// reconcileDeletion removes the 3scale product, unless orphan on delete is requested,
+// and then removes the finalizer to let the resource be deleted
+func (r *ReconcileProduct) reconcileDeletion(product *capabilitiesv1beta1.Product, reqLogger logr.Logger) (reconcile.Result, error) {
+	if product.OrphanOnDelete() {
+		reqLogger.Info("orphan on delete annotation found. 3scale product will not be deleted")
+	} else {
+		err := r.delete3scaleProduct(product)
+		if err != nil {
+			reqLogger.Error(err, "Failed to delete 3scale product")
+			r.EventRecorder().Eventf(product, corev1.EventTypeWarning, "DeleteError", "%v", err)
+			statusReconciler := NewStatusReconciler(r.BaseReconciler, product, nil, product.Status.ProviderAccountHost, err)
+			_, statusUpdateErr := statusReconciler.Reconcile()
+			if statusUpdateErr != nil {
+				return reconcile.Result{}, fmt.Errorf("Failed to delete 3scale product: %v. Failed to update product status: %w", err, statusUpdateErr)
+			}
+			return reconcile.Result{}, err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(product, capabilitiesv1beta1.ProductFinalizer)
+	err := r.Client().Update(r.Context(), product)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("Failed removing product finalizer: %w", err)
+	}
+
+	reqLogger.Info("resource finalizer removed")
+	return reconcile.Result{}, nil
+}
+
+func (r *ReconcileProduct) delete3scaleProduct(product *capabilitiesv1beta1.Product) error {
+	logger := r.Logger().WithValues("product", product.Name)
+
+	providerAccount, err := controllerhelper.LookupProviderAccount(r.Client(), product.Namespace, product.Spec.ProviderAccountRef, logger)
+	if controllerhelper.IsProviderAccountNotFound(err) {
+		// The credentials are gone, i.e. the namespace is being deleted.
+		// The 3scale product is orphaned instead of blocking the resource deletion forever
+		logger.Info("provider account not found. 3scale product will not be deleted", "error", err.Error())
+		r.EventRecorder().Eventf(product, corev1.EventTypeWarning, "Orphaned", "3scale product [%s] not deleted: %v", product.Spec.SystemName, err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	threescaleAPIClient, err := controllerhelper.PortaClient(providerAccount)
+	if err != nil {
+		return err
+	}
+
+	productList, err := threescaleAPIClient.ListProducts()
+	if err != nil {
+		return fmt.Errorf("delete3scaleProduct product [%s]: %w", product.Spec.SystemName, err)
+	}
+
+	for idx := range productList.Products {
+		if productList.Products[idx].Element.SystemName == product.Spec.SystemName {
+			// 3scale products not created nor adopted by the resource are never deleted
+			if !product.Owns(productList.Products[idx].Element.ID) {
+				logger.Info("3scale product not managed by the resource. Nothing to delete")
+				return nil
+			}
+
+			productEntity := controllerhelper.NewProductEntity(&productList.Products[idx], threescaleAPIClient, logger)
+			return productEntity.Delete()
+		}
+	}
+
+	// product not found in 3scale, nothing to delete
+	logger.Info("3scale product not found. Nothing to delete")
+	return nil
+}
+
 func (r *ReconcileProduct) reconcile(productResource *capabilitiesv1beta1.Product) (*StatusReconciler, error) {
 	logger := r.Logger().WithValues("product", productResource.Name)
 
@@ -211,7 +336,25 @@ func (r *ReconcileProduct) reconcile(productResource *capabilitiesv1beta1.Produc
 
 	reconciler := NewThreescaleReconciler(r.BaseReconciler, productResource, threescaleAPIClient, backendRemoteIndex)
 	productEntity, err := reconciler.Reconcile()
+	if productResource.Spec.DryRun {
+		// The 3scale product is not taken over in dry run mode
+		productEntity = nil
+	}
+
+	var plan *capabilitiesv1beta1.PlanStatus
+	if err == nil {
+		if productResource.Spec.DryRun {
+			plan, err = r.reconcilePlan(productResource, reconciler.PlannedChanges())
+		} else {
+			err = r.deletePlan(productResource)
+		}
+	}
+
 	statusReconciler := NewStatusReconciler(r.BaseReconciler, productResource, productEntity, providerAccount.AdminURLStr, err)
+	statusReconciler.policyChainDrift = reconciler.PolicyChainDrift()
+	statusReconciler.driftedSections = reconciler.DriftedSections()
+	statusReconciler.stagingConfigVersion, statusReconciler.productionConfigVersion = reconciler.ProxyConfigVersions()
+	statusReconciler.plan = plan
 	return statusReconciler, err
 }
 
@@ -233,15 +376,15 @@ func (r *ReconcileProduct) checkExternalRefs(resource *capabilitiesv1beta1.Produ
 	logger := r.Logger().WithValues("product", resource.Name)
 	errors := field.ErrorList{}
 
-	backendList, err := controllerhelper.BackendList(resource.Namespace, r.Client(), providerAccount, logger)
+	backendList, err := backendUsageNamespacesBackendList(resource, r.Client(), providerAccount, logger)
 	if err != nil {
 		return fmt.Errorf("checking backend usage references: %w", err)
 	}
 
-	backendUsageErrors := r.checkBackendUsages(resource, backendList)
-	errors = append(errors, backendUsageErrors...)
+	backendUsageList := computeBackendUsageList(resource, backendList)
 
-	backendUsageList := computeBackendUsageList(backendList, resource.Spec.BackendUsages)
+	backendUsageErrors := r.checkBackendUsages(resource, backendUsageList)
+	errors = append(errors, backendUsageErrors...)
 
 	limitBackendMetricRefErrors := checkAppLimitsExternalRefs(resource, backendUsageList)
 	errors = append(errors, limitBackendMetricRefErrors...)
@@ -249,6 +392,14 @@ func (r *ReconcileProduct) checkExternalRefs(resource *capabilitiesv1beta1.Produ
 	pricingRulesBackendMetricRefErrors := checkAppPricingRulesExternalRefs(resource, backendUsageList)
 	errors = append(errors, pricingRulesBackendMetricRefErrors...)
 
+	customPolicyList, err := controllerhelper.CustomPolicyDefinitionList(resource.Namespace, r.Client(), providerAccount, logger)
+	if err != nil {
+		return fmt.Errorf("checking policy chain references: %w", err)
+	}
+
+	policyChainErrors := checkPolicyChainCustomPolicyRefs(resource, customPolicyList)
+	errors = append(errors, policyChainErrors...)
+
 	if len(errors) == 0 {
 		return nil
 	}
@@ -260,6 +411,7 @@ func (r *ReconcileProduct) checkExternalRefs(resource *capabilitiesv1beta1.Produ
 }
 
 func (r *ReconcileProduct) checkBackendUsages(resource *capabilitiesv1beta1.Product, backendList []capabilitiesv1beta1.Backend) field.ErrorList {
+	// backendList param is expected to be valid product's backendUsageList
 	errors := field.ErrorList{}
 
 	specFldPath := field.NewPath("spec")
@@ -275,6 +427,32 @@ func (r *ReconcileProduct) checkBackendUsages(resource *capabilitiesv1beta1.Prod
 	return errors
 }
 
+// checkPolicyChainCustomPolicyRefs checks custom policies of the policy chain
+// defined by CustomPolicyDefinition resources are registered in the 3scale policy registry.
+// Policies not defined by CustomPolicyDefinition resources are not checked.
+func checkPolicyChainCustomPolicyRefs(resource *capabilitiesv1beta1.Product, customPolicyList []capabilitiesv1beta1.CustomPolicyDefinition) field.ErrorList {
+	errors := field.ErrorList{}
+
+	policiesFldPath := field.NewPath("spec").Child("policies")
+	for idx, policy := range resource.Spec.Policies {
+		defined := false
+		synced := false
+		for policyIdx := range customPolicyList {
+			customPolicy := &customPolicyList[policyIdx]
+			if customPolicy.Spec.Name == policy.Name && customPolicy.Spec.Version == policy.Version {
+				defined = true
+				synced = synced || customPolicy.IsSynced()
+			}
+		}
+
+		if defined && !synced {
+			errors = append(errors, field.Invalid(policiesFldPath.Index(idx), policy.Name, "custom policy definition not synchronized."))
+		}
+	}
+
+	return errors
+}
+
 func checkAppLimitsExternalRefs(resource *capabilitiesv1beta1.Product, backendList []capabilitiesv1beta1.Backend) field.ErrorList {
 	// backendList param is expected to be valid product's backendUsageList
 	errors := field.ErrorList{}
@@ -356,16 +534,36 @@ func findBackendBySystemName(list []capabilitiesv1beta1.Backend, systemName stri
 	return -1
 }
 
-func computeBackendUsageList(list []capabilitiesv1beta1.Backend, backendUsageMap map[string]capabilitiesv1beta1.BackendUsageSpec) []capabilitiesv1beta1.Backend {
-	target := map[string]bool{}
-	for systemName := range backendUsageMap {
-		target[systemName] = true
+// backendUsageNamespacesBackendList returns the valid backend resources
+// of the namespaces referenced in the product backend usages
+func backendUsageNamespacesBackendList(resource *capabilitiesv1beta1.Product, cl client.Client, providerAccount *controllerhelper.ProviderAccount, logger logr.Logger) ([]capabilitiesv1beta1.Backend, error) {
+	namespaces := make([]string, 0)
+	for systemName := range resource.Spec.BackendUsages {
+		namespace := resource.BackendUsageNamespace(systemName)
+		if !helper.ArrayContains(namespaces, namespace) {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+
+	result := make([]capabilitiesv1beta1.Backend, 0)
+	for _, namespace := range namespaces {
+		backendList, err := controllerhelper.BackendList(namespace, cl, providerAccount, logger)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, backendList...)
 	}
 
+	return result, nil
+}
+
+// computeBackendUsageList returns the backend resources used by the product.
+// Backend resources are matched by system name in the namespace of the backend usage
+func computeBackendUsageList(resource *capabilitiesv1beta1.Product, list []capabilitiesv1beta1.Backend) []capabilitiesv1beta1.Backend {
 	result := make([]capabilitiesv1beta1.Backend, 0)
-	for _, backend := range list {
-		if _, ok := target[backend.Spec.SystemName]; ok {
-			result = append(result, backend)
+	for idx := range list {
+		if resource.UsesBackend(&list[idx]) {
+			result = append(result, list[idx])
 		}
 	}
 
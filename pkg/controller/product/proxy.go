@@ -2,7 +2,10 @@ package product
 
 import (
 	"fmt"
+	"strconv"
 
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
 	"github.com/3scale/3scale-operator/pkg/helper"
 
 	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
@@ -83,11 +86,113 @@ func (t *ThreescaleReconciler) syncProxy(_ interface{}) error {
 		}
 	}
 
-	if len(params) > 0 {
+	// Gateway response
+	gatewayResponse := t.resource.Spec.GatewayResponseSpec()
+	if gatewayResponse != nil {
+		syncGatewayResponseParams(gatewayResponse, existing, params)
+	}
+
+	// OpenID Connect
+	oidcSpec := t.resource.Spec.OIDCSpec()
+	if oidcSpec != nil {
+		if existing.Element.OidcIssuerType != oidcSpec.IssuerType {
+			params["oidc_issuer_type"] = oidcSpec.IssuerType
+		}
+
+		if existing.Element.OidcIssuerEndpoint != oidcSpec.IssuerEndpoint {
+			params["oidc_issuer_endpoint"] = oidcSpec.IssuerEndpoint
+		}
+
+		if oidcSpec.JwtClaimWithClientID != nil {
+			if existing.Element.JwtClaimWithClientID != *oidcSpec.JwtClaimWithClientID {
+				params["jwt_claim_with_client_id"] = *oidcSpec.JwtClaimWithClientID
+			}
+		}
+
+		if oidcSpec.JwtClaimWithClientIDType != nil {
+			if existing.Element.JwtClaimWithClientIDType != *oidcSpec.JwtClaimWithClientIDType {
+				params["jwt_claim_with_client_id_type"] = *oidcSpec.JwtClaimWithClientIDType
+			}
+		}
+	}
+
+	if len(params) > 0 && !t.skipChange(controllerhelper.DriftSectionProxy, capabilitiesv1beta1.PlannedChangeUpdate, "proxy", params) {
 		err := t.productEntity.UpdateProxy(params)
 		if err != nil {
 			return fmt.Errorf("Error updating product proxy: %w", err)
 		}
 	}
+
+	if oidcSpec != nil && oidcSpec.AuthenticationFlow != nil {
+		err := t.syncOIDCAuthenticationFlow(oidcSpec.AuthenticationFlow)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+func (t *ThreescaleReconciler) syncOIDCAuthenticationFlow(desired *capabilitiesv1beta1.OIDCAuthenticationFlowSpec) error {
+	existing, err := t.productEntity.OIDCConfiguration()
+	if err != nil {
+		return fmt.Errorf("Error sync product [%s] oidc configuration: %w", t.resource.Spec.SystemName, err)
+	}
+
+	params := threescaleapi.Params{}
+
+	if existing.Element.StandardFlowEnabled != desired.StandardFlowEnabled {
+		params["standard_flow_enabled"] = strconv.FormatBool(desired.StandardFlowEnabled)
+	}
+
+	if existing.Element.ImplicitFlowEnabled != desired.ImplicitFlowEnabled {
+		params["implicit_flow_enabled"] = strconv.FormatBool(desired.ImplicitFlowEnabled)
+	}
+
+	if existing.Element.ServiceAccountsEnabled != desired.ServiceAccountsEnabled {
+		params["service_accounts_enabled"] = strconv.FormatBool(desired.ServiceAccountsEnabled)
+	}
+
+	if existing.Element.DirectAccessGrantsEnabled != desired.DirectAccessGrantsEnabled {
+		params["direct_access_grants_enabled"] = strconv.FormatBool(desired.DirectAccessGrantsEnabled)
+	}
+
+	if len(params) > 0 && !t.skipChange(controllerhelper.DriftSectionProxy, capabilitiesv1beta1.PlannedChangeUpdate, "oidcConfiguration", params) {
+		err := t.productEntity.UpdateOIDCConfiguration(params)
+		if err != nil {
+			return fmt.Errorf("Error updating product oidc configuration: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func syncGatewayResponseParams(desired *capabilitiesv1beta1.GatewayResponseSpec, existing *controllerhelper.ProxyExtJSON, params threescaleapi.Params) {
+	syncInt := func(key string, desiredVal *int32, existingVal int) {
+		if desiredVal != nil && int(*desiredVal) != existingVal {
+			params[key] = strconv.Itoa(int(*desiredVal))
+		}
+	}
+
+	syncString := func(key string, desiredVal *string, existingVal string) {
+		if desiredVal != nil && *desiredVal != existingVal {
+			params[key] = *desiredVal
+		}
+	}
+
+	syncInt("error_status_auth_failed", desired.ErrorStatusAuthFailed, existing.Element.ErrorStatusAuthFailed)
+	syncString("error_headers_auth_failed", desired.ErrorHeadersAuthFailed, existing.Element.ErrorHeadersAuthFailed)
+	syncString("error_auth_failed", desired.ErrorAuthFailed, existing.Element.ErrorAuthFailed)
+
+	syncInt("error_status_auth_missing", desired.ErrorStatusAuthMissing, existing.Element.ErrorStatusAuthMissing)
+	syncString("error_headers_auth_missing", desired.ErrorHeadersAuthMissing, existing.Element.ErrorHeadersAuthMissing)
+	syncString("error_auth_missing", desired.ErrorAuthMissing, existing.Element.ErrorAuthMissing)
+
+	syncInt("error_status_no_match", desired.ErrorStatusNoMatch, existing.Element.ErrorStatusNoMatch)
+	syncString("error_headers_no_match", desired.ErrorHeadersNoMatch, existing.Element.ErrorHeadersNoMatch)
+	syncString("error_no_match", desired.ErrorNoMatch, existing.Element.ErrorNoMatch)
+
+	syncInt("error_status_limits_exceeded", desired.ErrorStatusLimitsExceeded, existing.Element.ErrorStatusLimitsExceeded)
+	syncString("error_headers_limits_exceeded", desired.ErrorHeadersLimitsExceeded, existing.Element.ErrorHeadersLimitsExceeded)
+	syncString("error_limits_exceeded", desired.ErrorLimitsExceeded, existing.Element.ErrorLimitsExceeded)
+}
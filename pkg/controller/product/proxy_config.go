@@ -0,0 +1,41 @@
+package product
+
+import (
+	"fmt"
+	"strconv"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+)
+
+// promoteProxyConfig promotes the desired staging proxy config version to production
+// and reads the latest proxy config versions of both environments
+func (t *ThreescaleReconciler) promoteProxyConfig(_ interface{}) error {
+	productionVersion, err := t.productEntity.LatestProxyConfigVersion(controllerhelper.ProxyConfigProductionEnvironment)
+	if err != nil {
+		return fmt.Errorf("Error promote product [%s] proxy config: %w", t.resource.Spec.SystemName, err)
+	}
+
+	desiredVersion := t.resource.Spec.ProductionConfigVersion
+	// If production config version is not set in CR, will not be reconciled, respecting 3scale production proxy config.
+	if desiredVersion != nil && *desiredVersion != productionVersion && !t.skipChange(controllerhelper.DriftSectionProxy, capabilitiesv1beta1.PlannedChangeUpdate, "production", threescaleapi.Params{"version": strconv.FormatInt(*desiredVersion, 10)}) {
+		t.logger.Info("promote proxy config to production", "version", *desiredVersion)
+		err = t.productEntity.PromoteProxyConfigToProduction(*desiredVersion)
+		if err != nil {
+			return fmt.Errorf("Error promote product [%s] proxy config: %w", t.resource.Spec.SystemName, err)
+		}
+		productionVersion = *desiredVersion
+	}
+
+	stagingVersion, err := t.productEntity.LatestProxyConfigVersion(controllerhelper.ProxyConfigStagingEnvironment)
+	if err != nil {
+		return fmt.Errorf("Error promote product [%s] proxy config: %w", t.resource.Spec.SystemName, err)
+	}
+
+	t.stagingConfigVersion = &stagingVersion
+	t.productionConfigVersion = &productionVersion
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package product
+
+import (
+	"fmt"
+	"strconv"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+)
+
+func (t *ThreescaleReconciler) syncServicePlans(_ interface{}) error {
+	// If service plans are not set in CR, will not be reconciled, respecting 3scale service plans.
+	// Every 3scale product has at least one service plan
+	if len(t.resource.Spec.ServicePlans) == 0 {
+		return nil
+	}
+
+	desiredKeys := make([]string, 0, len(t.resource.Spec.ServicePlans))
+	for systemName := range t.resource.Spec.ServicePlans {
+		desiredKeys = append(desiredKeys, systemName)
+	}
+
+	existingList, err := t.productEntity.ServicePlans()
+	if err != nil {
+		return fmt.Errorf("Error sync product [%s] service plans: %w", t.resource.Spec.SystemName, err)
+	}
+
+	existingKeys := make([]string, 0, len(existingList.Plans))
+	existingMap := map[string]controllerhelper.ServicePlanItem{}
+	for _, existing := range existingList.Plans {
+		systemName := existing.Element.SystemName
+		existingKeys = append(existingKeys, systemName)
+		existingMap[systemName] = existing.Element
+	}
+
+	//
+	// Deleted existing and not desired
+	//
+
+	notDesiredExistingKeys := helper.ArrayStringDifference(existingKeys, desiredKeys)
+	t.logger.V(1).Info("syncServicePlans", "notDesiredExistingKeys", notDesiredExistingKeys)
+	for _, systemName := range notDesiredExistingKeys {
+		// key is expected to exist
+		// notDesiredExistingKeys is a subset of the existingMap key set
+		if t.skipChange(controllerhelper.DriftSectionServicePlans, capabilitiesv1beta1.PlannedChangeDelete, systemName, nil) {
+			continue
+		}
+
+		err := t.productEntity.DeleteServicePlan(existingMap[systemName].ID)
+		if err != nil {
+			return fmt.Errorf("Error sync product [%s] service plans: %w", t.resource.Spec.SystemName, err)
+		}
+	}
+
+	//
+	// Reconcile existing
+	//
+	matchedKeys := helper.ArrayStringIntersection(existingKeys, desiredKeys)
+	t.logger.V(1).Info("syncServicePlans", "matchedKeys", matchedKeys)
+	for _, systemName := range matchedKeys {
+		// interface to remote entity
+		planEntity := controllerhelper.NewServicePlanEntity(t.productEntity.ID(), existingMap[systemName], t.threescaleAPIClient, t.logger)
+		err := t.syncServicePlan(systemName, t.resource.Spec.ServicePlans[systemName], planEntity)
+		if err != nil {
+			return fmt.Errorf("Error sync product [%s] service plan [%s]: %w", t.resource.Spec.SystemName, systemName, err)
+		}
+	}
+
+	//
+	// Create not existing and desired
+	//
+
+	desiredNewKeys := helper.ArrayStringDifference(desiredKeys, existingKeys)
+	t.logger.V(1).Info("syncServicePlans", "desiredNewKeys", desiredNewKeys)
+	for _, systemName := range desiredNewKeys {
+		// key is expected to exist
+		// desiredNewKeys is a subset of the Spec.ServicePlans map key set
+		if t.skipChange(controllerhelper.DriftSectionServicePlans, capabilitiesv1beta1.PlannedChangeCreate, systemName, nil) {
+			continue
+		}
+
+		// Create Service Plan using system_name.
+		// it cannot be modified later
+		params := threescaleapi.Params{"system_name": systemName, "name": systemName}
+		obj, err := t.productEntity.CreateServicePlan(params)
+		if err != nil {
+			return fmt.Errorf("Error sync product [%s] service plan [%s]: %w", t.resource.Spec.SystemName, systemName, err)
+		}
+		// interface to remote entity
+		planEntity := controllerhelper.NewServicePlanEntity(t.productEntity.ID(), obj.Element, t.threescaleAPIClient, t.logger)
+		err = t.syncServicePlan(systemName, t.resource.Spec.ServicePlans[systemName], planEntity)
+		if err != nil {
+			return fmt.Errorf("Error sync product [%s] service plan [%s]: %w", t.resource.Spec.SystemName, systemName, err)
+		}
+	}
+
+	return nil
+}
+
+// syncServicePlan ensures service plan attrs are reconciled
+func (t *ThreescaleReconciler) syncServicePlan(systemName string, planSpec capabilitiesv1beta1.ServicePlanSpec, planEntity *controllerhelper.ServicePlanEntity) error {
+	params := threescaleapi.Params{}
+
+	if planSpec.Name != nil {
+		if planEntity.Name() != *planSpec.Name {
+			params["name"] = *planSpec.Name
+		}
+	}
+
+	if planSpec.SubscriptionsRequireApproval != nil {
+		if planEntity.ApprovalRequired() != *planSpec.SubscriptionsRequireApproval {
+			params["approval_required"] = strconv.FormatBool(*planSpec.SubscriptionsRequireApproval)
+		}
+	}
+
+	if planSpec.TrialPeriod != nil {
+		if planEntity.TrialPeriodDays() != *planSpec.TrialPeriod {
+			params["trial_period_days"] = strconv.Itoa(*planSpec.TrialPeriod)
+		}
+	}
+
+	if planSpec.SetupFee != nil {
+		// Field CRD openapiV3 validation should ensure no error parsing
+		desiredValue, _ := strconv.ParseFloat(*planSpec.SetupFee, 10)
+		if planEntity.SetupFee() != desiredValue {
+			params["setup_fee"] = *planSpec.SetupFee
+		}
+	}
+
+	if planSpec.CostMonth != nil {
+		// Field CRD openapiV3 validation should ensure no error parsing
+		desiredValue, _ := strconv.ParseFloat(*planSpec.CostMonth, 10)
+		if planEntity.CostPerMonth() != desiredValue {
+			params["cost_per_month"] = *planSpec.CostMonth
+		}
+	}
+
+	if planSpec.Published != nil {
+		if planEntity.Published() != *planSpec.Published {
+			params["state_event"] = controllerhelper.PlanStateEvent(*planSpec.Published)
+		}
+	}
+
+	if len(params) == 0 {
+		return nil
+	}
+
+	if t.skipChange(controllerhelper.DriftSectionServicePlans, capabilitiesv1beta1.PlannedChangeUpdate, systemName, params) {
+		return nil
+	}
+
+	err := planEntity.Update(params)
+	if err != nil {
+		return fmt.Errorf("Error sync service plan [%s;%d]: %w", systemName, planEntity.ID(), err)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package product
 
 import (
 	"fmt"
+	"strings"
 
 	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
 	"github.com/3scale/3scale-operator/pkg/common"
@@ -21,7 +22,14 @@ type StatusReconciler struct {
 	entity              *controllerhelper.ProductEntity
 	providerAccountHost string
 	syncError           error
-	logger              logr.Logger
+	policyChainDrift    bool
+	driftedSections     []string
+	// Nil proxy config versions keep the current status values
+	stagingConfigVersion    *int64
+	productionConfigVersion *int64
+	// Changes computed in dry run mode
+	plan   *capabilitiesv1beta1.PlanStatus
+	logger logr.Logger
 }
 
 func NewStatusReconciler(b *reconcilers.BaseReconciler, resource *capabilitiesv1beta1.Product, entity *controllerhelper.ProductEntity, providerAccountHost string, syncError error) *StatusReconciler {
@@ -39,6 +47,7 @@ func (s *StatusReconciler) Reconcile() (reconcile.Result, error) {
 	s.logger.V(1).Info("START")
 
 	newStatus := s.calculateStatus()
+	controllerhelper.SetResourceSynced(capabilitiesv1beta1.ProductKind, s.resource, newStatus.Conditions.IsTrueFor(capabilitiesv1beta1.ProductSyncedConditionType))
 
 	equalStatus := s.resource.Status.Equals(newStatus, s.logger)
 	s.logger.V(1).Info("Status", "status is different", !equalStatus)
@@ -73,6 +82,8 @@ func (s *StatusReconciler) Reconcile() (reconcile.Result, error) {
 
 func (s *StatusReconciler) calculateStatus() *capabilitiesv1beta1.ProductStatus {
 	newStatus := &capabilitiesv1beta1.ProductStatus{}
+	// The ID of the managed 3scale product is kept when unknown. It is the ownership marker of the resource
+	newStatus.ID = s.resource.Status.ID
 	if s.entity != nil {
 		tmpID := s.entity.ID()
 		newStatus.ID = &tmpID
@@ -80,15 +91,38 @@ func (s *StatusReconciler) calculateStatus() *capabilitiesv1beta1.ProductStatus
 		newStatus.State = &tmpState
 	}
 
+	newStatus.StagingConfigVersion = s.resource.Status.StagingConfigVersion
+	if s.stagingConfigVersion != nil {
+		newStatus.StagingConfigVersion = s.stagingConfigVersion
+	}
+
+	newStatus.ProductionConfigVersion = s.resource.Status.ProductionConfigVersion
+	if s.productionConfigVersion != nil {
+		newStatus.ProductionConfigVersion = s.productionConfigVersion
+	}
+
 	newStatus.ProviderAccountHost = s.providerAccountHost
 
 	newStatus.ObservedGeneration = s.resource.Status.ObservedGeneration
 
+	// The plan is only known when the sync process completed
+	newStatus.Plan = s.resource.Status.Plan
+	if s.syncError == nil {
+		newStatus.Plan = s.plan
+	}
+
 	newStatus.Conditions = s.resource.Status.Conditions.Copy()
 	newStatus.Conditions.SetCondition(s.syncCondition())
 	newStatus.Conditions.SetCondition(s.orphanCondition())
 	newStatus.Conditions.SetCondition(s.invalidCondition())
 	newStatus.Conditions.SetCondition(s.failedCondition())
+	newStatus.Conditions.SetCondition(s.unavailableCondition())
+	newStatus.Conditions.SetCondition(s.plannedCondition())
+	if s.syncError == nil {
+		// policy chain drift is only known when the sync process completed
+		newStatus.Conditions.SetCondition(s.policyChainDriftCondition())
+		newStatus.Conditions.SetCondition(s.outOfSyncCondition())
+	}
 
 	return newStatus
 }
@@ -99,13 +133,28 @@ func (s *StatusReconciler) syncCondition() common.Condition {
 		Status: corev1.ConditionFalse,
 	}
 
-	if s.syncError == nil {
+	// Nothing is synchronized in dry run mode
+	if s.syncError == nil && !s.resource.Spec.DryRun {
 		condition.Status = corev1.ConditionTrue
 	}
 
 	return condition
 }
 
+func (s *StatusReconciler) plannedCondition() common.Condition {
+	condition := common.Condition{
+		Type:   capabilitiesv1beta1.ProductPlannedConditionType,
+		Status: corev1.ConditionFalse,
+	}
+
+	if s.syncError == nil && s.plan != nil {
+		condition.Status = corev1.ConditionTrue
+		condition.Message = fmt.Sprintf("%d changes planned", s.plan.Total)
+	}
+
+	return condition
+}
+
 func (s *StatusReconciler) orphanCondition() common.Condition {
 	condition := common.Condition{
 		Type:   capabilitiesv1beta1.ProductOrphanConditionType,
@@ -141,10 +190,59 @@ func (s *StatusReconciler) failedCondition() common.Condition {
 	}
 
 	// This condition could be activated together with other conditions
-	if s.syncError != nil {
+	if s.syncError != nil && !controllerhelper.IsThreescaleUnavailable(s.syncError) {
 		condition.Status = corev1.ConditionTrue
 		condition.Message = s.syncError.Error()
 	}
 
 	return condition
 }
+
+func (s *StatusReconciler) unavailableCondition() common.Condition {
+	condition := common.Condition{
+		Type:   capabilitiesv1beta1.ProductUnavailableConditionType,
+		Status: corev1.ConditionFalse,
+	}
+
+	if controllerhelper.IsThreescaleUnavailable(s.syncError) {
+		condition.Status = corev1.ConditionTrue
+		condition.Message = s.syncError.Error()
+	}
+
+	return condition
+}
+
+func (s *StatusReconciler) policyChainDriftCondition() common.Condition {
+	condition := common.Condition{
+		Type:   capabilitiesv1beta1.ProductPolicyChainDriftConditionType,
+		Status: corev1.ConditionFalse,
+	}
+
+	if s.policyChainDrift {
+		condition.Status = corev1.ConditionTrue
+		condition.Message = "3scale policy chain did not match the spec policies and it has been overwritten"
+	}
+
+	return condition
+}
+
+func (s *StatusReconciler) outOfSyncCondition() common.Condition {
+	condition := common.Condition{
+		Type:   capabilitiesv1beta1.ProductOutOfSyncConditionType,
+		Status: corev1.ConditionFalse,
+	}
+
+	if len(s.driftedSections) == 0 {
+		return condition
+	}
+
+	sections := strings.Join(s.driftedSections, ", ")
+	if s.resource.Spec.ReportDriftOnly() {
+		condition.Status = corev1.ConditionTrue
+		condition.Message = fmt.Sprintf("3scale product differs from the spec in sections: %s", sections)
+	} else {
+		condition.Message = fmt.Sprintf("3scale product differed from the spec and it has been corrected in sections: %s", sections)
+	}
+
+	return condition
+}
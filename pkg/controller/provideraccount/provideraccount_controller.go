@@ -0,0 +1,223 @@
+package provideraccount
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	"github.com/3scale/3scale-operator/pkg/common"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/3scale/3scale-operator/pkg/reconcilers"
+	"github.com/3scale/3scale-operator/version"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	// defaultResyncPeriod is the period credentials are verified again
+	// when PROVIDERACCOUNT_RESYNC_PERIOD is not set
+	defaultResyncPeriod = 5 * time.Minute
+)
+
+var (
+	// controllerName is the name of this controller
+	controllerName = "controller_provideraccount"
+
+	// package level logger
+	log = logf.Log.WithName(controllerName)
+)
+
+// Add creates a new ProviderAccount Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	reconciler, err := newReconciler(mgr)
+	if err != nil {
+		return err
+	}
+
+	return add(mgr, reconciler)
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager) (reconcile.Reconciler, error) {
+	apiClientReader, err := common.NewAPIClientReader(mgr)
+	if err != nil {
+		return nil, err
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	resyncPeriod := controllerhelper.ResyncPeriod(controllerhelper.PROVIDERACCOUNT_RESYNC_PERIOD_ENVVAR, log)
+	if resyncPeriod == 0 {
+		resyncPeriod = defaultResyncPeriod
+	}
+
+	client := mgr.GetClient()
+	scheme := mgr.GetScheme()
+	ctx := context.TODO()
+	recorder := mgr.GetEventRecorderFor(controllerName)
+	return &ReconcileProviderAccount{
+		BaseReconciler: reconcilers.NewBaseReconciler(client, scheme, apiClientReader, ctx, log, discoveryClient, recorder),
+		resyncPeriod:   resyncPeriod,
+	}, nil
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New("provideraccount-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to primary resource ProviderAccount
+	err = c.Watch(&source.Kind{Type: &capabilitiesv1beta1.ProviderAccount{}}, &handler.EnqueueRequestForObject{})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// blank assignment to verify that ReconcileProviderAccount implements reconcile.Reconciler
+var _ reconcile.Reconciler = &ReconcileProviderAccount{}
+
+// ReconcileProviderAccount reconciles a ProviderAccount object
+type ReconcileProviderAccount struct {
+	*reconcilers.BaseReconciler
+	// resyncPeriod is the period credentials are verified again
+	resyncPeriod time.Duration
+}
+
+// Reconcile reads that state of the cluster for a ProviderAccount object and verifies
+// the credentials against the 3scale Account Management API
+func (r *ReconcileProviderAccount) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := r.Logger().WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconcile ProviderAccount", "Operator version", version.Version)
+
+	// Fetch the ProviderAccount instance
+	providerAccount := &capabilitiesv1beta1.ProviderAccount{}
+	err := r.Client().Get(r.Context(), request.NamespacedName, providerAccount)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Return and don't requeue
+			controllerhelper.DeleteResourceSynced(capabilitiesv1beta1.ProviderAccountKind, request.NamespacedName)
+			reqLogger.Info("resource not found. Ignoring since object must have been deleted")
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	if reqLogger.V(1).Enabled() {
+		jsonData, err := json.MarshalIndent(providerAccount, "", "  ")
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		reqLogger.V(1).Info(string(jsonData))
+	}
+
+	// Ignore deleted ProviderAccounts, this can happen when foregroundDeletion is enabled
+	// https://kubernetes.io/docs/concepts/workloads/controllers/garbage-collection/#foreground-cascading-deletion
+	if providerAccount.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	statusReconciler, reconcileErr := r.reconcile(providerAccount)
+	statusResult, statusUpdateErr := statusReconciler.Reconcile()
+	if statusUpdateErr != nil {
+		if reconcileErr != nil {
+			return reconcile.Result{}, fmt.Errorf("Failed to verify provider account: %v. Failed to update provider account status: %w", reconcileErr, statusUpdateErr)
+		}
+
+		return reconcile.Result{}, fmt.Errorf("Failed to update provider account status: %w", statusUpdateErr)
+	}
+
+	if statusResult.Requeue {
+		return statusResult, nil
+	}
+
+	if reconcileErr != nil {
+		if helper.IsInvalidSpecError(reconcileErr) {
+			// On Validation error, no need to retry as spec is not valid and needs to be changed
+			reqLogger.Info("ERROR", "spec validation error", reconcileErr)
+			r.EventRecorder().Eventf(providerAccount, corev1.EventTypeWarning, "Invalid ProviderAccount Spec", "%v", reconcileErr)
+			return reconcile.Result{}, nil
+		}
+
+		reqLogger.Error(reconcileErr, "Failed to reconcile")
+		r.EventRecorder().Eventf(providerAccount, corev1.EventTypeWarning, "ReconcileError", "%v", reconcileErr)
+		return reconcile.Result{}, reconcileErr
+	}
+
+	reqLogger.Info("END", "error", reconcileErr)
+	// Credentials can be revoked and the API can become unreachable, verify periodically
+	return reconcile.Result{RequeueAfter: r.resyncPeriod}, nil
+}
+
+func (r *ReconcileProviderAccount) reconcile(providerAccountResource *capabilitiesv1beta1.ProviderAccount) (*StatusReconciler, error) {
+	err := r.validateSpec(providerAccountResource)
+	if err != nil {
+		statusReconciler := NewStatusReconciler(r.BaseReconciler, providerAccountResource, nil, "", corev1.ConditionUnknown, err)
+		return statusReconciler, err
+	}
+
+	providerAccount, err := controllerhelper.ProviderAccountFromResource(r.Client(), providerAccountResource)
+	if err != nil {
+		statusReconciler := NewStatusReconciler(r.BaseReconciler, providerAccountResource, nil, "", corev1.ConditionUnknown, err)
+		return statusReconciler, err
+	}
+
+	threescaleAPIClient, err := controllerhelper.PortaClient(providerAccount)
+	if err != nil {
+		statusReconciler := NewStatusReconciler(r.BaseReconciler, providerAccountResource, nil, providerAccount.AdminURLStr, corev1.ConditionUnknown, err)
+		return statusReconciler, err
+	}
+
+	account, err := threescaleAPIClient.ReadProviderAccount()
+	if err != nil {
+		err = fmt.Errorf("Failed to verify provider account credentials: %w", err)
+		account = nil
+	}
+
+	// 3scale responded, even when credentials were rejected
+	reachable := corev1.ConditionTrue
+	if controllerhelper.IsThreescaleUnavailable(err) {
+		reachable = corev1.ConditionFalse
+	}
+
+	statusReconciler := NewStatusReconciler(r.BaseReconciler, providerAccountResource, account, providerAccount.AdminURLStr, reachable, err)
+	return statusReconciler, err
+}
+
+func (r *ReconcileProviderAccount) validateSpec(providerAccountResource *capabilitiesv1beta1.ProviderAccount) error {
+	errors := field.ErrorList{}
+	// internal validation
+	errors = append(errors, providerAccountResource.Validate()...)
+
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return &helper.SpecFieldError{
+		ErrorType:      helper.InvalidError,
+		FieldErrorList: errors,
+	}
+}
@@ -5,8 +5,11 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	apiv1alpha1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1alpha1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
 	porta_client_pkg "github.com/3scale/3scale-porta-go-client/client"
 	"github.com/go-logr/logr"
 	v1 "k8s.io/api/core/v1"
@@ -16,17 +19,27 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// 3scale tenant account states
+const (
+	tenantAccountApprovedState             = "approved"
+	tenantAccountSuspendedState            = "suspended"
+	tenantAccountScheduledForDeletionState = "scheduled_for_deletion"
+)
+
+// tenantControllerName labels the tenant reconcile task durations
+const tenantControllerName = "tenant-controller"
+
 // InternalReconciler reconciles a Tenant object
 type InternalReconciler struct {
 	k8sClient   client.Client
 	tenantR     *apiv1alpha1.Tenant
-	portaClient *porta_client_pkg.ThreeScaleClient
+	portaClient *controllerhelper.ThreescaleAPIClient
 	logger      logr.Logger
 }
 
 // NewInternalReconciler constructs InternalReconciler object
 func NewInternalReconciler(k8sClient client.Client, tenantR *apiv1alpha1.Tenant,
-	portaClient *porta_client_pkg.ThreeScaleClient, log logr.Logger) *InternalReconciler {
+	portaClient *controllerhelper.ThreescaleAPIClient, log logr.Logger) *InternalReconciler {
 	return &InternalReconciler{
 		k8sClient:   k8sClient,
 		tenantR:     tenantR,
@@ -41,17 +54,23 @@ func NewInternalReconciler(k8sClient client.Client, tenantR *apiv1alpha1.Tenant,
 // - Have active admin user
 // - Have secret with tenant's access_token
 func (r *InternalReconciler) Run() error {
+	start := time.Now()
 	tenantDef, err := r.reconcileTenant()
+	helper.ObserveTaskDuration(tenantControllerName, "ReconcileTenant", start)
 	if err != nil {
 		return err
 	}
 
+	start = time.Now()
 	adminUserDef, err := r.reconcileAdminUser(tenantDef)
+	helper.ObserveTaskDuration(tenantControllerName, "ReconcileAdminUser", start)
 	if err != nil {
 		return err
 	}
 
+	start = time.Now()
 	err = r.reconcileAccessTokenSecret(tenantDef)
+	helper.ObserveTaskDuration(tenantControllerName, "ReconcileAccessTokenSecret", start)
 	if err != nil {
 		return err
 	}
@@ -86,7 +105,7 @@ func (r *InternalReconciler) reconcileTenant() (*porta_client_pkg.Tenant, error)
 		}
 	}
 
-	return tenantDef, nil
+	return r.syncTenantState(tenantDef)
 }
 
 func (r *InternalReconciler) fetchTenant() (*porta_client_pkg.Tenant, error) {
@@ -136,6 +155,59 @@ func (r *InternalReconciler) syncTenant(tenantDef *porta_client_pkg.Tenant) erro
 	return nil
 }
 
+// This method makes sure tenant account state matches desired state.
+// Nil desired state means tenant account state is not reconciled.
+func (r *InternalReconciler) syncTenantState(tenantDef *porta_client_pkg.Tenant) (*porta_client_pkg.Tenant, error) {
+	if r.tenantR.Spec.State == nil {
+		return tenantDef, nil
+	}
+
+	currentState := tenantDef.Signup.Account.State
+	stateEvent := ""
+	if *r.tenantR.Spec.State == apiv1alpha1.TenantStateSuspended && currentState == tenantAccountApprovedState {
+		stateEvent = "suspend"
+	} else if *r.tenantR.Spec.State == apiv1alpha1.TenantStateActive && currentState == tenantAccountSuspendedState {
+		stateEvent = "resume"
+	}
+
+	if stateEvent == "" {
+		return tenantDef, nil
+	}
+
+	r.logger.Info("Syncing tenant state", "TenantId", tenantDef.Signup.Account.ID, "stateEvent", stateEvent)
+	params := porta_client_pkg.Params{
+		"state_event": stateEvent,
+	}
+	return r.portaClient.UpdateTenant(tenantDef.Signup.Account.ID, params)
+}
+
+// Delete schedules tenant account for deletion
+// 3scale deletes tenant accounts scheduled for deletion permanently after some days
+func (r *InternalReconciler) Delete() error {
+	tenantDef, err := r.fetchTenant()
+	if err != nil {
+		return err
+	}
+
+	if tenantDef == nil {
+		r.logger.Info("Tenant not found. Nothing to delete")
+		return nil
+	}
+
+	if tenantDef.Signup.Account.State == tenantAccountScheduledForDeletionState {
+		r.logger.Info("Tenant already scheduled for deletion", "TenantId", tenantDef.Signup.Account.ID)
+		return nil
+	}
+
+	r.logger.Info("Deleting tenant", "TenantId", tenantDef.Signup.Account.ID)
+	err = r.portaClient.DeleteTenant(tenantDef.Signup.Account.ID)
+	if err != nil && !porta_client_pkg.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
 ////
 //
 // This method makes sure admin user:
@@ -364,6 +436,7 @@ func (r *InternalReconciler) getTenantStatus(tenantDef *porta_client_pkg.Tenant,
 	return &apiv1alpha1.TenantStatus{
 		TenantId: tenantDef.Signup.Account.ID,
 		AdminId:  adminUserDef.ID,
+		State:    tenantDef.Signup.Account.State,
 	}
 }
 
@@ -8,12 +8,16 @@ import (
 	"github.com/3scale/3scale-operator/pkg/3scale/amp/component"
 	apiv1alpha1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1alpha1"
 	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+	"github.com/3scale/3scale-operator/pkg/helper"
+	"github.com/go-logr/logr"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -45,13 +49,13 @@ func Add(mgr manager.Manager) error {
 
 // newReconciler returns a new reconcile.Reconciler
 func newReconciler(mgr manager.Manager) reconcile.Reconciler {
-	return &ReconcileTenant{client: mgr.GetClient(), scheme: mgr.GetScheme()}
+	return &ReconcileTenant{client: mgr.GetClient(), scheme: mgr.GetScheme(), recorder: mgr.GetEventRecorderFor(tenantControllerName)}
 }
 
 // add adds a new Controller to mgr with r as the reconcile.Reconciler
 func add(mgr manager.Manager, r reconcile.Reconciler) error {
 	// Create a new controller
-	c, err := controller.New("tenant-controller", mgr, controller.Options{Reconciler: r})
+	c, err := controller.New(tenantControllerName, mgr, controller.Options{Reconciler: r})
 	if err != nil {
 		return err
 	}
@@ -72,8 +76,9 @@ var _ reconcile.Reconciler = &ReconcileTenant{}
 type ReconcileTenant struct {
 	// This client, initialized using mgr.Client() above, is a split client
 	// that reads objects from the cache and writes to the apiserver
-	client client.Client
-	scheme *runtime.Scheme
+	client   client.Client
+	scheme   *runtime.Scheme
+	recorder record.EventRecorder
 }
 
 // Reconcile reads that state of the cluster for a Tenant object and makes changes based on the state read
@@ -95,6 +100,7 @@ func (r *ReconcileTenant) Reconcile(request reconcile.Request) (reconcile.Result
 			// Request object not found, could have been deleted after reconcile request.
 			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
 			// Return and don't requeue
+			controllerhelper.DeleteResourceSynced(apiv1alpha1.TenantKind, request.NamespacedName)
 			reqLogger.Info("Tenant resource not found")
 			return reconcile.Result{}, nil
 		}
@@ -102,6 +108,27 @@ func (r *ReconcileTenant) Reconcile(request reconcile.Request) (reconcile.Result
 		return reconcile.Result{}, err
 	}
 
+	if tenantR.GetDeletionTimestamp() != nil && helper.ArrayContains(tenantR.GetFinalizers(), apiv1alpha1.TenantFinalizer) {
+		return r.reconcileDeletion(tenantR, reqLogger)
+	}
+
+	// Ignore deleted Tenants, this can happen when foregroundDeletion is enabled
+	// https://kubernetes.io/docs/concepts/workloads/controllers/garbage-collection/#foreground-cascading-deletion
+	if tenantR.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	if !helper.ArrayContains(tenantR.GetFinalizers(), apiv1alpha1.TenantFinalizer) {
+		controllerutil.AddFinalizer(tenantR, apiv1alpha1.TenantFinalizer)
+		err = r.client.Update(context.TODO(), tenantR)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		reqLogger.Info("Tenant resource updated with finalizer")
+		// Expect for re-trigger
+		return reconcile.Result{}, nil
+	}
+
 	changed := tenantR.SetDefaults()
 	if changed {
 		err = r.client.Update(context.TODO(), tenantR)
@@ -113,22 +140,15 @@ func (r *ReconcileTenant) Reconcile(request reconcile.Request) (reconcile.Result
 		return reconcile.Result{}, nil
 	}
 
-	masterAccessToken, err := FetchMasterCredentials(r.client, tenantR)
+	portaClient, err := masterPortaClient(r.client, tenantR)
 	if err != nil {
-		log.Error(err, "Error fetching master credentials secret")
-		// Error reading the object - requeue the request.
-		return reconcile.Result{}, err
-	}
-
-	portaClient, err := controllerhelper.PortaClientFromURLString(tenantR.Spec.SystemMasterUrl, masterAccessToken)
-	if err != nil {
-		log.Error(err, "Error creating porta client object")
 		// Error reading the object - requeue the request.
 		return reconcile.Result{}, err
 	}
 
 	internalReconciler := NewInternalReconciler(r.client, tenantR, portaClient, reqLogger)
 	err = internalReconciler.Run()
+	controllerhelper.SetResourceSynced(apiv1alpha1.TenantKind, tenantR, err == nil)
 	if err != nil {
 		log.Error(err, "Error in tenant reconciliation")
 		// Error reading the object - requeue the request.
@@ -139,6 +159,58 @@ func (r *ReconcileTenant) Reconcile(request reconcile.Request) (reconcile.Result
 	return reconcile.Result{}, nil
 }
 
+// reconcileDeletion schedules 3scale tenant account for deletion, unless orphan on delete is requested,
+// and removes the finalizer to let the Tenant resource be deleted
+func (r *ReconcileTenant) reconcileDeletion(tenantR *apiv1alpha1.Tenant, reqLogger logr.Logger) (reconcile.Result, error) {
+	if tenantR.OrphanOnDelete() {
+		reqLogger.Info("orphan on delete annotation found. 3scale tenant account will not be scheduled for deletion")
+	} else {
+		err := r.delete3scaleTenant(tenantR, reqLogger)
+		if err != nil {
+			// The resource is kept while the deletion fails, the orphan on delete annotation releases it
+			log.Error(err, "Error in tenant deletion")
+			r.recorder.Eventf(tenantR, v1.EventTypeWarning, "DeleteError",
+				"%v. Set the %s annotation to \"true\" to delete the resource keeping the 3scale tenant account", err, apiv1alpha1.OrphanOnDeleteAnnotation)
+			return reconcile.Result{}, err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(tenantR, apiv1alpha1.TenantFinalizer)
+	err := r.client.Update(context.TODO(), tenantR)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	reqLogger.Info("Tenant deleted successfully")
+	return reconcile.Result{}, nil
+}
+
+func (r *ReconcileTenant) delete3scaleTenant(tenantR *apiv1alpha1.Tenant, reqLogger logr.Logger) error {
+	portaClient, err := masterPortaClient(r.client, tenantR)
+	if err != nil {
+		return err
+	}
+
+	internalReconciler := NewInternalReconciler(r.client, tenantR, portaClient, reqLogger)
+	return internalReconciler.Delete()
+}
+
+func masterPortaClient(k8sClient client.Client, tenantR *apiv1alpha1.Tenant) (*controllerhelper.ThreescaleAPIClient, error) {
+	masterAccessToken, err := FetchMasterCredentials(k8sClient, tenantR)
+	if err != nil {
+		log.Error(err, "Error fetching master credentials secret")
+		return nil, err
+	}
+
+	portaClient, err := controllerhelper.PortaClientFromURLString(tenantR.Spec.SystemMasterUrl, masterAccessToken)
+	if err != nil {
+		log.Error(err, "Error creating porta client object")
+		return nil, err
+	}
+
+	return portaClient, nil
+}
+
 // FetchMasterCredentials get secret using k8s client
 func FetchMasterCredentials(k8sClient client.Client, tenantR *apiv1alpha1.Tenant) (string, error) {
 	masterCredentialsSecret := &v1.Secret{}
@@ -0,0 +1,79 @@
+package tenant
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apiv1alpha1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1alpha1"
+	"github.com/3scale/3scale-operator/pkg/helper"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTestDeletedTenant(annotations map[string]string) *apiv1alpha1.Tenant {
+	now := metav1.Now()
+	return &apiv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "ecorp-tenant",
+			Namespace:         "test",
+			Annotations:       annotations,
+			Finalizers:        []string{apiv1alpha1.TenantFinalizer},
+			DeletionTimestamp: &now,
+		},
+		Spec: apiv1alpha1.TenantSpec{
+			SystemMasterUrl:      "https://master.example.com",
+			MasterCredentialsRef: v1.SecretReference{Name: "ecorp-master-secret"},
+		},
+		Status: apiv1alpha1.TenantStatus{TenantId: 3},
+	}
+}
+
+func TestReconcileTenantDeletionWithoutMasterCredentials(t *testing.T) {
+	cases := []struct {
+		testName        string
+		annotations     map[string]string
+		expectFinalizer bool
+	}{
+		{"blocked", nil, true},
+		{"orphan", map[string]string{apiv1alpha1.OrphanOnDeleteAnnotation: "true"}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			s := runtime.NewScheme()
+			if err := apiv1alpha1.SchemeBuilder.AddToScheme(s); err != nil {
+				subT.Fatal(err)
+			}
+			tenantR := newTestDeletedTenant(tc.annotations)
+			recorder := record.NewFakeRecorder(10)
+			r := &ReconcileTenant{client: fake.NewFakeClientWithScheme(s, tenantR), scheme: s, recorder: recorder}
+
+			// The master credentials secret does not exist
+			_, err := r.reconcileDeletion(tenantR, log)
+			if tc.expectFinalizer != (err != nil) {
+				subT.Fatalf("expected deletion error: %t, got: %v", tc.expectFinalizer, err)
+			}
+
+			updated := &apiv1alpha1.Tenant{}
+			if err := r.client.Get(context.TODO(), types.NamespacedName{Name: "ecorp-tenant", Namespace: "test"}, updated); err != nil {
+				subT.Fatal(err)
+			}
+			if helper.ArrayContains(updated.GetFinalizers(), apiv1alpha1.TenantFinalizer) != tc.expectFinalizer {
+				subT.Fatalf("expected finalizer: %t, got: %v", tc.expectFinalizer, updated.GetFinalizers())
+			}
+
+			if tc.expectFinalizer {
+				event := <-recorder.Events
+				if !strings.Contains(event, "DeleteError") || !strings.Contains(event, apiv1alpha1.OrphanOnDeleteAnnotation) {
+					subT.Fatalf("expected DeleteError event pointing to the orphan annotation, got %s", event)
+				}
+			}
+		})
+	}
+}
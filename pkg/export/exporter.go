@@ -0,0 +1,700 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	deploymentOptionHosted = "hosted"
+
+	backendVersionUserKey = "1"
+	backendVersionAppID   = "2"
+	backendVersionOIDC    = "oidc"
+)
+
+var (
+	// resource names keep word boundaries of the system names
+	wordSeparatorRegexp = regexp.MustCompile("[^a-z0-9]+")
+)
+
+// ExporterOptions customizes the exported resources
+type ExporterOptions struct {
+	// ProductSystemNames of the products to export. All products are exported when empty.
+	// Only the backends used by the exported products are exported when set.
+	ProductSystemNames []string
+	// ProviderAccountRef is the name of the ProviderAccount resource or provider account secret referenced by the exported resources.
+	// Resources do not reference any provider account when empty.
+	ProviderAccountRef string
+}
+
+// Exporter generates Product and Backend resources from the products and backends of a 3scale tenant.
+// Resources are generated to be applied without changes in 3scale on the first reconciliation.
+// Resources adopt the existing 3scale products and backends.
+type Exporter struct {
+	client             *controllerhelper.ThreescaleAPIClient
+	backendRemoteIndex *controllerhelper.BackendAPIRemoteIndex
+	options            ExporterOptions
+	logger             logr.Logger
+}
+
+func NewExporter(client *controllerhelper.ThreescaleAPIClient, options ExporterOptions, logger logr.Logger) (*Exporter, error) {
+	backendRemoteIndex, err := controllerhelper.NewBackendAPIRemoteIndex(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Exporter{
+		client:             client,
+		backendRemoteIndex: backendRemoteIndex,
+		options:            options,
+		logger:             logger,
+	}, nil
+}
+
+// Export generates the Product and Backend resources of the tenant
+func (e *Exporter) Export() ([]*capabilitiesv1beta1.Product, []*capabilitiesv1beta1.Backend, error) {
+	productList, err := e.client.ListProducts()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	selectedProducts := map[string]bool{}
+	for _, systemName := range e.options.ProductSystemNames {
+		selectedProducts[systemName] = false
+	}
+
+	products := make([]*capabilitiesv1beta1.Product, 0, len(productList.Products))
+	usedBackends := map[int64]bool{}
+	for idx := range productList.Products {
+		productEntity := controllerhelper.NewProductEntity(&productList.Products[idx], e.client, e.logger)
+		if len(selectedProducts) > 0 {
+			if _, ok := selectedProducts[productEntity.SystemName()]; !ok {
+				continue
+			}
+			selectedProducts[productEntity.SystemName()] = true
+		}
+
+		product, err := e.Product(productEntity)
+		if err != nil {
+			return nil, nil, err
+		}
+		products = append(products, product)
+
+		backendUsages, err := productEntity.BackendUsages()
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, backendUsage := range backendUsages {
+			usedBackends[backendUsage.Element.BackendAPIID] = true
+		}
+	}
+
+	for systemName, found := range selectedProducts {
+		if !found {
+			return nil, nil, fmt.Errorf("product [%s] not found", systemName)
+		}
+	}
+
+	backends := []*capabilitiesv1beta1.Backend{}
+	for _, backendEntity := range e.backendRemoteIndex.BackendAPIs() {
+		if len(selectedProducts) > 0 && !usedBackends[backendEntity.ID()] {
+			continue
+		}
+
+		backend, err := e.Backend(backendEntity)
+		if err != nil {
+			return nil, nil, err
+		}
+		backends = append(backends, backend)
+	}
+
+	return products, backends, nil
+}
+
+// Backend generates the Backend resource of a 3scale backend
+func (e *Exporter) Backend(backendEntity *controllerhelper.BackendAPIEntity) (*capabilitiesv1beta1.Backend, error) {
+	backend := &capabilitiesv1beta1.Backend{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: capabilitiesv1beta1.SchemeGroupVersion.String(),
+			Kind:       capabilitiesv1beta1.BackendKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: resourceName(backendEntity.SystemName()),
+		},
+		Spec: capabilitiesv1beta1.BackendSpec{
+			Name:               backendEntity.Name(),
+			SystemName:         backendEntity.SystemName(),
+			Description:        backendEntity.Description(),
+			PrivateBaseURL:     backendEntity.PrivateEndpoint(),
+			Adopt:              true,
+			ProviderAccountRef: e.providerAccountRef(),
+		},
+	}
+
+	metrics, err := backendEntity.Metrics()
+	if err != nil {
+		return nil, fmt.Errorf("Error exporting backend [%s] metrics: %w", backendEntity.SystemName(), err)
+	}
+	backend.Spec.Metrics = metricsSpec(metrics)
+
+	methods, err := backendEntity.Methods()
+	if err != nil {
+		return nil, fmt.Errorf("Error exporting backend [%s] methods: %w", backendEntity.SystemName(), err)
+	}
+	backend.Spec.Methods = methodsSpec(methods)
+
+	metricsAndMethods, err := backendEntity.MetricsAndMethods()
+	if err != nil {
+		return nil, fmt.Errorf("Error exporting backend [%s] mapping rules: %w", backendEntity.SystemName(), err)
+	}
+
+	mappingRules, err := backendEntity.MappingRules()
+	if err != nil {
+		return nil, fmt.Errorf("Error exporting backend [%s] mapping rules: %w", backendEntity.SystemName(), err)
+	}
+
+	backend.Spec.MappingRules, err = mappingRulesSpec(mappingRules, metricSystemNameIndex(metricsAndMethods))
+	if err != nil {
+		return nil, fmt.Errorf("Error exporting backend [%s] mapping rules: %w", backendEntity.SystemName(), err)
+	}
+
+	return backend, nil
+}
+
+// Product generates the Product resource of a 3scale product
+func (e *Exporter) Product(productEntity *controllerhelper.ProductEntity) (*capabilitiesv1beta1.Product, error) {
+	product := &capabilitiesv1beta1.Product{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: capabilitiesv1beta1.SchemeGroupVersion.String(),
+			Kind:       capabilitiesv1beta1.ProductKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: resourceName(productEntity.SystemName()),
+		},
+		Spec: capabilitiesv1beta1.ProductSpec{
+			Name:               productEntity.Name(),
+			SystemName:         productEntity.SystemName(),
+			Description:        productEntity.Description(),
+			Adopt:              true,
+			ProviderAccountRef: e.providerAccountRef(),
+		},
+	}
+
+	proxy, err := productEntity.Proxy()
+	if err != nil {
+		return nil, fmt.Errorf("Error exporting product [%s] proxy: %w", productEntity.SystemName(), err)
+	}
+
+	var oidcConfiguration *controllerhelper.OIDCConfigurationItem
+	if productEntity.BackendVersion() == backendVersionOIDC {
+		obj, err := productEntity.OIDCConfiguration()
+		if err != nil {
+			return nil, fmt.Errorf("Error exporting product [%s] proxy: %w", productEntity.SystemName(), err)
+		}
+		oidcConfiguration = &obj.Element
+	}
+
+	product.Spec.Deployment = deploymentSpec(productEntity.DeploymentOption(), productEntity.BackendVersion(), &proxy.Element, oidcConfiguration)
+
+	metrics, err := productEntity.Metrics()
+	if err != nil {
+		return nil, fmt.Errorf("Error exporting product [%s] metrics: %w", productEntity.SystemName(), err)
+	}
+	product.Spec.Metrics = metricsSpec(metrics)
+
+	methods, err := productEntity.Methods()
+	if err != nil {
+		return nil, fmt.Errorf("Error exporting product [%s] methods: %w", productEntity.SystemName(), err)
+	}
+	product.Spec.Methods = methodsSpec(methods)
+
+	metricsAndMethods, err := productEntity.MetricsAndMethods()
+	if err != nil {
+		return nil, fmt.Errorf("Error exporting product [%s] mapping rules: %w", productEntity.SystemName(), err)
+	}
+	productMetricIndex := metricSystemNameIndex(metricsAndMethods)
+
+	mappingRules, err := productEntity.MappingRules()
+	if err != nil {
+		return nil, fmt.Errorf("Error exporting product [%s] mapping rules: %w", productEntity.SystemName(), err)
+	}
+
+	product.Spec.MappingRules, err = mappingRulesSpec(mappingRules, productMetricIndex)
+	if err != nil {
+		return nil, fmt.Errorf("Error exporting product [%s] mapping rules: %w", productEntity.SystemName(), err)
+	}
+
+	// Index of the metrics and methods available for the plan limits and pricing rules
+	metricRefIndex := map[int64]capabilitiesv1beta1.MetricMethodRefSpec{}
+	for id, systemName := range productMetricIndex {
+		metricRefIndex[id] = capabilitiesv1beta1.MetricMethodRefSpec{SystemName: systemName}
+	}
+
+	backendUsages, err := productEntity.BackendUsages()
+	if err != nil {
+		return nil, fmt.Errorf("Error exporting product [%s] backend usages: %w", productEntity.SystemName(), err)
+	}
+
+	product.Spec.BackendUsages = map[string]capabilitiesv1beta1.BackendUsageSpec{}
+	for _, backendUsage := range backendUsages {
+		backendEntity, ok := e.backendRemoteIndex.FindByID(backendUsage.Element.BackendAPIID)
+		if !ok {
+			return nil, fmt.Errorf("Error exporting product [%s] backend usages: backend ID [%d] not found", productEntity.SystemName(), backendUsage.Element.BackendAPIID)
+		}
+
+		backendSystemName := backendEntity.SystemName()
+		product.Spec.BackendUsages[backendSystemName] = capabilitiesv1beta1.BackendUsageSpec{Path: backendUsage.Element.Path}
+
+		backendMetricsAndMethods, err := backendEntity.MetricsAndMethods()
+		if err != nil {
+			return nil, fmt.Errorf("Error exporting product [%s] backend usages: %w", productEntity.SystemName(), err)
+		}
+
+		for id, systemName := range metricSystemNameIndex(backendMetricsAndMethods) {
+			metricRefIndex[id] = capabilitiesv1beta1.MetricMethodRefSpec{
+				SystemName:        systemName,
+				BackendSystemName: &backendSystemName,
+			}
+		}
+	}
+
+	features, err := productEntity.Features()
+	if err != nil {
+		return nil, fmt.Errorf("Error exporting product [%s] features: %w", productEntity.SystemName(), err)
+	}
+	product.Spec.Features = featuresSpec(features)
+
+	policies, err := productEntity.Policies()
+	if err != nil {
+		return nil, fmt.Errorf("Error exporting product [%s] policies: %w", productEntity.SystemName(), err)
+	}
+
+	product.Spec.Policies, err = policiesSpec(policies.Policies)
+	if err != nil {
+		return nil, fmt.Errorf("Error exporting product [%s] policies: %w", productEntity.SystemName(), err)
+	}
+
+	planList, err := productEntity.ApplicationPlans()
+	if err != nil {
+		return nil, fmt.Errorf("Error exporting product [%s] plans: %w", productEntity.SystemName(), err)
+	}
+
+	product.Spec.ApplicationPlans = map[string]capabilitiesv1beta1.ApplicationPlanSpec{}
+	for _, plan := range planList.Plans {
+		planEntity := controllerhelper.NewApplicationPlanEntity(productEntity.ID(), plan.Element, e.client, e.logger)
+		planSpec, err := e.applicationPlanSpec(planEntity, metricRefIndex)
+		if err != nil {
+			return nil, fmt.Errorf("Error exporting product [%s] plan [%s]: %w", productEntity.SystemName(), plan.Element.SystemName, err)
+		}
+		product.Spec.ApplicationPlans[plan.Element.SystemName] = *planSpec
+		if planEntity.Default() {
+			defaultPlan := plan.Element.SystemName
+			product.Spec.DefaultApplicationPlan = &defaultPlan
+		}
+	}
+
+	servicePlanList, err := productEntity.ServicePlans()
+	if err != nil {
+		return nil, fmt.Errorf("Error exporting product [%s] service plans: %w", productEntity.SystemName(), err)
+	}
+	product.Spec.ServicePlans = servicePlansSpec(servicePlanList)
+
+	return product, nil
+}
+
+func (e *Exporter) applicationPlanSpec(planEntity *controllerhelper.ApplicationPlanEntity, metricRefIndex map[int64]capabilitiesv1beta1.MetricMethodRefSpec) (*capabilitiesv1beta1.ApplicationPlanSpec, error) {
+	name := planEntity.Name()
+	approvalRequired := planEntity.ApprovalRequired()
+	trialPeriod := planEntity.TrialPeriodDays()
+	setupFee := fee(planEntity.SetupFee())
+	costMonth := fee(planEntity.CostPerMonth())
+	published := planEntity.Published()
+
+	planSpec := &capabilitiesv1beta1.ApplicationPlanSpec{
+		Name:                &name,
+		AppsRequireApproval: &approvalRequired,
+		TrialPeriod:         &trialPeriod,
+		SetupFee:            &setupFee,
+		CostMonth:           &costMonth,
+		Published:           &published,
+	}
+
+	limits, err := planEntity.Limits()
+	if err != nil {
+		return nil, err
+	}
+
+	planSpec.Limits, err = limitsSpec(limits, metricRefIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	pricingRules, err := planEntity.PricingRules()
+	if err != nil {
+		return nil, err
+	}
+
+	planSpec.PricingRules, err = pricingRulesSpec(pricingRules, metricRefIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	features, err := planEntity.Features()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, feature := range features.Features {
+		if feature.Element.Scope == controllerhelper.ApplicationPlanFeatureScope {
+			planSpec.Features = append(planSpec.Features, feature.Element.SystemName)
+		}
+	}
+	sort.Strings(planSpec.Features)
+
+	return planSpec, nil
+}
+
+func (e *Exporter) providerAccountRef() *capabilitiesv1beta1.ProviderAccountReference {
+	if e.options.ProviderAccountRef == "" {
+		return nil
+	}
+
+	return &capabilitiesv1beta1.ProviderAccountReference{Name: e.options.ProviderAccountRef}
+}
+
+// metricSystemNameIndex returns the metric and method system names by ID
+func metricSystemNameIndex(list *threescaleapi.MetricJSONList) map[int64]string {
+	index := map[int64]string{}
+	for _, metric := range list.Metrics {
+		index[metric.Element.ID] = metric.Element.SystemName
+	}
+	return index
+}
+
+func metricsSpec(list *threescaleapi.MetricJSONList) map[string]capabilitiesv1beta1.MetricSpec {
+	metrics := map[string]capabilitiesv1beta1.MetricSpec{}
+	for _, metric := range list.Metrics {
+		metrics[metric.Element.SystemName] = capabilitiesv1beta1.MetricSpec{
+			Name:        metric.Element.Name,
+			Unit:        metric.Element.Unit,
+			Description: metric.Element.Description,
+		}
+	}
+	return metrics
+}
+
+func methodsSpec(list *threescaleapi.MethodList) map[string]capabilitiesv1beta1.MethodSpec {
+	if len(list.Methods) == 0 {
+		return nil
+	}
+
+	methods := map[string]capabilitiesv1beta1.MethodSpec{}
+	for _, method := range list.Methods {
+		methods[method.Element.SystemName] = capabilitiesv1beta1.MethodSpec{
+			Name:        method.Element.Name,
+			Description: method.Element.Description,
+		}
+	}
+	return methods
+}
+
+// mappingRulesSpec returns the mapping rules sorted by position.
+// metricIndex maps metric and method IDs to system names
+func mappingRulesSpec(list *threescaleapi.MappingRuleJSONList, metricIndex map[int64]string) ([]capabilitiesv1beta1.MappingRuleSpec, error) {
+	items := make([]threescaleapi.MappingRuleItem, 0, len(list.MappingRules))
+	for _, mappingRule := range list.MappingRules {
+		items = append(items, mappingRule.Element)
+	}
+	sort.SliceStable(items, func(i, j int) bool { return items[i].Position < items[j].Position })
+
+	mappingRules := make([]capabilitiesv1beta1.MappingRuleSpec, 0, len(items))
+	for _, item := range items {
+		metricSystemName, ok := metricIndex[item.MetricID]
+		if !ok {
+			return nil, fmt.Errorf("mapping rule [%s %s] metric ID [%d] not found", item.HTTPMethod, item.Pattern, item.MetricID)
+		}
+
+		mappingRule := capabilitiesv1beta1.MappingRuleSpec{
+			HTTPMethod:      item.HTTPMethod,
+			Pattern:         item.Pattern,
+			MetricMethodRef: metricSystemName,
+			Increment:       item.Delta,
+		}
+
+		if item.Last {
+			last := true
+			mappingRule.Last = &last
+		}
+
+		mappingRules = append(mappingRules, mappingRule)
+	}
+
+	if len(mappingRules) == 0 {
+		return nil, nil
+	}
+
+	return mappingRules, nil
+}
+
+// limitsSpec returns the plan limits.
+// metricRefIndex maps product and backend metric and method IDs to references
+func limitsSpec(list *threescaleapi.ApplicationPlanLimitList, metricRefIndex map[int64]capabilitiesv1beta1.MetricMethodRefSpec) ([]capabilitiesv1beta1.LimitSpec, error) {
+	if len(list.Limits) == 0 {
+		return nil, nil
+	}
+
+	limits := make([]capabilitiesv1beta1.LimitSpec, 0, len(list.Limits))
+	for _, limit := range list.Limits {
+		metricRef, ok := metricRefIndex[limit.Element.MetricID]
+		if !ok {
+			return nil, fmt.Errorf("limit metric ID [%d] not found", limit.Element.MetricID)
+		}
+
+		limits = append(limits, capabilitiesv1beta1.LimitSpec{
+			Period:          limit.Element.Period,
+			Value:           limit.Element.Value,
+			MetricMethodRef: metricRef,
+		})
+	}
+
+	return limits, nil
+}
+
+// pricingRulesSpec returns the plan pricing rules.
+// metricRefIndex maps product and backend metric and method IDs to references
+func pricingRulesSpec(list *threescaleapi.ApplicationPlanPricingRuleList, metricRefIndex map[int64]capabilitiesv1beta1.MetricMethodRefSpec) ([]capabilitiesv1beta1.PricingRuleSpec, error) {
+	if len(list.Rules) == 0 {
+		return nil, nil
+	}
+
+	rules := make([]capabilitiesv1beta1.PricingRuleSpec, 0, len(list.Rules))
+	for _, rule := range list.Rules {
+		metricRef, ok := metricRefIndex[rule.Element.MetricID]
+		if !ok {
+			return nil, fmt.Errorf("pricing rule metric ID [%d] not found", rule.Element.MetricID)
+		}
+
+		rules = append(rules, capabilitiesv1beta1.PricingRuleSpec{
+			From:            rule.Element.Min,
+			To:              rule.Element.Max,
+			MetricMethodRef: metricRef,
+			// The reconciler compares the price with the 3scale value as a string
+			PricePerUnit: rule.Element.CostPerUnit,
+		})
+	}
+
+	return rules, nil
+}
+
+// featuresSpec returns the product features that can be enabled in application plans.
+// Other scopes are not managed by the Product resource
+func servicePlansSpec(list *controllerhelper.ServicePlanList) map[string]capabilitiesv1beta1.ServicePlanSpec {
+	plans := map[string]capabilitiesv1beta1.ServicePlanSpec{}
+	for _, plan := range list.Plans {
+		name := plan.Element.Name
+		approvalRequired := plan.Element.ApprovalRequired
+		trialPeriod := plan.Element.TrialPeriodDays
+		setupFee := fee(plan.Element.SetupFee)
+		costMonth := fee(plan.Element.CostPerMonth)
+		published := plan.Element.State == controllerhelper.PlanPublishedState
+		plans[plan.Element.SystemName] = capabilitiesv1beta1.ServicePlanSpec{
+			Name:                         &name,
+			SubscriptionsRequireApproval: &approvalRequired,
+			TrialPeriod:                  &trialPeriod,
+			SetupFee:                     &setupFee,
+			CostMonth:                    &costMonth,
+			Published:                    &published,
+		}
+	}
+	return plans
+}
+
+func featuresSpec(list *controllerhelper.FeatureJSONList) map[string]capabilitiesv1beta1.FeatureSpec {
+	features := map[string]capabilitiesv1beta1.FeatureSpec{}
+	for _, feature := range list.Features {
+		if feature.Element.Scope != controllerhelper.ApplicationPlanFeatureScope {
+			continue
+		}
+
+		features[feature.Element.SystemName] = capabilitiesv1beta1.FeatureSpec{
+			Name:        feature.Element.Name,
+			Description: feature.Element.Description,
+		}
+	}
+
+	if len(features) == 0 {
+		return nil
+	}
+
+	return features
+}
+
+// policiesSpec returns the product policy chain.
+// The chain is not exported when it only contains the builtin apicast policy,
+// the default chain of the 3scale products.
+func policiesSpec(list []controllerhelper.PolicyConfigItem) ([]capabilitiesv1beta1.PolicyConfig, error) {
+	if len(list) == 0 || (len(list) == 1 && list[0].Name == "apicast" && list[0].Enabled && len(list[0].Configuration) == 0) {
+		return nil, nil
+	}
+
+	policies := make([]capabilitiesv1beta1.PolicyConfig, 0, len(list))
+	for _, item := range list {
+		policy := capabilitiesv1beta1.PolicyConfig{
+			Name:    item.Name,
+			Version: item.Version,
+			Enabled: item.Enabled,
+		}
+
+		if len(item.Configuration) > 0 {
+			raw, err := json.Marshal(item.Configuration)
+			if err != nil {
+				return nil, fmt.Errorf("policy [%s] configuration: %w", item.Name, err)
+			}
+			policy.Configuration = runtime.RawExtension{Raw: raw}
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
+
+// deploymentSpec returns the deployment spec from the product deployment option, authentication mode and proxy.
+// oidcConfiguration is only read for OpenID Connect authentication
+func deploymentSpec(deploymentOption, backendVersion string, proxy *controllerhelper.ProxyExtItem, oidcConfiguration *controllerhelper.OIDCConfigurationItem) *capabilitiesv1beta1.ProductDeploymentSpec {
+	authentication := authenticationSpec(backendVersion, proxy, oidcConfiguration)
+
+	if deploymentOption == deploymentOptionHosted {
+		return &capabilitiesv1beta1.ProductDeploymentSpec{
+			ApicastHosted: &capabilitiesv1beta1.ApicastHostedSpec{Authentication: authentication},
+		}
+	}
+
+	return &capabilitiesv1beta1.ProductDeploymentSpec{
+		ApicastSelfManaged: &capabilitiesv1beta1.ApicastSelfManagedSpec{
+			Authentication:          authentication,
+			StagingPublicBaseURL:    optionalString(proxy.SandboxEndpoint),
+			ProductionPublicBaseURL: optionalString(proxy.Endpoint),
+		},
+	}
+}
+
+func authenticationSpec(backendVersion string, proxy *controllerhelper.ProxyExtItem, oidcConfiguration *controllerhelper.OIDCConfigurationItem) *capabilitiesv1beta1.AuthenticationSpec {
+	security := securitySpec(proxy)
+	gatewayResponse := gatewayResponseSpec(proxy)
+
+	switch backendVersion {
+	case backendVersionUserKey:
+		return &capabilitiesv1beta1.AuthenticationSpec{
+			UserKeyAuthentication: &capabilitiesv1beta1.UserKeyAuthenticationSpec{
+				Key:             optionalString(proxy.AuthUserKey),
+				CredentialsLoc:  optionalString(proxy.CredentialsLocation),
+				Security:        security,
+				GatewayResponse: gatewayResponse,
+			},
+		}
+	case backendVersionAppID:
+		return &capabilitiesv1beta1.AuthenticationSpec{
+			AppKeyAppIDAuthentication: &capabilitiesv1beta1.AppKeyAppIDAuthenticationSpec{
+				AppID:           optionalString(proxy.AuthAppID),
+				AppKey:          optionalString(proxy.AuthAppKey),
+				CredentialsLoc:  optionalString(proxy.CredentialsLocation),
+				Security:        security,
+				GatewayResponse: gatewayResponse,
+			},
+		}
+	case backendVersionOIDC:
+		oidc := &capabilitiesv1beta1.OIDCSpec{
+			IssuerType:               proxy.OidcIssuerType,
+			IssuerEndpoint:           proxy.OidcIssuerEndpoint,
+			JwtClaimWithClientID:     optionalString(proxy.JwtClaimWithClientID),
+			JwtClaimWithClientIDType: optionalString(proxy.JwtClaimWithClientIDType),
+			Security:                 security,
+			GatewayResponse:          gatewayResponse,
+		}
+
+		// OpenID Connect credentials cannot be read from the authorization header
+		if proxy.CredentialsLocation == "headers" || proxy.CredentialsLocation == "query" {
+			oidc.CredentialsLoc = optionalString(proxy.CredentialsLocation)
+		}
+
+		if oidcConfiguration != nil {
+			oidc.AuthenticationFlow = &capabilitiesv1beta1.OIDCAuthenticationFlowSpec{
+				StandardFlowEnabled:       oidcConfiguration.StandardFlowEnabled,
+				ImplicitFlowEnabled:       oidcConfiguration.ImplicitFlowEnabled,
+				ServiceAccountsEnabled:    oidcConfiguration.ServiceAccountsEnabled,
+				DirectAccessGrantsEnabled: oidcConfiguration.DirectAccessGrantsEnabled,
+			}
+		}
+
+		return &capabilitiesv1beta1.AuthenticationSpec{OIDC: oidc}
+	}
+
+	return nil
+}
+
+func securitySpec(proxy *controllerhelper.ProxyExtItem) *capabilitiesv1beta1.SecuritySpec {
+	if proxy.HostnameRewrite == "" && proxy.SecretToken == "" {
+		return nil
+	}
+
+	return &capabilitiesv1beta1.SecuritySpec{
+		HostHeader:  optionalString(proxy.HostnameRewrite),
+		SecretToken: optionalString(proxy.SecretToken),
+	}
+}
+
+func gatewayResponseSpec(proxy *controllerhelper.ProxyExtItem) *capabilitiesv1beta1.GatewayResponseSpec {
+	return &capabilitiesv1beta1.GatewayResponseSpec{
+		ErrorStatusAuthFailed:      optionalInt32(proxy.ErrorStatusAuthFailed),
+		ErrorHeadersAuthFailed:     optionalString(proxy.ErrorHeadersAuthFailed),
+		ErrorAuthFailed:            optionalString(proxy.ErrorAuthFailed),
+		ErrorStatusAuthMissing:     optionalInt32(proxy.ErrorStatusAuthMissing),
+		ErrorHeadersAuthMissing:    optionalString(proxy.ErrorHeadersAuthMissing),
+		ErrorAuthMissing:           optionalString(proxy.ErrorAuthMissing),
+		ErrorStatusNoMatch:         optionalInt32(proxy.ErrorStatusNoMatch),
+		ErrorHeadersNoMatch:        optionalString(proxy.ErrorHeadersNoMatch),
+		ErrorNoMatch:               optionalString(proxy.ErrorNoMatch),
+		ErrorStatusLimitsExceeded:  optionalInt32(proxy.ErrorStatusLimitsExceeded),
+		ErrorHeadersLimitsExceeded: optionalString(proxy.ErrorHeadersLimitsExceeded),
+		ErrorLimitsExceeded:        optionalString(proxy.ErrorLimitsExceeded),
+	}
+}
+
+// optionalString returns nil for empty values. Unset spec fields are not reconciled
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// optionalInt32 returns nil for zero values. Unset spec fields are not reconciled
+func optionalInt32(value int) *int32 {
+	if value == 0 {
+		return nil
+	}
+	tmp := int32(value)
+	return &tmp
+}
+
+// fee formats the 3scale amount with the format expected by the application plan spec
+func fee(value float64) string {
+	return fmt.Sprintf("%.2f", value)
+}
+
+func resourceName(systemName string) string {
+	return strings.Trim(wordSeparatorRegexp.ReplaceAllString(strings.ToLower(systemName), "-"), "-")
+}
@@ -0,0 +1,244 @@
+package export
+
+import (
+	"testing"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+	controllerhelper "github.com/3scale/3scale-operator/pkg/controller/helper"
+
+	threescaleapi "github.com/3scale/3scale-porta-go-client/client"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestMappingRulesSpec(t *testing.T) {
+	list := &threescaleapi.MappingRuleJSONList{
+		MappingRules: []threescaleapi.MappingRuleJSON{
+			{Element: threescaleapi.MappingRuleItem{MetricID: 2, Pattern: "/pets$", HTTPMethod: "POST", Delta: 2, Position: 3, Last: true}},
+			{Element: threescaleapi.MappingRuleItem{MetricID: 1, Pattern: "/", HTTPMethod: "GET", Delta: 1, Position: 1}},
+		},
+	}
+	metricIndex := map[int64]string{1: "hits", 2: "createpets"}
+
+	mappingRules, err := mappingRulesSpec(list, metricIndex)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	last := true
+	expected := []capabilitiesv1beta1.MappingRuleSpec{
+		{HTTPMethod: "GET", Pattern: "/", MetricMethodRef: "hits", Increment: 1},
+		{HTTPMethod: "POST", Pattern: "/pets$", MetricMethodRef: "createpets", Increment: 2, Last: &last},
+	}
+	if diff := cmp.Diff(mappingRules, expected); diff != "" {
+		t.Fatalf("unexpected mapping rules: %s", diff)
+	}
+
+	_, err = mappingRulesSpec(list, map[int64]string{1: "hits"})
+	if err == nil {
+		t.Fatal("expected error for unknown metric")
+	}
+}
+
+func TestPlanLimitsAndPricingRulesSpec(t *testing.T) {
+	backendSystemName := "petstorebackend"
+	metricRefIndex := map[int64]capabilitiesv1beta1.MetricMethodRefSpec{
+		1: {SystemName: "hits"},
+		2: {SystemName: "hits", BackendSystemName: &backendSystemName},
+	}
+
+	limits, err := limitsSpec(&threescaleapi.ApplicationPlanLimitList{
+		Limits: []threescaleapi.ApplicationPlanLimit{
+			{Element: threescaleapi.ApplicationPlanLimitItem{Period: "month", Value: 100, MetricID: 1}},
+			{Element: threescaleapi.ApplicationPlanLimitItem{Period: "day", Value: 10, MetricID: 2}},
+		},
+	}, metricRefIndex)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedLimits := []capabilitiesv1beta1.LimitSpec{
+		{Period: "month", Value: 100, MetricMethodRef: capabilitiesv1beta1.MetricMethodRefSpec{SystemName: "hits"}},
+		{Period: "day", Value: 10, MetricMethodRef: capabilitiesv1beta1.MetricMethodRefSpec{SystemName: "hits", BackendSystemName: &backendSystemName}},
+	}
+	if diff := cmp.Diff(limits, expectedLimits); diff != "" {
+		t.Fatalf("unexpected limits: %s", diff)
+	}
+
+	rules, err := pricingRulesSpec(&threescaleapi.ApplicationPlanPricingRuleList{
+		Rules: []threescaleapi.ApplicationPlanPricingRule{
+			{Element: threescaleapi.ApplicationPlanPricingRuleItem{MetricID: 2, CostPerUnit: "0.5", Min: 1, Max: 100}},
+		},
+	}, metricRefIndex)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedRules := []capabilitiesv1beta1.PricingRuleSpec{
+		{From: 1, To: 100, PricePerUnit: "0.5", MetricMethodRef: capabilitiesv1beta1.MetricMethodRefSpec{SystemName: "hits", BackendSystemName: &backendSystemName}},
+	}
+	if diff := cmp.Diff(rules, expectedRules); diff != "" {
+		t.Fatalf("unexpected pricing rules: %s", diff)
+	}
+
+	_, err = limitsSpec(&threescaleapi.ApplicationPlanLimitList{
+		Limits: []threescaleapi.ApplicationPlanLimit{
+			{Element: threescaleapi.ApplicationPlanLimitItem{Period: "month", Value: 100, MetricID: 3}},
+		},
+	}, metricRefIndex)
+	if err == nil {
+		t.Fatal("expected error for unknown metric")
+	}
+}
+
+func TestDeploymentSpec(t *testing.T) {
+	proxy := &controllerhelper.ProxyExtItem{
+		ProxyItem: threescaleapi.ProxyItem{
+			Endpoint:              "https://api.example.com:443",
+			SandboxEndpoint:       "https://api-staging.example.com:443",
+			CredentialsLocation:   "headers",
+			AuthUserKey:           "user_key",
+			ErrorStatusAuthFailed: 403,
+			ErrorAuthFailed:       "Authentication failed",
+			SecretToken:           "secret",
+		},
+	}
+
+	deployment := deploymentSpec("self_managed", "1", proxy, nil)
+
+	credentials := "headers"
+	userKey := "user_key"
+	secretToken := "secret"
+	errorStatusAuthFailed := int32(403)
+	errorAuthFailed := "Authentication failed"
+	productionURL := "https://api.example.com:443"
+	stagingURL := "https://api-staging.example.com:443"
+	expected := &capabilitiesv1beta1.ProductDeploymentSpec{
+		ApicastSelfManaged: &capabilitiesv1beta1.ApicastSelfManagedSpec{
+			Authentication: &capabilitiesv1beta1.AuthenticationSpec{
+				UserKeyAuthentication: &capabilitiesv1beta1.UserKeyAuthenticationSpec{
+					Key:            &userKey,
+					CredentialsLoc: &credentials,
+					Security:       &capabilitiesv1beta1.SecuritySpec{SecretToken: &secretToken},
+					GatewayResponse: &capabilitiesv1beta1.GatewayResponseSpec{
+						ErrorStatusAuthFailed: &errorStatusAuthFailed,
+						ErrorAuthFailed:       &errorAuthFailed,
+					},
+				},
+			},
+			StagingPublicBaseURL:    &stagingURL,
+			ProductionPublicBaseURL: &productionURL,
+		},
+	}
+	if diff := cmp.Diff(deployment, expected); diff != "" {
+		t.Fatalf("unexpected deployment: %s", diff)
+	}
+}
+
+func TestDeploymentSpecOIDC(t *testing.T) {
+	proxy := &controllerhelper.ProxyExtItem{
+		ProxyItem: threescaleapi.ProxyItem{
+			CredentialsLocation: "authorization",
+			OidcIssuerEndpoint:  "https://sso.example.com/auth/realms/petstore",
+		},
+		OidcIssuerType: "keycloak",
+	}
+	oidcConfiguration := &controllerhelper.OIDCConfigurationItem{StandardFlowEnabled: true}
+
+	deployment := deploymentSpec("hosted", "oidc", proxy, oidcConfiguration)
+	if deployment.ApicastHosted == nil || deployment.ApicastHosted.Authentication == nil {
+		t.Fatalf("expected hosted deployment with authentication: %v", deployment)
+	}
+
+	oidc := deployment.ApicastHosted.Authentication.OIDC
+	if oidc == nil {
+		t.Fatal("expected oidc authentication")
+	}
+	if oidc.IssuerType != "keycloak" || oidc.IssuerEndpoint != proxy.OidcIssuerEndpoint {
+		t.Fatalf("unexpected issuer: %s %s", oidc.IssuerType, oidc.IssuerEndpoint)
+	}
+	// authorization is not a valid OIDC credentials location of the Product resource
+	if oidc.CredentialsLoc != nil {
+		t.Fatalf("unexpected credentials location: %s", *oidc.CredentialsLoc)
+	}
+	if oidc.AuthenticationFlow == nil || !oidc.AuthenticationFlow.StandardFlowEnabled {
+		t.Fatalf("unexpected authentication flow: %v", oidc.AuthenticationFlow)
+	}
+}
+
+func TestPoliciesSpec(t *testing.T) {
+	defaultChain := []controllerhelper.PolicyConfigItem{
+		{Name: "apicast", Version: "builtin", Configuration: map[string]interface{}{}, Enabled: true},
+	}
+	policies, err := policiesSpec(defaultChain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if policies != nil {
+		t.Fatalf("default policy chain should not be exported: %v", policies)
+	}
+
+	chain := []controllerhelper.PolicyConfigItem{
+		{Name: "cors", Version: "builtin", Configuration: map[string]interface{}{"allow_credentials": true}, Enabled: true},
+		{Name: "apicast", Version: "builtin", Configuration: map[string]interface{}{}, Enabled: true},
+	}
+	policies, err = policiesSpec(chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []capabilitiesv1beta1.PolicyConfig{
+		{Name: "cors", Version: "builtin", Enabled: true, Configuration: runtime.RawExtension{Raw: []byte(`{"allow_credentials":true}`)}},
+		{Name: "apicast", Version: "builtin", Enabled: true},
+	}
+	if diff := cmp.Diff(policies, expected); diff != "" {
+		t.Fatalf("unexpected policies: %s", diff)
+	}
+}
+
+func TestServicePlansSpec(t *testing.T) {
+	list := &controllerhelper.ServicePlanList{
+		Plans: []controllerhelper.ServicePlan{
+			{Element: controllerhelper.ServicePlanItem{ID: 1, Name: "Default", SystemName: "default", State: "published", ApprovalRequired: true, SetupFee: 5}},
+			{Element: controllerhelper.ServicePlanItem{ID: 2, Name: "Premium", SystemName: "premium", State: "hidden", TrialPeriodDays: 7, CostPerMonth: 9.5}},
+		},
+	}
+
+	plans := servicePlansSpec(list)
+	if len(plans) != 2 {
+		t.Fatalf("unexpected service plans: %v", plans)
+	}
+
+	defaultPlan := plans["default"]
+	if !*defaultPlan.Published || !*defaultPlan.SubscriptionsRequireApproval || *defaultPlan.SetupFee != "5.00" {
+		t.Errorf("unexpected default service plan: %v", defaultPlan)
+	}
+
+	premiumPlan := plans["premium"]
+	if *premiumPlan.Published || *premiumPlan.TrialPeriod != 7 || *premiumPlan.CostMonth != "9.50" {
+		t.Errorf("unexpected premium service plan: %v", premiumPlan)
+	}
+}
+
+func TestFee(t *testing.T) {
+	cases := []struct {
+		value    float64
+		expected string
+	}{
+		{0, "0.00"},
+		{10, "10.00"},
+		{2.5, "2.50"},
+	}
+
+	for _, tc := range cases {
+		if got := fee(tc.value); got != tc.expected {
+			t.Errorf("fee(%v) = %s, expected %s", tc.value, got, tc.expected)
+		}
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	if got := resourceName("Pet_Store.API"); got != "pet-store-api" {
+		t.Fatalf("unexpected resource name: %s", got)
+	}
+}
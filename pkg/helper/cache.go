@@ -2,6 +2,9 @@ package helper
 
 import (
 	"errors"
+	"strings"
+	"sync"
+	"time"
 )
 
 // Errors
@@ -39,3 +42,95 @@ func (c *MemoryCache) Get(key string) (interface{}, error) {
 func (c *MemoryCache) Put(key string, data interface{}) {
 	c.store[key] = data
 }
+
+type ttlCacheEntry struct {
+	data      interface{}
+	expiresAt time.Time
+}
+
+// TTLCache implements a threadsafe memory cache
+// Entries expire after the TTL
+// Expired entries are evicted on access, and all of them at most once per TTL on Put
+type TTLCache struct {
+	ttl   time.Duration
+	mu    sync.Mutex
+	store map[string]ttlCacheEntry
+	// nextSweep is the time from which Put evicts all the expired entries
+	nextSweep time.Time
+	// now returns the current time. Replaceable for testing purposes
+	now func() time.Time
+}
+
+func NewTTLCache(ttl time.Duration) *TTLCache {
+	return &TTLCache{
+		ttl:   ttl,
+		store: make(map[string]ttlCacheEntry),
+		now:   time.Now,
+	}
+}
+
+// Get returns ErrNonExistentKey when the key does not exist or it has expired
+func (c *TTLCache) Get(key string) (interface{}, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.store[key]
+	if !ok {
+		return nil, ErrNonExistentKey
+	}
+
+	if !c.now().Before(entry.expiresAt) {
+		delete(c.store, key)
+		return nil, ErrNonExistentKey
+	}
+
+	return entry.data, nil
+}
+
+// Put stores the entry. Expired entries of keys never read again are evicted here,
+// so the cache does not grow with keys that are no longer used
+func (c *TTLCache) Put(key string, data interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if !now.Before(c.nextSweep) {
+		c.evictExpired(now)
+		c.nextSweep = now.Add(c.ttl)
+	}
+
+	c.store[key] = ttlCacheEntry{data: data, expiresAt: now.Add(c.ttl)}
+}
+
+// Len evicts the expired entries and returns the number of entries left
+func (c *TTLCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.evictExpired(c.now())
+	return len(c.store)
+}
+
+func (c *TTLCache) evictExpired(now time.Time) {
+	for key, entry := range c.store {
+		if !now.Before(entry.expiresAt) {
+			delete(c.store, key)
+		}
+	}
+}
+
+// DeletePrefix deletes all the keys starting with prefix.
+// Returns the number of deleted keys
+func (c *TTLCache) DeletePrefix(prefix string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	count := 0
+	for key := range c.store {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.store, key)
+			count++
+		}
+	}
+	return count
+}
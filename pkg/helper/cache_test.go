@@ -0,0 +1,58 @@
+package helper
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTTLCacheExpiration(t *testing.T) {
+	now := time.Now()
+	cache := NewTTLCache(time.Minute)
+	cache.now = func() time.Time { return now }
+
+	cache.Put("a", 1)
+	data, err := cache.Get("a")
+	if err != nil || data != 1 {
+		t.Fatalf("expected cached value, got %v, %v", data, err)
+	}
+
+	now = now.Add(time.Minute)
+	if _, err := cache.Get("a"); err != ErrNonExistentKey {
+		t.Fatalf("expected expired key, got %v", err)
+	}
+}
+
+func TestTTLCacheDeletePrefix(t *testing.T) {
+	cache := NewTTLCache(time.Minute)
+	cache.Put("backendapi/1/metrics", 1)
+	cache.Put("backendapi/1/mappingrules", 2)
+	cache.Put("backendapi/10/metrics", 3)
+
+	if deleted := cache.DeletePrefix("backendapi/1/"); deleted != 2 {
+		t.Fatalf("expected 2 deleted keys, got %d", deleted)
+	}
+
+	if _, err := cache.Get("backendapi/10/metrics"); err != nil {
+		t.Fatalf("expected key not deleted, got %v", err)
+	}
+}
+
+func TestTTLCacheEvictsExpiredOnPut(t *testing.T) {
+	now := time.Now()
+	cache := NewTTLCache(time.Minute)
+	cache.now = func() time.Time { return now }
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+
+	now = now.Add(time.Minute)
+	cache.Put("c", 3)
+
+	if len(cache.store) != 1 {
+		t.Fatalf("expected expired keys to be evicted, got %d keys", len(cache.store))
+	}
+
+	if cache.Len() != 1 {
+		t.Fatalf("expected 1 key, got %d", cache.Len())
+	}
+}
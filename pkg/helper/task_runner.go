@@ -1,15 +1,74 @@
 package helper
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
+var taskDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "threescale_operator_reconcile_task_duration_seconds",
+		Help:    "Duration of the reconcile tasks by controller and task",
+		Buckets: prometheus.ExponentialBuckets(0.005, 2, 14),
+	},
+	[]string{"controller", "task"},
+)
+
+func init() {
+	crmetrics.Registry.MustRegister(taskDuration)
+}
+
+// ObserveTaskDuration records the duration of the controller task started at start
+func ObserveTaskDuration(controller, task string, start time.Time) {
+	taskDuration.WithLabelValues(controller, task).Observe(time.Since(start).Seconds())
+}
+
 type task struct {
-	Name string
-	Run  func(interface{}) error
+	Name         string
+	Run          func(interface{}) error
+	Dependencies []string
+}
+
+type taskResult struct {
+	idx int
+	err error
+}
+
+// TaskErrors aggregates the errors of the failed tasks in registration order
+type TaskErrors []error
+
+func (e TaskErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Is reports whether any of the task errors matches target
+func (e TaskErrors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first task error that matches target
+func (e TaskErrors) As(target interface{}) bool {
+	for _, err := range e {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
 }
 
 // TaskRunner abstracts task running engine
@@ -17,36 +76,181 @@ type TaskRunner interface {
 	Run() error
 	// AddTask register tasks to be executed sequentially
 	// Tasks will be executed in order. First in, first to be executed.
+	// The task depends on all the tasks registered before.
 	AddTask(string, func(interface{}) error)
+	// AddTaskWithDependencies register tasks to be executed once the named tasks succeeded.
+	// Dependencies must be registered before. Tasks without dependencies are executed first.
+	// Tasks whose dependencies succeeded may be executed concurrently.
+	AddTaskWithDependencies(string, func(interface{}) error, ...string)
+	// ObserveDurations records the duration of every task in the task duration metric
+	// labeled with the controller name. Task names should be bounded, they are metric labels.
+	ObserveDurations(controller string)
 }
 
 type taskRunnerImpl struct {
-	ctx      interface{}
-	taskList []task
-	logger   logr.Logger
+	ctx         interface{}
+	taskList    []task
+	parallelism int
+	logger      logr.Logger
+	// controller labels the task durations, empty when not observed
+	controller string
 }
 
 // NewTaskRunner TaskRunner Constructor
+// Tasks are executed one at a time
 func NewTaskRunner(ctx interface{}, logger logr.Logger) TaskRunner {
+	return NewParallelTaskRunner(ctx, 1, logger)
+}
+
+// NewParallelTaskRunner TaskRunner Constructor
+// Up to parallelism tasks are executed concurrently
+func NewParallelTaskRunner(ctx interface{}, parallelism int, logger logr.Logger) TaskRunner {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
 	return &taskRunnerImpl{
-		ctx:      ctx,
-		taskList: []task{},
-		logger:   logger,
+		ctx:         ctx,
+		taskList:    []task{},
+		parallelism: parallelism,
+		logger:      logger,
 	}
 }
 
+// Run executes the tasks honoring their dependencies.
+// When a task fails, the tasks depending on it are not executed,
+// while independent tasks are. Errors of all failed tasks are returned.
 func (t *taskRunnerImpl) Run() error {
-	for _, task := range t.taskList {
-		start := time.Now()
-		if err := task.Run(t.ctx); err != nil {
-			return fmt.Errorf("Task failed %s: %w", task.Name, err)
+	// dependents[i] holds the tasks depending on task i
+	dependents := make([][]int, len(t.taskList))
+	// pendingDeps[i] holds the number of dependencies of task i not succeeded yet
+	pendingDeps := make([]int, len(t.taskList))
+	taskIndex := map[string]int{}
+	for idx, task := range t.taskList {
+		if _, ok := taskIndex[task.Name]; ok {
+			return fmt.Errorf("Task %s registered twice", task.Name)
+		}
+		for _, dep := range task.Dependencies {
+			depIdx, ok := taskIndex[dep]
+			if !ok {
+				return fmt.Errorf("Task %s depends on unknown task %s", task.Name, dep)
+			}
+			dependents[depIdx] = append(dependents[depIdx], idx)
+			pendingDeps[idx]++
 		}
-		elapsed := time.Since(start)
-		t.logger.V(1).Info("Measure", task.Name, elapsed)
+		taskIndex[task.Name] = idx
 	}
-	return nil
+
+	ready := []int{}
+	for idx := range t.taskList {
+		if pendingDeps[idx] == 0 {
+			ready = append(ready, idx)
+		}
+	}
+
+	errs := make([]error, len(t.taskList))
+	skipped := make([]bool, len(t.taskList))
+	results := make(chan taskResult)
+	running := 0
+	finished := 0
+
+	for finished < len(t.taskList) {
+		for running < t.parallelism && len(ready) > 0 {
+			idx := ready[0]
+			ready = ready[1:]
+			running++
+			go t.runTask(idx, results)
+		}
+
+		result := <-results
+		running--
+		finished++
+
+		if result.err != nil {
+			errs[result.idx] = fmt.Errorf("Task failed %s: %w", t.taskList[result.idx].Name, result.err)
+			finished += t.skipDependents(result.idx, dependents, skipped)
+			continue
+		}
+
+		for _, dependent := range dependents[result.idx] {
+			pendingDeps[dependent]--
+			if pendingDeps[dependent] == 0 && !skipped[dependent] {
+				ready = insertSorted(ready, dependent)
+			}
+		}
+	}
+
+	taskErrors := TaskErrors{}
+	for _, err := range errs {
+		if err != nil {
+			taskErrors = append(taskErrors, err)
+		}
+	}
+
+	switch len(taskErrors) {
+	case 0:
+		return nil
+	case 1:
+		return taskErrors[0]
+	}
+
+	return taskErrors
 }
 
 func (t *taskRunnerImpl) AddTask(name string, f func(interface{}) error) {
-	t.taskList = append(t.taskList, task{Name: name, Run: f})
+	dependencies := make([]string, 0, len(t.taskList))
+	for _, task := range t.taskList {
+		dependencies = append(dependencies, task.Name)
+	}
+	t.AddTaskWithDependencies(name, f, dependencies...)
+}
+
+func (t *taskRunnerImpl) AddTaskWithDependencies(name string, f func(interface{}) error, dependencies ...string) {
+	t.taskList = append(t.taskList, task{Name: name, Run: f, Dependencies: dependencies})
+}
+
+func (t *taskRunnerImpl) ObserveDurations(controller string) {
+	t.controller = controller
+}
+
+func (t *taskRunnerImpl) runTask(idx int, results chan<- taskResult) {
+	task := t.taskList[idx]
+	start := time.Now()
+	err := task.Run(t.ctx)
+	elapsed := time.Since(start)
+	t.logger.V(1).Info("Measure", task.Name, elapsed)
+	if t.controller != "" {
+		taskDuration.WithLabelValues(t.controller, task.Name).Observe(elapsed.Seconds())
+	}
+	results <- taskResult{idx: idx, err: err}
+}
+
+// skipDependents marks all the tasks depending, directly or not, on the failed task as skipped.
+// Returns the number of newly skipped tasks
+func (t *taskRunnerImpl) skipDependents(idx int, dependents [][]int, skipped []bool) int {
+	count := 0
+	for _, dependent := range dependents[idx] {
+		if skipped[dependent] {
+			continue
+		}
+		skipped[dependent] = true
+		t.logger.V(1).Info("Skipped", "task", t.taskList[dependent].Name, "failed dependency", t.taskList[idx].Name)
+		count += 1 + t.skipDependents(dependent, dependents, skipped)
+	}
+	return count
+}
+
+// insertSorted keeps ready tasks in registration order
+func insertSorted(list []int, value int) []int {
+	pos := len(list)
+	for i, v := range list {
+		if v > value {
+			pos = i
+			break
+		}
+	}
+	list = append(list, 0)
+	copy(list[pos+1:], list[pos:])
+	list[pos] = value
+	return list
 }
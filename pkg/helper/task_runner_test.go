@@ -0,0 +1,173 @@
+package helper
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type taskRecorder struct {
+	mu    sync.Mutex
+	order []string
+}
+
+func (r *taskRecorder) task(name string, err error) func(interface{}) error {
+	return func(interface{}) error {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.order = append(r.order, name)
+		return err
+	}
+}
+
+func TestTaskRunnerSequential(t *testing.T) {
+	recorder := &taskRecorder{}
+	runner := NewTaskRunner(nil, logf.Log.WithName("test"))
+	runner.AddTask("A", recorder.task("A", nil))
+	runner.AddTask("B", recorder.task("B", nil))
+	runner.AddTask("C", recorder.task("C", nil))
+
+	err := runner.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !cmp.Equal(recorder.order, []string{"A", "B", "C"}) {
+		t.Fatalf("unexpected order: %v", recorder.order)
+	}
+}
+
+func TestTaskRunnerDependencies(t *testing.T) {
+	recorder := &taskRecorder{}
+	runner := NewParallelTaskRunner(nil, 4, logf.Log.WithName("test"))
+	runner.AddTaskWithDependencies("A", recorder.task("A", nil))
+	runner.AddTaskWithDependencies("B", recorder.task("B", nil), "A")
+	runner.AddTaskWithDependencies("C", recorder.task("C", nil), "A")
+	runner.AddTaskWithDependencies("D", recorder.task("D", nil), "B", "C")
+
+	err := runner.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	position := map[string]int{}
+	for idx, name := range recorder.order {
+		position[name] = idx
+	}
+	if len(position) != 4 || position["A"] != 0 || position["D"] != 3 {
+		t.Fatalf("unexpected order: %v", recorder.order)
+	}
+}
+
+func TestTaskRunnerFailedDependency(t *testing.T) {
+	errA := errors.New("A failed")
+	errC := errors.New("C failed")
+	recorder := &taskRecorder{}
+	runner := NewParallelTaskRunner(nil, 2, logf.Log.WithName("test"))
+	runner.AddTaskWithDependencies("A", recorder.task("A", errA))
+	runner.AddTaskWithDependencies("B", recorder.task("B", nil), "A")
+	runner.AddTaskWithDependencies("C", recorder.task("C", errC))
+	runner.AddTaskWithDependencies("D", recorder.task("D", nil))
+
+	err := runner.Run()
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if !errors.Is(err, errA) || !errors.Is(err, errC) {
+		t.Fatalf("expected errors of all failed tasks, got: %v", err)
+	}
+
+	for _, name := range recorder.order {
+		if name == "B" {
+			t.Fatal("task depending on failed task was executed")
+		}
+	}
+	if len(recorder.order) != 3 {
+		t.Fatalf("expected independent tasks to be executed, got: %v", recorder.order)
+	}
+}
+
+func TestTaskRunnerParallelism(t *testing.T) {
+	var running, maxRunning int32
+	f := func(interface{}) error {
+		current := atomic.AddInt32(&running, 1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if current <= max || atomic.CompareAndSwapInt32(&maxRunning, max, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return nil
+	}
+
+	runner := NewParallelTaskRunner(nil, 2, logf.Log.WithName("test"))
+	for _, name := range []string{"A", "B", "C", "D", "E"} {
+		runner.AddTaskWithDependencies(name, f)
+	}
+
+	err := runner.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if maxRunning != 2 {
+		t.Fatalf("expected 2 tasks running concurrently, got %d", maxRunning)
+	}
+}
+
+func TestTaskRunnerUnknownDependency(t *testing.T) {
+	runner := NewTaskRunner(nil, logf.Log.WithName("test"))
+	runner.AddTaskWithDependencies("A", func(interface{}) error { return nil }, "B")
+
+	if err := runner.Run(); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func collectedMetrics(c prometheus.Collector) int {
+	ch := make(chan prometheus.Metric)
+	go func() {
+		c.Collect(ch)
+		close(ch)
+	}()
+
+	count := 0
+	for range ch {
+		count++
+	}
+	return count
+}
+
+func TestTaskRunnerObserveDurations(t *testing.T) {
+	recorder := &taskRecorder{}
+	initial := collectedMetrics(taskDuration)
+
+	runner := NewTaskRunner(nil, logf.Log.WithName("test"))
+	runner.AddTask("NotObserved", recorder.task("NotObserved", nil))
+	if err := runner.Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	if count := collectedMetrics(taskDuration); count != initial {
+		t.Fatalf("expected durations not to be observed, got %d new series", count-initial)
+	}
+
+	runner = NewTaskRunner(nil, logf.Log.WithName("test"))
+	runner.ObserveDurations("test-controller")
+	runner.AddTask("A", recorder.task("A", nil))
+	runner.AddTask("B", recorder.task("B", errors.New("B failed")))
+	_ = runner.Run()
+
+	if count := collectedMetrics(taskDuration); count != initial+2 {
+		t.Fatalf("expected durations of the 2 tasks, got %d new series", count-initial)
+	}
+}
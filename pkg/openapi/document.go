@@ -0,0 +1,153 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+)
+
+// Operation verbs supported by OpenAPI 2.0 and 3.0 path items, in the order operations are imported
+var operationVerbs = []string{"get", "head", "post", "put", "patch", "delete", "options", "trace"}
+
+// Document holds the subset of OpenAPI 2.0 and 3.0 documents used by the importer
+type Document struct {
+	// OpenAPI 2.0 version
+	Swagger string `json:"swagger,omitempty"`
+	// OpenAPI 3.0 version
+	OpenAPI string `json:"openapi,omitempty"`
+
+	Info Info `json:"info"`
+
+	// OpenAPI 3.0 servers
+	Servers []Server `json:"servers,omitempty"`
+
+	// OpenAPI 2.0 server attributes
+	Host     string   `json:"host,omitempty"`
+	BasePath string   `json:"basePath,omitempty"`
+	Schemes  []string `json:"schemes,omitempty"`
+
+	// Map: path -> verb -> operation
+	// Path item attributes other than operations are ignored
+	Paths map[string]map[string]json.RawMessage `json:"paths"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+}
+
+type Server struct {
+	URL       string                    `json:"url"`
+	Variables map[string]ServerVariable `json:"variables,omitempty"`
+}
+
+type ServerVariable struct {
+	Default string `json:"default"`
+}
+
+type Operation struct {
+	OperationID string `json:"operationId,omitempty"`
+	Summary     string `json:"summary,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// OperationItem is an operation identified by path and verb
+type OperationItem struct {
+	Path string
+	// Verb in lower case
+	Verb      string
+	Operation Operation
+}
+
+// ParseDocument parses OpenAPI 2.0 or 3.0 documents in JSON or YAML format
+func ParseDocument(data []byte) (*Document, error) {
+	// JSON is a subset of YAML
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("parsing OpenAPI document: %w", err)
+	}
+
+	doc := &Document{}
+	if err := json.Unmarshal(jsonData, doc); err != nil {
+		return nil, fmt.Errorf("parsing OpenAPI document: %w", err)
+	}
+
+	if !strings.HasPrefix(doc.Swagger, "2.") && !strings.HasPrefix(doc.OpenAPI, "3.") {
+		return nil, fmt.Errorf("unsupported OpenAPI document version: only 2.x and 3.x versions are supported")
+	}
+
+	if doc.Info.Title == "" {
+		return nil, fmt.Errorf("OpenAPI document info.title is required")
+	}
+
+	return doc, nil
+}
+
+// Operations returns the document operations sorted by path and verb
+func (d *Document) Operations() ([]OperationItem, error) {
+	operations := []OperationItem{}
+	for _, path := range sortedKeys(d.Paths) {
+		pathItem := d.Paths[path]
+		for _, verb := range operationVerbs {
+			rawOperation, ok := pathItem[verb]
+			if !ok {
+				continue
+			}
+
+			operation := Operation{}
+			if err := json.Unmarshal(rawOperation, &operation); err != nil {
+				return nil, fmt.Errorf("parsing OpenAPI operation %s %s: %w", strings.ToUpper(verb), path, err)
+			}
+
+			operations = append(operations, OperationItem{Path: path, Verb: verb, Operation: operation})
+		}
+	}
+
+	return operations, nil
+}
+
+// PrivateBaseURL returns the API base URL.
+// OpenAPI 3.0: first server URL with variables replaced by default values.
+// OpenAPI 2.0: first scheme, host and basePath.
+// Empty when the document does not define an absolute URL.
+func (d *Document) PrivateBaseURL() string {
+	var baseURL string
+	if strings.HasPrefix(d.OpenAPI, "3.") {
+		if len(d.Servers) == 0 {
+			return ""
+		}
+		baseURL = d.Servers[0].URL
+		for name, variable := range d.Servers[0].Variables {
+			baseURL = strings.ReplaceAll(baseURL, "{"+name+"}", variable.Default)
+		}
+	} else {
+		if d.Host == "" {
+			return ""
+		}
+		scheme := "https"
+		if len(d.Schemes) > 0 {
+			scheme = d.Schemes[0]
+		}
+		baseURL = fmt.Sprintf("%s://%s%s", scheme, d.Host, d.BasePath)
+	}
+
+	parsedURL, err := url.Parse(baseURL)
+	if err != nil || !parsedURL.IsAbs() || parsedURL.Host == "" {
+		return ""
+	}
+
+	return baseURL
+}
+
+func sortedKeys(m map[string]map[string]json.RawMessage) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
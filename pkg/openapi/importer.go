@@ -0,0 +1,232 @@
+package openapi
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	// system names have the same format as the ones defaulted by the Product and Backend resources
+	systemNameRegexp = regexp.MustCompile("[^a-zA-Z0-9]+")
+	// resource names and method system names keep word boundaries
+	wordSeparatorRegexp = regexp.MustCompile("[^a-z0-9]+")
+)
+
+// ImporterOptions customizes the resources generated from the OpenAPI document
+type ImporterOptions struct {
+	// SystemName of the product. Default value will be sanitized document info.title
+	SystemName string
+	// BackendSystemName of the backend. Default value will be product system name with backend suffix
+	BackendSystemName string
+	// PrivateBaseURL overrides the API base URL read from the document
+	PrivateBaseURL string
+}
+
+// Importer generates Product and Backend resources from an OpenAPI document.
+// Every operation is imported as a product method and a product mapping rule.
+// The product uses a single backend with the document's API base URL as private base URL.
+type Importer struct {
+	doc     *Document
+	options ImporterOptions
+}
+
+func NewImporter(doc *Document, options ImporterOptions) *Importer {
+	return &Importer{doc: doc, options: options}
+}
+
+func (i *Importer) productSystemName() string {
+	if i.options.SystemName != "" {
+		return strings.ToLower(i.options.SystemName)
+	}
+	return strings.ToLower(systemNameRegexp.ReplaceAllString(i.doc.Info.Title, ""))
+}
+
+func (i *Importer) backendSystemName() string {
+	if i.options.BackendSystemName != "" {
+		return strings.ToLower(i.options.BackendSystemName)
+	}
+	return i.productSystemName() + "backend"
+}
+
+func (i *Importer) privateBaseURL() (string, error) {
+	if i.options.PrivateBaseURL != "" {
+		return i.options.PrivateBaseURL, nil
+	}
+
+	baseURL := i.doc.PrivateBaseURL()
+	if baseURL == "" {
+		return "", fmt.Errorf("OpenAPI document does not define an absolute API base URL. Private base URL must be provided")
+	}
+
+	return baseURL, nil
+}
+
+// Backend generates a new Backend resource
+func (i *Importer) Backend() (*capabilitiesv1beta1.Backend, error) {
+	backend := &capabilitiesv1beta1.Backend{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: capabilitiesv1beta1.SchemeGroupVersion.String(),
+			Kind:       capabilitiesv1beta1.BackendKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: resourceName(i.backendSystemName()),
+		},
+		Spec: capabilitiesv1beta1.BackendSpec{
+			Name:       fmt.Sprintf("%s Backend", i.doc.Info.Title),
+			SystemName: i.backendSystemName(),
+		},
+	}
+
+	err := i.UpdateBackend(backend)
+	if err != nil {
+		return nil, err
+	}
+
+	return backend, nil
+}
+
+// UpdateBackend updates the private base URL of an existing Backend resource.
+// Other attributes are kept.
+func (i *Importer) UpdateBackend(backend *capabilitiesv1beta1.Backend) error {
+	baseURL, err := i.privateBaseURL()
+	if err != nil {
+		return err
+	}
+
+	backend.Spec.PrivateBaseURL = baseURL
+	return nil
+}
+
+// Product generates a new Product resource
+func (i *Importer) Product() (*capabilitiesv1beta1.Product, error) {
+	product := &capabilitiesv1beta1.Product{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: capabilitiesv1beta1.SchemeGroupVersion.String(),
+			Kind:       capabilitiesv1beta1.ProductKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: resourceName(i.productSystemName()),
+		},
+		Spec: capabilitiesv1beta1.ProductSpec{
+			Name:        i.doc.Info.Title,
+			SystemName:  i.productSystemName(),
+			Description: i.doc.Info.Description,
+		},
+	}
+
+	err := i.UpdateProduct(product)
+	if err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+// UpdateProduct updates an existing Product resource.
+// Methods of the document operations are added or updated, other existing methods are kept.
+// Mapping rules are replaced by the ones of the document operations.
+// The backend usage of the generated backend is added when missing.
+// Other attributes are kept.
+func (i *Importer) UpdateProduct(product *capabilitiesv1beta1.Product) error {
+	operations, err := i.doc.Operations()
+	if err != nil {
+		return err
+	}
+
+	err = validateOperationMethodSystemNames(operations)
+	if err != nil {
+		return err
+	}
+
+	if product.Spec.Methods == nil {
+		product.Spec.Methods = map[string]capabilitiesv1beta1.MethodSpec{}
+	}
+
+	mappingRules := make([]capabilitiesv1beta1.MappingRuleSpec, 0, len(operations))
+	for _, operationItem := range operations {
+		methodSystemName := operationMethodSystemName(operationItem)
+		product.Spec.Methods[methodSystemName] = capabilitiesv1beta1.MethodSpec{
+			Name:        operationFriendlyName(operationItem),
+			Description: operationItem.Operation.Description,
+		}
+
+		mappingRules = append(mappingRules, capabilitiesv1beta1.MappingRuleSpec{
+			HTTPMethod:      strings.ToUpper(operationItem.Verb),
+			Pattern:         operationItem.Path + "$",
+			MetricMethodRef: methodSystemName,
+			Increment:       1,
+		})
+	}
+	product.Spec.MappingRules = mappingRules
+
+	if product.Spec.BackendUsages == nil {
+		product.Spec.BackendUsages = map[string]capabilitiesv1beta1.BackendUsageSpec{}
+	}
+
+	if _, ok := product.Spec.BackendUsages[i.backendSystemName()]; !ok {
+		product.Spec.BackendUsages[i.backendSystemName()] = capabilitiesv1beta1.BackendUsageSpec{Path: "/"}
+	}
+
+	return nil
+}
+
+// validateOperationMethodSystemNames checks each operation maps to its own method.
+// Operations with the same sanitized system name, or the reserved hits metric, would overwrite each other
+func validateOperationMethodSystemNames(operations []OperationItem) error {
+	methodOperations := map[string]OperationItem{}
+	for _, operationItem := range operations {
+		methodSystemName := operationMethodSystemName(operationItem)
+		if methodSystemName == "hits" {
+			return fmt.Errorf("OpenAPI operation %s: method system name \"hits\" is reserved", operationName(operationItem))
+		}
+
+		if other, ok := methodOperations[methodSystemName]; ok {
+			return fmt.Errorf("OpenAPI operations %s and %s have the same method system name %q",
+				operationName(other), operationName(operationItem), methodSystemName)
+		}
+		methodOperations[methodSystemName] = operationItem
+	}
+
+	return nil
+}
+
+// operationName identifies the operation in error messages
+func operationName(operationItem OperationItem) string {
+	name := fmt.Sprintf("%s %s", strings.ToUpper(operationItem.Verb), operationItem.Path)
+	if operationItem.Operation.OperationID != "" {
+		name = fmt.Sprintf("%s (%s)", name, operationItem.Operation.OperationID)
+	}
+	return name
+}
+
+// operationMethodSystemName returns the method system name of the operation.
+// Sanitized operationId if defined, otherwise, sanitized verb and path
+func operationMethodSystemName(operationItem OperationItem) string {
+	name := operationItem.Operation.OperationID
+	if name == "" {
+		name = operationItem.Verb + "_" + operationItem.Path
+	}
+
+	return strings.Trim(wordSeparatorRegexp.ReplaceAllString(strings.ToLower(name), "_"), "_")
+}
+
+func operationFriendlyName(operationItem OperationItem) string {
+	if operationItem.Operation.Summary != "" {
+		return operationItem.Operation.Summary
+	}
+
+	if operationItem.Operation.OperationID != "" {
+		return operationItem.Operation.OperationID
+	}
+
+	return fmt.Sprintf("%s %s", strings.ToUpper(operationItem.Verb), operationItem.Path)
+}
+
+func resourceName(systemName string) string {
+	return strings.Trim(wordSeparatorRegexp.ReplaceAllString(strings.ToLower(systemName), "-"), "-")
+}
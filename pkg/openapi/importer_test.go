@@ -0,0 +1,223 @@
+package openapi
+
+import (
+	"strings"
+	"testing"
+
+	capabilitiesv1beta1 "github.com/3scale/3scale-operator/pkg/apis/capabilities/v1beta1"
+)
+
+const testOpenAPI3Doc = `
+openapi: "3.0.0"
+info:
+  title: Swagger Petstore
+  version: 1.0.0
+servers:
+  - url: "https://{env}.petstore.example.com/v1"
+    variables:
+      env:
+        default: api
+paths:
+  /pets:
+    get:
+      summary: List all pets
+      operationId: listPets
+    post:
+      operationId: createPets
+  /pets/{petId}:
+    parameters:
+      - name: petId
+        in: path
+    delete: {}
+`
+
+const testOpenAPI2Doc = `
+{
+  "swagger": "2.0",
+  "info": {"title": "Echo API", "version": "1.0.0"},
+  "host": "echo.example.com",
+  "basePath": "/api",
+  "schemes": ["http"],
+  "paths": {"/": {"get": {"operationId": "echo"}}}
+}
+`
+
+func TestParseDocumentUnsupportedVersion(t *testing.T) {
+	_, err := ParseDocument([]byte(`{"info": {"title": "A"}, "paths": {}}`))
+	if err == nil {
+		t.Error("expected error for document without version")
+	}
+}
+
+func TestPrivateBaseURL(t *testing.T) {
+	cases := []struct {
+		testName string
+		doc      string
+		expected string
+	}{
+		{"openapi3", testOpenAPI3Doc, "https://api.petstore.example.com/v1"},
+		{"openapi2", testOpenAPI2Doc, "http://echo.example.com/api"},
+		{"relative", "{\"openapi\": \"3.0.1\", \"info\": {\"title\": \"A\"}, \"servers\": [{\"url\": \"/v1\"}]}", ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(subT *testing.T) {
+			doc, err := ParseDocument([]byte(tc.doc))
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			if baseURL := doc.PrivateBaseURL(); baseURL != tc.expected {
+				subT.Errorf("expected %s, got %s", tc.expected, baseURL)
+			}
+		})
+	}
+}
+
+func TestImporterProduct(t *testing.T) {
+	doc, err := ParseDocument([]byte(testOpenAPI3Doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	product, err := NewImporter(doc, ImporterOptions{}).Product()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if product.Name != "swaggerpetstore" || product.Spec.SystemName != "swaggerpetstore" {
+		t.Errorf("unexpected product name: %s, system name: %s", product.Name, product.Spec.SystemName)
+	}
+
+	expectedMappingRules := []capabilitiesv1beta1.MappingRuleSpec{
+		{HTTPMethod: "GET", Pattern: "/pets$", MetricMethodRef: "listpets", Increment: 1},
+		{HTTPMethod: "POST", Pattern: "/pets$", MetricMethodRef: "createpets", Increment: 1},
+		{HTTPMethod: "DELETE", Pattern: "/pets/{petId}$", MetricMethodRef: "delete_pets_petid", Increment: 1},
+	}
+
+	if len(product.Spec.MappingRules) != len(expectedMappingRules) {
+		t.Fatalf("expected %d mapping rules, got %d", len(expectedMappingRules), len(product.Spec.MappingRules))
+	}
+
+	for idx, expected := range expectedMappingRules {
+		if product.Spec.MappingRules[idx] != expected {
+			t.Errorf("mapping rule %d: expected %v, got %v", idx, expected, product.Spec.MappingRules[idx])
+		}
+
+		if _, ok := product.Spec.Methods[expected.MetricMethodRef]; !ok {
+			t.Errorf("method %s not found", expected.MetricMethodRef)
+		}
+	}
+
+	if product.Spec.Methods["listpets"].Name != "List all pets" {
+		t.Errorf("unexpected method friendly name: %s", product.Spec.Methods["listpets"].Name)
+	}
+
+	if _, ok := product.Spec.BackendUsages["swaggerpetstorebackend"]; !ok {
+		t.Error("backend usage not found")
+	}
+}
+
+func TestImporterUpdateProduct(t *testing.T) {
+	doc, err := ParseDocument([]byte(testOpenAPI2Doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	product := &capabilitiesv1beta1.Product{
+		Spec: capabilitiesv1beta1.ProductSpec{
+			Name: "Custom Name",
+			Methods: map[string]capabilitiesv1beta1.MethodSpec{
+				"custom": {Name: "Custom"},
+			},
+			MappingRules: []capabilitiesv1beta1.MappingRuleSpec{
+				{HTTPMethod: "GET", Pattern: "/custom", MetricMethodRef: "custom", Increment: 1},
+			},
+		},
+	}
+
+	err = NewImporter(doc, ImporterOptions{BackendSystemName: "echobackend"}).UpdateProduct(product)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if product.Spec.Name != "Custom Name" {
+		t.Errorf("product name not kept: %s", product.Spec.Name)
+	}
+
+	if _, ok := product.Spec.Methods["custom"]; !ok {
+		t.Error("existing method not kept")
+	}
+
+	if len(product.Spec.MappingRules) != 1 || product.Spec.MappingRules[0].MetricMethodRef != "echo" {
+		t.Errorf("mapping rules not replaced: %v", product.Spec.MappingRules)
+	}
+
+	if _, ok := product.Spec.BackendUsages["echobackend"]; !ok {
+		t.Error("backend usage not found")
+	}
+}
+
+func TestImporterBackendRequiresBaseURL(t *testing.T) {
+	doc, err := ParseDocument([]byte(`{"openapi": "3.0.1", "info": {"title": "A"}, "paths": {}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewImporter(doc, ImporterOptions{}).Backend(); err == nil {
+		t.Error("expected error when the document does not define base URL")
+	}
+
+	backend, err := NewImporter(doc, ImporterOptions{PrivateBaseURL: "https://api.example.com"}).Backend()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if backend.Spec.PrivateBaseURL != "https://api.example.com" {
+		t.Errorf("unexpected private base URL: %s", backend.Spec.PrivateBaseURL)
+	}
+}
+
+func TestImporterUpdateProductDuplicatedMethods(t *testing.T) {
+	cases := []struct {
+		name     string
+		doc      string
+		expected []string
+	}{
+		{
+			"sanitized operationId collision",
+			`{"openapi": "3.0.1", "info": {"title": "A"}, "paths": {"/pets": {"get": {"operationId": "get_pet"}, "post": {"operationId": "get-pet"}}}}`,
+			[]string{"GET /pets (get_pet)", "POST /pets (get-pet)", `"get_pet"`},
+		},
+		{
+			"reserved hits metric",
+			`{"openapi": "3.0.1", "info": {"title": "A"}, "paths": {"/hits": {"get": {"operationId": "hits"}}}}`,
+			[]string{"GET /hits (hits)", `"hits" is reserved`},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(subT *testing.T) {
+			doc, err := ParseDocument([]byte(tc.doc))
+			if err != nil {
+				subT.Fatal(err)
+			}
+
+			product := &capabilitiesv1beta1.Product{}
+			err = NewImporter(doc, ImporterOptions{}).UpdateProduct(product)
+			if err == nil {
+				subT.Fatal("expected error on operations with the same method system name")
+			}
+
+			for _, expected := range tc.expected {
+				if !strings.Contains(err.Error(), expected) {
+					subT.Errorf("expected error to contain %s, got %v", expected, err)
+				}
+			}
+
+			if len(product.Spec.Methods) != 0 || len(product.Spec.MappingRules) != 0 {
+				subT.Errorf("product should not be updated, got %v", product.Spec)
+			}
+		})
+	}
+}
@@ -26,17 +26,25 @@ const (
 	systemSharedPVCResourceRequestsPath      = "/spec/system/fileStorage/persistentVolumeClaim/resources/requests"
 	systemMySQLPVCResourceRequestsPath       = "/spec/system/database/mysql/persistentVolumeClaim/resources/requests"
 	systemPostgreSQLPVCResourceRequestsPath  = "/spec/system/database/postgresql/persistentVolumeClaim/resources/requests"
+	productPolicyConfigurationPath           = "/spec/policies/configuration"
+	customPolicyDefinitionSchemaPath         = "/spec/schema"
 )
 
 func TestSampleCustomResources(t *testing.T) {
 	root := "../../deploy/crds"
 	crdCrMap := map[string]string{
-		"apps.3scale.net_apimanagers_crd.yaml":        "apps.3scale.net_v1alpha1_apimanager_cr",
-		"apps.3scale.net_apimanagerbackups_crd.yaml":  "apps.3scale.net_v1alpha1_apimanagerbackup_cr.yaml",
-		"apps.3scale.net_apimanagerrestores_crd.yaml": "apps.3scale.net_v1alpha1_apimanagerrestore_cr.yaml",
-		"capabilities.3scale.net_tenants_crd.yaml":    "capabilities.3scale.net_v1alpha1_tenant_cr",
-		"capabilities.3scale.net_backends_crd.yaml":   "capabilities.3scale.net_v1beta1_backend_cr",
-		"capabilities.3scale.net_products_crd.yaml":   "capabilities.3scale.net_v1beta1_product_cr",
+		"apps.3scale.net_apimanagers_crd.yaml":                     "apps.3scale.net_v1alpha1_apimanager_cr",
+		"apps.3scale.net_apimanagerbackups_crd.yaml":               "apps.3scale.net_v1alpha1_apimanagerbackup_cr.yaml",
+		"apps.3scale.net_apimanagerrestores_crd.yaml":              "apps.3scale.net_v1alpha1_apimanagerrestore_cr.yaml",
+		"capabilities.3scale.net_tenants_crd.yaml":                 "capabilities.3scale.net_v1alpha1_tenant_cr",
+		"capabilities.3scale.net_backends_crd.yaml":                "capabilities.3scale.net_v1beta1_backend_cr",
+		"capabilities.3scale.net_products_crd.yaml":                "capabilities.3scale.net_v1beta1_product_cr",
+		"capabilities.3scale.net_activedocs_crd.yaml":              "capabilities.3scale.net_v1beta1_activedoc_cr",
+		"capabilities.3scale.net_applications_crd.yaml":            "capabilities.3scale.net_v1beta1_application_cr",
+		"capabilities.3scale.net_custompolicydefinitions_crd.yaml": "capabilities.3scale.net_v1beta1_custompolicydefinition_cr",
+		"capabilities.3scale.net_developeraccounts_crd.yaml":       "capabilities.3scale.net_v1beta1_developeraccount_cr",
+		"capabilities.3scale.net_provideraccounts_crd.yaml":        "capabilities.3scale.net_v1beta1_provideraccount_cr",
+		"capabilities.3scale.net_accountplans_crd.yaml":            "capabilities.3scale.net_v1beta1_accountplan_cr",
 	}
 	for crd, prefix := range crdCrMap {
 		validateCustomResources(t, root, crd, prefix)
@@ -69,12 +77,18 @@ func validateCustomResources(t *testing.T, root string, crd string, prefix strin
 func TestCompleteCRD(t *testing.T) {
 	root := "../../deploy/crds"
 	crdStructMap := map[string]interface{}{
-		"apps.3scale.net_apimanagers_crd.yaml":        &apps.APIManager{},
-		"apps.3scale.net_apimanagerbackups_crd.yaml":  &apps.APIManagerBackup{},
-		"apps.3scale.net_apimanagerrestores_crd.yaml": &apps.APIManagerRestore{},
-		"capabilities.3scale.net_tenants_crd.yaml":    &capabilitiesv1alpha1.Tenant{},
-		"capabilities.3scale.net_backends_crd.yaml":   &capabilitiesv1beta1.Backend{},
-		"capabilities.3scale.net_products_crd.yaml":   &capabilitiesv1beta1.Product{},
+		"apps.3scale.net_apimanagers_crd.yaml":                     &apps.APIManager{},
+		"apps.3scale.net_apimanagerbackups_crd.yaml":               &apps.APIManagerBackup{},
+		"apps.3scale.net_apimanagerrestores_crd.yaml":              &apps.APIManagerRestore{},
+		"capabilities.3scale.net_tenants_crd.yaml":                 &capabilitiesv1alpha1.Tenant{},
+		"capabilities.3scale.net_backends_crd.yaml":                &capabilitiesv1beta1.Backend{},
+		"capabilities.3scale.net_products_crd.yaml":                &capabilitiesv1beta1.Product{},
+		"capabilities.3scale.net_activedocs_crd.yaml":              &capabilitiesv1beta1.ActiveDoc{},
+		"capabilities.3scale.net_applications_crd.yaml":            &capabilitiesv1beta1.Application{},
+		"capabilities.3scale.net_custompolicydefinitions_crd.yaml": &capabilitiesv1beta1.CustomPolicyDefinition{},
+		"capabilities.3scale.net_developeraccounts_crd.yaml":       &capabilitiesv1beta1.DeveloperAccount{},
+		"capabilities.3scale.net_provideraccounts_crd.yaml":        &capabilitiesv1beta1.ProviderAccount{},
+		"capabilities.3scale.net_accountplans_crd.yaml":            &capabilitiesv1beta1.AccountPlan{},
 	}
 
 	pathOmissions := []string{
@@ -85,6 +99,8 @@ func TestCompleteCRD(t *testing.T) {
 		systemSharedPVCResourceRequestsPath,
 		systemMySQLPVCResourceRequestsPath,
 		systemPostgreSQLPVCResourceRequestsPath,
+		productPolicyConfigurationPath,
+		customPolicyDefinitionSchemaPath,
 	}
 
 	for crd, obj := range crdStructMap {